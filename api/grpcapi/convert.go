@@ -0,0 +1,393 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcapi
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/loxilb-io/loxilb/api/loxinlp"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// lbServ2Arg - convert a LbService message to cmn.LbServiceArg
+func lbServ2Arg(s *LbService) cmn.LbServiceArg {
+	if s == nil {
+		return cmn.LbServiceArg{}
+	}
+	return cmn.LbServiceArg{
+		ServIP:                 s.ExternalIp,
+		PrivateIP:              s.PrivateIp,
+		ServPort:               uint16(s.Port),
+		ServPortMax:            uint16(s.PortMax),
+		Proto:                  s.Protocol,
+		BlockNum:               s.Block,
+		Sel:                    cmn.EpSelect(s.Sel),
+		Bgp:                    s.Bgp,
+		Monitor:                s.Monitor,
+		Mode:                   cmn.LBMode(s.Mode),
+		Security:               cmn.LBSec(s.Security),
+		InactiveTimeout:        s.InactiveTimeout,
+		Managed:                s.Managed,
+		ProbeType:              s.ProbeType,
+		ProbePort:              uint16(s.ProbePort),
+		ProbeReq:               s.ProbeReq,
+		ProbeResp:              s.ProbeResp,
+		ProbeTimeout:           s.ProbeTimeout,
+		ProbeRetries:           int(s.ProbeRetries),
+		ProbeRise:              int(s.ProbeRise),
+		SlowStart:              s.SlowStart,
+		OutlierConsecErrors:    int(s.OutlierConsecutiveErrors),
+		OutlierErrorRate:       uint8(s.OutlierErrorRate),
+		OutlierEjectTime:       s.OutlierEjectTime,
+		OutlierMaxEjectPercent: uint8(s.OutlierMaxEjectPercent),
+		Name:                   s.Name,
+		PersistTimeout:         s.PersistTimeout,
+		Snat:                   s.Snat,
+		HostUrl:                s.HostUrl,
+		ProxyProtocolV2:        s.ProxyProtocolV2,
+		Egress:                 s.Egress,
+	}
+}
+
+// lbArg2Serv - convert cmn.LbServiceArg to a LbService message
+func lbArg2Serv(s *cmn.LbServiceArg) *LbService {
+	return &LbService{
+		ExternalIp:               s.ServIP,
+		PrivateIp:                s.PrivateIP,
+		Port:                     uint32(s.ServPort),
+		PortMax:                  uint32(s.ServPortMax),
+		Protocol:                 s.Proto,
+		Block:                    s.BlockNum,
+		Sel:                      int32(s.Sel),
+		Bgp:                      s.Bgp,
+		Monitor:                  s.Monitor,
+		Mode:                     int32(s.Mode),
+		Security:                 int32(s.Security),
+		InactiveTimeout:          s.InactiveTimeout,
+		Managed:                  s.Managed,
+		ProbeType:                s.ProbeType,
+		ProbePort:                uint32(s.ProbePort),
+		ProbeReq:                 s.ProbeReq,
+		ProbeResp:                s.ProbeResp,
+		ProbeTimeout:             s.ProbeTimeout,
+		ProbeRetries:             int32(s.ProbeRetries),
+		ProbeRise:                int32(s.ProbeRise),
+		SlowStart:                s.SlowStart,
+		OutlierConsecutiveErrors: int32(s.OutlierConsecErrors),
+		OutlierErrorRate:         uint32(s.OutlierErrorRate),
+		OutlierEjectTime:         s.OutlierEjectTime,
+		OutlierMaxEjectPercent:   uint32(s.OutlierMaxEjectPercent),
+		Name:                     s.Name,
+		PersistTimeout:           s.PersistTimeout,
+		Snat:                     s.Snat,
+		HostUrl:                  s.HostUrl,
+		ProxyProtocolV2:          s.ProxyProtocolV2,
+		Egress:                   s.Egress,
+	}
+}
+
+// lbRule2Mod - convert a LbRule message to cmn.LbRuleMod
+func lbRule2Mod(r *LbRule) cmn.LbRuleMod {
+	lm := cmn.LbRuleMod{Serv: lbServ2Arg(r.Service)}
+	for _, ip := range r.SecondaryIps {
+		lm.SecIPs = append(lm.SecIPs, cmn.LbSecIPArg{SecIP: ip})
+	}
+	for _, pfx := range r.AllowedSources {
+		lm.SrcIPs = append(lm.SrcIPs, cmn.LbAllowedSrcIPArg{Prefix: pfx})
+	}
+	for _, ep := range r.Endpoints {
+		lm.Eps = append(lm.Eps, cmn.LbEndPointArg{
+			EpIP:   ep.EndpointIp,
+			EpPort: uint16(ep.TargetPort),
+			Weight: uint8(ep.Weight),
+		})
+	}
+	return lm
+}
+
+// lbMod2Rule - convert cmn.LbRuleMod to a LbRule message
+func lbMod2Rule(lm *cmn.LbRuleMod) *LbRule {
+	r := &LbRule{Service: lbArg2Serv(&lm.Serv)}
+	for _, sip := range lm.SecIPs {
+		r.SecondaryIps = append(r.SecondaryIps, sip.SecIP)
+	}
+	for _, src := range lm.SrcIPs {
+		r.AllowedSources = append(r.AllowedSources, src.Prefix)
+	}
+	for _, ep := range lm.Eps {
+		r.Endpoints = append(r.Endpoints, &LbEndPoint{
+			EndpointIp: ep.EpIP,
+			TargetPort: uint32(ep.EpPort),
+			Weight:     uint32(ep.Weight),
+			State:      ep.State,
+			Counters:   ep.Counters,
+		})
+	}
+	return r
+}
+
+// ep2Mod - convert an EndPoint message to cmn.EndPointMod
+func ep2Mod(ep *EndPoint) cmn.EndPointMod {
+	return cmn.EndPointMod{
+		HostName:         ep.HostName,
+		Name:             ep.Name,
+		InActTries:       int(ep.InactiveRetries),
+		ActTries:         int(ep.ActiveRetries),
+		ProbeType:        ep.ProbeType,
+		ProbeReq:         ep.ProbeReq,
+		ProbeResp:        ep.ProbeResp,
+		ProbeDuration:    ep.ProbeDuration,
+		ProbePort:        uint16(ep.ProbePort),
+		ProbeTimeout:     ep.ProbeTimeout,
+		ProbeBackoffMax:  ep.ProbeBackoffMax,
+		ProbeJitter:      uint8(ep.ProbeJitter),
+		ProbeMethod:      ep.ProbeMethod,
+		ProbeHost:        ep.ProbeHost,
+		ProbeHeaders:     ep.ProbeHeaders,
+		ProbeStatus:      ep.ProbeStatus,
+		ProbeBodyRegex:   ep.ProbeBodyRegex,
+		ProbeJSONPath:    ep.ProbeJsonPath,
+		ProbeJSONValue:   ep.ProbeJsonValue,
+		ProbeGRPCService: ep.ProbeGrpcService,
+		ProbeTLS:         ep.ProbeTls,
+		ProbeExec:        ep.ProbeExec,
+		ProbeExecState:   ep.ProbeExecState,
+	}
+}
+
+// epMod2EP - convert cmn.EndPointMod to an EndPoint message
+func epMod2EP(ep *cmn.EndPointMod) *EndPoint {
+	return &EndPoint{
+		HostName:         ep.HostName,
+		Name:             ep.Name,
+		InactiveRetries:  int32(ep.InActTries),
+		ActiveRetries:    int32(ep.ActTries),
+		ProbeType:        ep.ProbeType,
+		ProbeReq:         ep.ProbeReq,
+		ProbeResp:        ep.ProbeResp,
+		ProbeDuration:    ep.ProbeDuration,
+		ProbePort:        uint32(ep.ProbePort),
+		MinDelay:         ep.MinDelay,
+		AvgDelay:         ep.AvgDelay,
+		MaxDelay:         ep.MaxDelay,
+		CurrState:        ep.CurrState,
+		ProbeTimeout:     ep.ProbeTimeout,
+		ProbeBackoffMax:  ep.ProbeBackoffMax,
+		ProbeJitter:      uint32(ep.ProbeJitter),
+		ProbeMethod:      ep.ProbeMethod,
+		ProbeHost:        ep.ProbeHost,
+		ProbeHeaders:     ep.ProbeHeaders,
+		ProbeStatus:      ep.ProbeStatus,
+		ProbeBodyRegex:   ep.ProbeBodyRegex,
+		ProbeJsonPath:    ep.ProbeJSONPath,
+		ProbeJsonValue:   ep.ProbeJSONValue,
+		ProbeGrpcService: ep.ProbeGRPCService,
+		ProbeTls:         ep.ProbeTLS,
+		ProbeExec:        ep.ProbeExec,
+		ProbeExecState:   ep.ProbeExecState,
+	}
+}
+
+// fwAnyIP - default an unset source or destination the same way as the REST api
+func fwAnyIP(other string) string {
+	if other == "" || tk.IsNetIPv4(other) {
+		return "0.0.0.0/0"
+	}
+	return "::/0"
+}
+
+// fw2Mod - convert a FwRule message to cmn.FwRuleMod
+func fw2Mod(fw *FwRule) cmn.FwRuleMod {
+	var fm cmn.FwRuleMod
+
+	fm.Rule = cmn.FwRuleArg{
+		SrcIP:      fw.SourceIp,
+		DstIP:      fw.DestinationIp,
+		SrcPortMin: uint16(fw.MinSourcePort),
+		SrcPortMax: uint16(fw.MaxSourcePort),
+		DstPortMin: uint16(fw.MinDestinationPort),
+		DstPortMax: uint16(fw.MaxDestinationPort),
+		Proto:      uint8(fw.Protocol),
+		InPort:     fw.PortName,
+		Pref:       fw.Preference,
+		SrcIPSet:   fw.SourceIpSet,
+		DstIPSet:   fw.DestinationIpSet,
+	}
+	if fm.Rule.DstIP == "" {
+		fm.Rule.DstIP = fwAnyIP(fm.Rule.SrcIP)
+	}
+	if fm.Rule.SrcIP == "" {
+		fm.Rule.SrcIP = fwAnyIP(fm.Rule.DstIP)
+	}
+
+	if o := fw.Opts; o != nil {
+		fm.Opts = cmn.FwOptArg{
+			Drop:      o.Drop,
+			Trap:      o.Trap,
+			Record:    o.Record,
+			Rdr:       o.Redirect,
+			RdrPort:   o.RedirectPortName,
+			Allow:     o.Allow,
+			Mark:      o.FwMark,
+			DoSnat:    o.DoSnat,
+			ToIP:      o.ToIp,
+			ToPort:    uint16(o.ToPort),
+			OnDefault: o.OnDefault,
+			ValidFrom: o.ValidFrom,
+			ExpiresAt: o.ExpiresAt,
+			TTL:       o.Ttl,
+		}
+	}
+	return fm
+}
+
+// fwMod2Rule - convert cmn.FwRuleMod to a FwRule message
+func fwMod2Rule(fm *cmn.FwRuleMod) *FwRule {
+	return &FwRule{
+		SourceIp:           fm.Rule.SrcIP,
+		DestinationIp:      fm.Rule.DstIP,
+		MinSourcePort:      uint32(fm.Rule.SrcPortMin),
+		MaxSourcePort:      uint32(fm.Rule.SrcPortMax),
+		MinDestinationPort: uint32(fm.Rule.DstPortMin),
+		MaxDestinationPort: uint32(fm.Rule.DstPortMax),
+		Protocol:           uint32(fm.Rule.Proto),
+		PortName:           fm.Rule.InPort,
+		Preference:         fm.Rule.Pref,
+		SourceIpSet:        fm.Rule.SrcIPSet,
+		DestinationIpSet:   fm.Rule.DstIPSet,
+		Opts: &FwOptions{
+			Drop:             fm.Opts.Drop,
+			Trap:             fm.Opts.Trap,
+			Record:           fm.Opts.Record,
+			Redirect:         fm.Opts.Rdr,
+			RedirectPortName: fm.Opts.RdrPort,
+			Allow:            fm.Opts.Allow,
+			FwMark:           fm.Opts.Mark,
+			DoSnat:           fm.Opts.DoSnat,
+			ToIp:             fm.Opts.ToIP,
+			ToPort:           uint32(fm.Opts.ToPort),
+			OnDefault:        fm.Opts.OnDefault,
+			Counter:          fm.Opts.Counter,
+			ValidFrom:        fm.Opts.ValidFrom,
+			ExpiresAt:        fm.Opts.ExpiresAt,
+			Ttl:              fm.Opts.TTL,
+			TtlExpiresAt:     fm.Opts.TTLExpiresAt,
+			Packets:          fm.Stats.Packets,
+			Bytes:            fm.Stats.Bytes,
+			LastHit:          fm.Stats.LastHit,
+		},
+	}
+}
+
+// routeProtoStr - name of a route protocol
+func routeProtoStr(proto int) string {
+	switch proto {
+	case 0:
+		return "unspec"
+	case 1:
+		return "redirect"
+	case 2:
+		return "kernel"
+	case 3:
+		return "boot"
+	case 4:
+		return "static"
+	}
+	return strconv.Itoa(proto)
+}
+
+// routeGet2Route - convert cmn.RouteGet to a Route message
+func routeGet2Route(rt *cmn.RouteGet) *Route {
+	return &Route{
+		Destination:  rt.Dst,
+		Gateway:      rt.Gw,
+		Flags:        strings.TrimSpace(rt.Flags),
+		Protocol:     routeProtoStr(rt.Protocol),
+		HardwareMark: int64(rt.HardwareMark),
+		Bytes:        int64(rt.Statistic.Bytes),
+		Packets:      int64(rt.Statistic.Packets),
+		Sync:         int64(rt.Sync),
+	}
+}
+
+// neighMod2Neighbor - convert cmn.NeighMod to a Neighbor message
+func neighMod2Neighbor(nm *cmn.NeighMod) *Neighbor {
+	dev, _ := loxinlp.GetLinkNameByIndex(nm.LinkIndex)
+	return &Neighbor{
+		IpAddress:  nm.IP.String(),
+		Dev:        dev,
+		MacAddress: nm.HardwareAddr.String(),
+	}
+}
+
+// ciMod2State - convert cmn.HASMod to a CIState message
+func ciMod2State(hm *cmn.HASMod) *CIState {
+	vip := ""
+	if hm.Vip != nil {
+		vip = hm.Vip.String()
+	}
+	return &CIState{Instance: hm.Instance, State: hm.State, Vip: vip, CloudError: hm.CloudErr}
+}
+
+// ciState2Mod - convert a CIState message to cmn.HASMod
+func ciState2Mod(cs *CIState) cmn.HASMod {
+	return cmn.HASMod{Instance: cs.Instance, State: cs.State, Vip: net.ParseIP(cs.Vip)}
+}
+
+// bgpNeigh2Mod - convert a BGPNeighbor message to cmn.GoBGPNeighMod
+func bgpNeigh2Mod(bn *BGPNeighbor) cmn.GoBGPNeighMod {
+	return cmn.GoBGPNeighMod{
+		Addr:       net.ParseIP(bn.IpAddress),
+		RemoteAS:   bn.RemoteAs,
+		RemotePort: uint16(bn.RemotePort),
+		MultiHop:   bn.MultiHop,
+	}
+}
+
+// bgpNeighGet2Neigh - convert cmn.GoBGPNeighGetMod to a BGPNeighbor message
+func bgpNeighGet2Neigh(bn *cmn.GoBGPNeighGetMod) *BGPNeighbor {
+	return &BGPNeighbor{IpAddress: bn.Addr, RemoteAs: bn.RemoteAS, State: bn.State, Uptime: bn.Uptime}
+}
+
+// watchEv2Msg - convert cmn.WatchEvent to a WatchEvent message
+func watchEv2Msg(ev *cmn.WatchEvent) *WatchEvent {
+	m := &WatchEvent{
+		ResourceVersion: ev.Version,
+		Kind:            ev.Kind,
+		Type:            ev.Type,
+		Key:             ev.Key,
+		TimeUnixNano:    ev.Time.UnixNano(),
+	}
+	switch obj := ev.Object.(type) {
+	case cmn.LbRuleMod:
+		m.Object = &WatchEvent_LbRule{LbRule: lbMod2Rule(&obj)}
+	case cmn.LbServiceArg:
+		m.Object = &WatchEvent_LbService{LbService: lbArg2Serv(&obj)}
+	case cmn.EndPointMod:
+		m.Object = &WatchEvent_Endpoint{Endpoint: epMod2EP(&obj)}
+	case cmn.HASMod:
+		m.Object = &WatchEvent_CiState{CiState: ciMod2State(&obj)}
+	case cmn.GoBGPNeighGetMod:
+		m.Object = &WatchEvent_BgpNeighbor{BgpNeighbor: bgpNeighGet2Neigh(&obj)}
+	case cmn.PortStateMod:
+		m.Object = &WatchEvent_Port{Port: &Port{Name: obj.Name, Link: obj.Link, State: obj.State}}
+	}
+	return m
+}
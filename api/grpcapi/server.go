@@ -0,0 +1,456 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/loxilb-io/loxilb/api/loxinlp"
+	"github.com/loxilb-io/loxilb/api/restapi/handler"
+	cmn "github.com/loxilb-io/loxilb/common"
+	opts "github.com/loxilb-io/loxilb/options"
+	tk "github.com/loxilb-io/loxilib"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// This file implements the gRPC management api. It serves the same
+// NetHookInterface as the REST api and uses the same authentication
+
+// mgmtServer - implementation of the LoxiMgmt service
+type mgmtServer struct {
+	UnimplementedLoxiMgmtServer
+}
+
+var success = &Result{Result: "Success"}
+
+// grpcError - convert an api hook error to a gRPC status with the same
+// classification as the REST api
+func grpcError(err error) error {
+	code := codes.Internal
+	switch handler.ResultErrorResponseErrorMessage(err.Error()).Code {
+	case 400:
+		code = codes.InvalidArgument
+	case 401:
+		code = codes.Unauthenticated
+	case 403:
+		code = codes.FailedPrecondition
+	case 404:
+		code = codes.NotFound
+	case 409:
+		code = codes.AlreadyExists
+	case 503:
+		code = codes.Unavailable
+	}
+	return status.Error(code, err.Error())
+}
+
+// grpcResources - REST api path of the resource each call of the service works on
+var grpcResources = map[string]string{
+	"LbRule":      "/netlox/v1/config/loadbalancer",
+	"EndPoint":    "/netlox/v1/config/endpoint",
+	"FwRule":      "/netlox/v1/config/firewall",
+	"Route":       "/netlox/v1/config/route",
+	"Neighbor":    "/netlox/v1/config/neighbor",
+	"BGPNeighbor": "/netlox/v1/config/bgp/neigh",
+	"CIState":     "/netlox/v1/config/cistate",
+	"Param":       "/netlox/v1/config/params",
+}
+
+// grpcRESTEquiv - REST api method and path equivalent to a call of the
+// service, so that the same roles apply to both apis
+func grpcRESTEquiv(method string) (string, string) {
+	name := method[strings.LastIndex(method, "/")+1:]
+	if name == "Watch" {
+		return "GET", "/netlox/v1/config/watch"
+	}
+	for _, v := range []struct{ prefix, httpMethod string }{
+		{"Add", "POST"}, {"Set", "POST"}, {"Delete", "DELETE"}, {"List", "GET"}, {"Get", "GET"},
+	} {
+		if strings.HasPrefix(name, v.prefix) {
+			res := strings.TrimSuffix(strings.TrimPrefix(name, v.prefix), "s")
+			return v.httpMethod, grpcResources[res]
+		}
+	}
+	return "", ""
+}
+
+// grpcAuth - authenticate and authorize a call with the token in its
+// "authorization" metadata, in the same way as the REST api
+func grpcAuth(ctx context.Context, method string) error {
+	if !opts.Opts.UserServiceEnable && !opts.Opts.Oauth2Enable && !opts.Opts.ManualTokenEnable {
+		return nil
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			token = v[0]
+		}
+	}
+	if token == "" {
+		return status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	principal, err := handler.BearerAuthAuth(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if opts.Opts.UserServiceEnable || opts.Opts.Oauth2Enable {
+		httpMethod, path := grpcRESTEquiv(method)
+		if err := handler.RoleAuthorize(principal, httpMethod, path); err != nil {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+	return nil
+}
+
+func unaryAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h grpc.UnaryHandler) (interface{}, error) {
+	if err := grpcAuth(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return h(ctx, req)
+}
+
+func streamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, h grpc.StreamHandler) error {
+	if err := grpcAuth(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return h(srv, ss)
+}
+
+// AddLbRule - add a load-balancer rule
+func (s *mgmtServer) AddLbRule(ctx context.Context, r *LbRule) (*Result, error) {
+	lm := lbRule2Mod(r)
+	if lm.Serv.Mode == cmn.LBModeDSR && lm.Serv.Sel != cmn.LbSelHash && lm.Serv.Sel != cmn.LbSelMaglev {
+		return nil, status.Error(codes.InvalidArgument, "Only Hash or Maglev Selection criteria allowed for DSR mode")
+	}
+	if _, err := handler.ApiHooks.NetLbRuleAdd(&lm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// DeleteLbRule - delete a load-balancer rule
+func (s *mgmtServer) DeleteLbRule(ctx context.Context, serv *LbService) (*Result, error) {
+	lm := cmn.LbRuleMod{Serv: lbServ2Arg(serv)}
+	if _, err := handler.ApiHooks.NetLbRuleDel(&lm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// ListLbRules - stream all load-balancer rules
+func (s *mgmtServer) ListLbRules(_ *ListRequest, stream LoxiMgmt_ListLbRulesServer) error {
+	res, err := handler.ApiHooks.NetLbRuleGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(lbMod2Rule(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddEndPoint - add an end-point host
+func (s *mgmtServer) AddEndPoint(ctx context.Context, ep *EndPoint) (*Result, error) {
+	em := ep2Mod(ep)
+	if _, err := handler.ApiHooks.NetEpHostAdd(&em); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// DeleteEndPoint - delete an end-point host
+func (s *mgmtServer) DeleteEndPoint(ctx context.Context, ep *EndPoint) (*Result, error) {
+	em := ep2Mod(ep)
+	if _, err := handler.ApiHooks.NetEpHostDel(&em); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// ListEndPoints - stream all end-point hosts
+func (s *mgmtServer) ListEndPoints(_ *ListRequest, stream LoxiMgmt_ListEndPointsServer) error {
+	res, err := handler.ApiHooks.NetEpHostGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(epMod2EP(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddFwRule - add a firewall rule
+func (s *mgmtServer) AddFwRule(ctx context.Context, fw *FwRule) (*Result, error) {
+	fm := fw2Mod(fw)
+	if _, err := handler.ApiHooks.NetFwRuleAdd(&fm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// DeleteFwRule - delete a firewall rule
+func (s *mgmtServer) DeleteFwRule(ctx context.Context, fw *FwRule) (*Result, error) {
+	fm := fw2Mod(fw)
+	fm.Opts = cmn.FwOptArg{}
+	if _, err := handler.ApiHooks.NetFwRuleDel(&fm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// ListFwRules - stream all firewall rules
+func (s *mgmtServer) ListFwRules(_ *ListRequest, stream LoxiMgmt_ListFwRulesServer) error {
+	res, err := handler.ApiHooks.NetFwRuleGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(fwMod2Rule(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddRoute - add an ip route
+func (s *mgmtServer) AddRoute(ctx context.Context, rt *Route) (*Result, error) {
+	if rt.Destination == "" || rt.Gateway == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if err := loxinlp.AddRouteNoHook(rt.Destination, rt.Gateway, rt.Protocol); err != nil {
+		return nil, grpcError(err)
+	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindRoute, true,
+		loxinlp.NetConfigRec{Addr: rt.Destination, Gw: rt.Gateway, Proto: rt.Protocol})
+	return success, nil
+}
+
+// DeleteRoute - delete an ip route
+func (s *mgmtServer) DeleteRoute(ctx context.Context, rt *Route) (*Result, error) {
+	if rt.Destination == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if err := loxinlp.DelRouteNoHook(rt.Destination); err != nil {
+		return nil, grpcError(err)
+	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindRoute, false, loxinlp.NetConfigRec{Addr: rt.Destination})
+	return success, nil
+}
+
+// ListRoutes - stream all ip routes
+func (s *mgmtServer) ListRoutes(_ *ListRequest, stream LoxiMgmt_ListRoutesServer) error {
+	res, err := handler.ApiHooks.NetRouteGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(routeGet2Route(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddNeighbor - add an ip neighbor
+func (s *mgmtServer) AddNeighbor(ctx context.Context, nb *Neighbor) (*Result, error) {
+	if nb.IpAddress == "" || nb.Dev == "" || nb.MacAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if err := loxinlp.AddNeighNoHook(nb.IpAddress, nb.Dev, nb.MacAddress); err != nil {
+		return nil, grpcError(err)
+	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindNeigh, true,
+		loxinlp.NetConfigRec{Addr: nb.IpAddress, Dev: nb.Dev, Mac: nb.MacAddress})
+	return success, nil
+}
+
+// DeleteNeighbor - delete an ip neighbor
+func (s *mgmtServer) DeleteNeighbor(ctx context.Context, nb *Neighbor) (*Result, error) {
+	if nb.IpAddress == "" || nb.Dev == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if err := loxinlp.DelNeighNoHook(nb.IpAddress, nb.Dev); err != nil {
+		return nil, grpcError(err)
+	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindNeigh, false, loxinlp.NetConfigRec{Addr: nb.IpAddress, Dev: nb.Dev})
+	return success, nil
+}
+
+// ListNeighbors - stream all ip neighbors
+func (s *mgmtServer) ListNeighbors(_ *ListRequest, stream LoxiMgmt_ListNeighborsServer) error {
+	res, err := handler.ApiHooks.NetNeighGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(neighMod2Neighbor(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetCIState - set the HA state of a cluster instance
+func (s *mgmtServer) SetCIState(ctx context.Context, cs *CIState) (*Result, error) {
+	hm := ciState2Mod(cs)
+	if _, err := handler.ApiHooks.NetCIStateMod(&hm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// ListCIStates - stream HA states of all cluster instances
+func (s *mgmtServer) ListCIStates(_ *ListRequest, stream LoxiMgmt_ListCIStatesServer) error {
+	res, err := handler.ApiHooks.NetCIStateGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(ciMod2State(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddBGPNeighbor - add a goBGP neighbor
+func (s *mgmtServer) AddBGPNeighbor(ctx context.Context, bn *BGPNeighbor) (*Result, error) {
+	nm := bgpNeigh2Mod(bn)
+	if nm.Addr == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if _, err := handler.ApiHooks.NetGoBGPNeighAdd(&nm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// DeleteBGPNeighbor - delete a goBGP neighbor
+func (s *mgmtServer) DeleteBGPNeighbor(ctx context.Context, bn *BGPNeighbor) (*Result, error) {
+	nm := bgpNeigh2Mod(bn)
+	if nm.Addr == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid parameters")
+	}
+	if _, err := handler.ApiHooks.NetGoBGPNeighDel(&nm); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// ListBGPNeighbors - stream all goBGP neighbors
+func (s *mgmtServer) ListBGPNeighbors(_ *ListRequest, stream LoxiMgmt_ListBGPNeighborsServer) error {
+	res, err := handler.ApiHooks.NetGoBGPNeighGet()
+	if err != nil {
+		return grpcError(err)
+	}
+	for i := range res {
+		if err := stream.Send(bgpNeighGet2Neigh(&res[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetParams - set operational parameters
+func (s *mgmtServer) SetParams(ctx context.Context, p *Params) (*Result, error) {
+	if _, err := handler.ApiHooks.NetParamSet(cmn.ParamMod{LogLevel: p.LogLevel}); err != nil {
+		return nil, grpcError(err)
+	}
+	return success, nil
+}
+
+// GetParams - get operational parameters
+func (s *mgmtServer) GetParams(ctx context.Context, _ *ListRequest) (*Params, error) {
+	var param cmn.ParamMod
+	if _, err := handler.ApiHooks.NetParamGet(&param); err != nil {
+		return nil, grpcError(err)
+	}
+	return &Params{LogLevel: param.LogLevel}, nil
+}
+
+// Watch - stream change events, resuming after the given resource version
+func (s *mgmtServer) Watch(req *WatchRequest, stream LoxiMgmt_WatchServer) error {
+	evCh, cancel, err := handler.ApiHooks.NetWatch(req.Kinds, req.ResourceVersion)
+	if err != nil {
+		if strings.Contains(err.Error(), "expired") {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return grpcError(err)
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-evCh:
+			if !ok {
+				// The watcher fell behind. The client resumes from its last version
+				return status.Error(codes.Aborted, "watcher fell behind")
+			}
+			if err := stream.Send(watchEv2Msg(&ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunGRPCServer - routine to start the gRPC management api server
+func RunGRPCServer() error {
+	if handler.ApiHooks == nil {
+		return errors.New("grpc api hooks not registered")
+	}
+
+	sOpts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(unaryAuth),
+		grpc.StreamInterceptor(streamAuth),
+	}
+	if opts.Opts.TLS {
+		creds, err := credentials.NewServerTLSFromFile(string(opts.Opts.TLSCertificate), string(opts.Opts.TLSCertificateKey))
+		if err != nil {
+			tk.LogIt(tk.LogError, "gRPC api - tls credentials error : %v\n", err)
+			return err
+		}
+		sOpts = append(sOpts, grpc.Creds(creds))
+	}
+
+	lis, err := net.Listen("tcp", net.JoinHostPort(opts.Opts.Host, fmt.Sprintf("%d", opts.Opts.GRPCPort)))
+	if err != nil {
+		tk.LogIt(tk.LogError, "gRPC api - listen error : %v\n", err)
+		return err
+	}
+
+	grpcServer := grpc.NewServer(sOpts...)
+	RegisterLoxiMgmtServer(grpcServer, &mgmtServer{})
+	tk.LogIt(tk.LogNotice, "gRPC api - server started on %s\n", lis.Addr().String())
+	return grpcServer.Serve(lis)
+}
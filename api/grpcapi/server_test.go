@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/loxilb-io/loxilb/api/restapi/handler"
+	cmn "github.com/loxilb-io/loxilb/common"
+	opts "github.com/loxilb-io/loxilb/options"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeHooks stubs the few api hooks exercised here; any other call panics.
+type fakeHooks struct {
+	cmn.NetHookInterface
+	rules []cmn.LbRuleMod
+	evCh  chan cmn.WatchEvent
+}
+
+func (f *fakeHooks) NetLbRuleAdd(lm *cmn.LbRuleMod) (int, error) {
+	for _, r := range f.rules {
+		if r.Serv.ServIP == lm.Serv.ServIP && r.Serv.ServPort == lm.Serv.ServPort {
+			return -1, errors.New("lbrule-exists error")
+		}
+	}
+	f.rules = append(f.rules, *lm)
+	return 0, nil
+}
+
+func (f *fakeHooks) NetLbRuleGet() ([]cmn.LbRuleMod, error) {
+	return f.rules, nil
+}
+
+func (f *fakeHooks) NetWatch(kinds []string, since uint64) (<-chan cmn.WatchEvent, func(), error) {
+	if since > 100 {
+		return nil, nil, errors.New("watch resource-version expired error")
+	}
+	return f.evCh, func() {}, nil
+}
+
+func (f *fakeHooks) NetUserValidate(token string) (interface{}, error) {
+	// tokens are "user|role" as cached by the user service
+	if !strings.Contains(token, "|") {
+		return nil, errors.New("invalid token")
+	}
+	return token, nil
+}
+
+func (f *fakeHooks) NetRouteGet() ([]cmn.RouteGet, error) {
+	return nil, nil
+}
+
+func startTestServer(t *testing.T, hooks cmn.NetHookInterface) LoxiMgmtClient {
+	t.Helper()
+
+	handler.ApiHooks = hooks
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer(grpc.UnaryInterceptor(unaryAuth), grpc.StreamInterceptor(streamAuth))
+	RegisterLoxiMgmtServer(srv, &mgmtServer{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return NewLoxiMgmtClient(conn)
+}
+
+func TestLbRuleAddList(t *testing.T) {
+	c := startTestServer(t, &fakeHooks{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	rule := &LbRule{
+		Service:   &LbService{ExternalIp: "10.10.10.1", Port: 2020, Protocol: "tcp"},
+		Endpoints: []*LbEndPoint{{EndpointIp: "32.32.32.1", TargetPort: 5001, Weight: 1}},
+	}
+	if _, err := c.AddLbRule(ctx, rule); err != nil {
+		t.Fatalf("add lb rule: %v", err)
+	}
+	_, err := c.AddLbRule(ctx, rule)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("duplicate lb rule: got %v, want AlreadyExists", err)
+	}
+
+	stream, err := c.ListLbRules(ctx, &ListRequest{})
+	if err != nil {
+		t.Fatalf("list lb rules: %v", err)
+	}
+	var got []*LbRule
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("list lb rules recv: %v", err)
+		}
+		got = append(got, r)
+	}
+	if len(got) != 1 || got[0].Service.ExternalIp != "10.10.10.1" || len(got[0].Endpoints) != 1 ||
+		got[0].Endpoints[0].TargetPort != 5001 {
+		t.Fatalf("unexpected lb rules %v", got)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	hooks := &fakeHooks{evCh: make(chan cmn.WatchEvent, 1)}
+	c := startTestServer(t, hooks)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	hooks.evCh <- cmn.WatchEvent{Version: 7, Kind: cmn.WatchKindLB, Type: cmn.WatchEvAdded, Key: "k",
+		Time: time.Now(), Object: cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.1"}}}
+	stream, err := c.Watch(ctx, &WatchRequest{})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	ev, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("watch recv: %v", err)
+	}
+	if ev.ResourceVersion != 7 || ev.GetLbRule().GetService().GetExternalIp() != "10.10.10.1" {
+		t.Fatalf("unexpected watch event %v", ev)
+	}
+
+	stream, err = c.Watch(ctx, &WatchRequest{ResourceVersion: 1000})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.OutOfRange {
+		t.Fatalf("expired watch: got %v, want OutOfRange", err)
+	}
+}
+
+func TestManualTokenAuth(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "manual_token")
+	if err := os.WriteFile(path, []byte("secret\n"), 0o600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+	opts.Opts.ManualTokenEnable = true
+	opts.Opts.ManualTokenPath = path
+	defer func() { opts.Opts.ManualTokenEnable = false }()
+
+	c := startTestServer(t, &fakeHooks{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := c.AddLbRule(ctx, &LbRule{Service: &LbService{ExternalIp: "10.10.10.1", Port: 80, Protocol: "tcp"}})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("no token: got %v, want Unauthenticated", err)
+	}
+
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "secret")
+	if _, err := c.AddLbRule(ctx, &LbRule{Service: &LbService{ExternalIp: "10.10.10.1", Port: 80, Protocol: "tcp"}}); err != nil {
+		t.Fatalf("with token: %v", err)
+	}
+}
+
+func TestRoleAuth(t *testing.T) {
+	opts.Opts.UserServiceEnable = true
+	defer func() { opts.Opts.UserServiceEnable = false }()
+
+	c := startTestServer(t, &fakeHooks{})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	rule := &LbRule{Service: &LbService{ExternalIp: "10.10.10.1", Port: 80, Protocol: "tcp"}}
+	opCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "ops|lb-operator")
+	if _, err := c.AddLbRule(opCtx, rule); err != nil {
+		t.Fatalf("lb-operator add lb rule: %v", err)
+	}
+	_, err := c.AddRoute(opCtx, &Route{Destination: "10.0.0.0/8", Gateway: "1.1.1.1"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("lb-operator add route: got %v, want PermissionDenied", err)
+	}
+
+	netCtx := metadata.AppendToOutgoingContext(ctx, "authorization", "net|network-admin")
+	_, err = c.AddLbRule(netCtx, rule)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("network-admin add lb rule: got %v, want PermissionDenied", err)
+	}
+	stream, err := c.ListRoutes(netCtx, &ListRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if err != io.EOF {
+		t.Fatalf("network-admin list routes: got %v, want EOF", err)
+	}
+}
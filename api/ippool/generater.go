@@ -17,7 +17,9 @@ package ippool
 
 import (
 	"fmt"
+	"math/big"
 	"net"
+	"sync"
 	"sync/atomic"
 )
 
@@ -32,6 +34,14 @@ type IPGenerater struct {
 	netCIDR      *net.IPNet
 	uintMaskBits uint32
 	counter      uint32
+
+	// IPv6 subnets don't fit in 32 bits, so they walk the host part
+	// with a big.Int counter instead
+	v6        bool
+	v6Base    *big.Int
+	v6Size    *big.Int
+	v6Counter *big.Int
+	v6Mtx     sync.Mutex
 }
 
 func InitIPGenerater(cidr string) (*IPGenerater, error) {
@@ -40,7 +50,17 @@ func InitIPGenerater(cidr string) (*IPGenerater, error) {
 		return nil, fmt.Errorf("invalid CIDR format")
 	}
 
-	maskbit, _ := ipn.Mask.Size()
+	maskbit, bits := ipn.Mask.Size()
+	if bits == 128 {
+		return &IPGenerater{
+			netCIDR:   ipn,
+			v6:        true,
+			v6Base:    new(big.Int).SetBytes(ipn.IP.To16()),
+			v6Size:    new(big.Int).Lsh(big.NewInt(1), uint(bits-maskbit)),
+			v6Counter: new(big.Int),
+		}, nil
+	}
+
 	uintMaskBits := uint32(0xFFFFFFFF >> maskbit)
 
 	return &IPGenerater{
@@ -49,7 +69,31 @@ func InitIPGenerater(cidr string) (*IPGenerater, error) {
 	}, nil
 }
 
+// IsIPv6 returns true if the generater walks an IPv6 subnet
+func (i *IPGenerater) IsIPv6() bool {
+	return i.v6
+}
+
+func (i *IPGenerater) nextIPv6() net.IP {
+	i.v6Mtx.Lock()
+	off := new(big.Int).Mod(i.v6Counter, i.v6Size)
+	i.v6Counter.Add(i.v6Counter, big.NewInt(1))
+	i.v6Mtx.Unlock()
+
+	return bigToIPv6(new(big.Int).Add(i.v6Base, off))
+}
+
+func bigToIPv6(n *big.Int) net.IP {
+	ip := make(net.IP, net.IPv6len)
+	n.FillBytes(ip)
+	return ip
+}
+
 func (i *IPGenerater) NextIP() net.IP {
+	if i.v6 {
+		return i.nextIPv6()
+	}
+
 	counter := i.counter
 	for !atomic.CompareAndSwapUint32(&i.counter, counter, counter+1) {
 		counter = i.counter
@@ -66,6 +110,11 @@ func (i *IPGenerater) NextIP() net.IP {
 }
 
 func (i *IPGenerater) GetBroadcastIP() net.IP {
+	if i.v6 {
+		// IPv6 has no broadcast, hand back the last address of the subnet
+		last := new(big.Int).Add(i.v6Base, i.v6Size)
+		return bigToIPv6(last.Sub(last, big.NewInt(1)))
+	}
 	return net.IP{
 		byte(uint32(i.netCIDR.IP[0]) | (i.uintMaskBits)>>24),
 		byte(uint32(i.netCIDR.IP[1]) | (i.uintMaskBits)>>16),
@@ -21,6 +21,8 @@ import (
 	"sync"
 )
 
+// IPPool - pool of addresses carved out of a single subnet. Despite the
+// field names, the subnet may be IPv4 or IPv6.
 type IPPool struct {
 	IPv4Generator *IPGenerater
 	IPv4Pool      *IPSet
@@ -36,7 +38,9 @@ func NewIPPool(netCIDR string) (*IPPool, error) {
 
 	poolIPv4 := NewSet()
 	poolIPv4.Add(genIPv4.GetNetwork().String())
-	poolIPv4.Add(genIPv4.GetBroadcastIP().String())
+	if !genIPv4.IsIPv6() {
+		poolIPv4.Add(genIPv4.GetBroadcastIP().String())
+	}
 
 	return &IPPool{
 		IPv4Generator: genIPv4,
@@ -49,6 +53,11 @@ func NewIPPool(netCIDR string) (*IPPool, error) {
 // If IP is already in pool, try to generate next IP.
 // Returns nil If all IPs in the subnet are already in the pool.
 func (i *IPPool) AssignNewIPv4() net.IP {
+	return i.AssignNewIP()
+}
+
+// AssignNewIP is the address family agnostic variant of AssignNewIPv4
+func (i *IPPool) AssignNewIP() net.IP {
 	startNewIP := i.IPv4Generator.NextIP()
 
 	i.mutex.Lock()
@@ -77,6 +86,11 @@ func (i *IPPool) AssignNewIPv4() net.IP {
 
 // RetrieveIPv4 remove key(IP) in IP Pool
 func (i *IPPool) RetrieveIPv4(retrieveIP string) {
+	i.RetrieveIP(retrieveIP)
+}
+
+// RetrieveIP is the address family agnostic variant of RetrieveIPv4
+func (i *IPPool) RetrieveIP(retrieveIP string) {
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
 
@@ -85,6 +99,23 @@ func (i *IPPool) RetrieveIPv4(retrieveIP string) {
 	}
 }
 
+// ReserveIP marks a specific IP as allocated. It returns false if the IP
+// is outside the pool's subnet or is already allocated.
+func (i *IPPool) ReserveIP(ip string) bool {
+	if !i.IPv4Generator.CheckIPAddressInSubnet(ip) {
+		return false
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if ok := i.IPv4Pool.Contains(ip); ok {
+		return false
+	}
+	i.IPv4Pool.Add(ip)
+	return true
+}
+
 func (i *IPPool) UpdateAllocateddIPv4(allocatedIP string) {
 	i.mutex.Lock()
 	defer i.mutex.Unlock()
@@ -114,6 +114,9 @@ func NlpIsBlackListedIntf(name string, masterIdx int) bool {
 }
 
 func applyAllConfig(name string) bool {
+	if nlpStore != nil {
+		return applyStoreConfig(name)
+	}
 
 	command := "loxicmd apply --per-intf " + name + " -c " + opt.Opts.ConfigPath + "/ipconfig/"
 	cmd := exec.Command("bash", "-c", command)
@@ -148,6 +151,28 @@ func applyLoadBalancerConfig() bool {
 	return true
 }
 
+func applyIPPoolConfig() bool {
+	var resp struct {
+		Attr []cmn.IPPoolMod `json:"ipPoolAttr"`
+	}
+	dpath := opt.Opts.ConfigPath + "/IPAMconfig.txt"
+	byteBuf, err := os.ReadFile(dpath)
+	if err != nil {
+		tk.LogIt(tk.LogError, "nlp: Failed to read IPAM Config file: %v\n", err)
+		return false
+	}
+
+	// Unmarshal to Json
+	if err := json.Unmarshal(byteBuf, &resp); err != nil {
+		tk.LogIt(tk.LogError, "nlp: Failed to Unmarshal File: %v\n", err)
+		return false
+	}
+	for _, pool := range resp.Attr {
+		hooks.NetIPPoolAdd(&pool)
+	}
+	return true
+}
+
 func applySessionConfig() bool {
 	var resp struct {
 		Attr []cmn.SessionMod `json:"sessionAttr"`
@@ -260,6 +285,10 @@ func ApplyBFDConfig() bool {
 
 func applyRoutes(name string) {
 	tk.LogIt(tk.LogDebug, "nlp: Applying Route Config for %s \n", name)
+	if nlpStore != nil {
+		applyStoreRoutes(name)
+		return
+	}
 	command := "loxicmd apply --per-intf " + name + " -r -c " + opt.Opts.ConfigPath + "/ipconfig/"
 	cmd := exec.Command("bash", "-c", command)
 	output, err := cmd.Output()
@@ -275,7 +304,7 @@ func applyConfigMap(name string, state bool, add bool) {
 	var needRouteApply bool
 	dpath := opt.Opts.ConfigPath + "/ipconfig/"
 
-	if _, err := os.Stat(dpath); errors.Is(err, os.ErrNotExist) {
+	if _, err := os.Stat(dpath); nlpStore == nil && errors.Is(err, os.ErrNotExist) {
 		return
 	}
 	if add {
@@ -1698,6 +1727,15 @@ func LbSessionGet(done bool) int {
 
 	if done {
 
+		if _, err := os.Stat(opt.Opts.ConfigPath + "/IPAMconfig.txt"); errors.Is(err, os.ErrNotExist) {
+			if err != nil {
+				tk.LogIt(tk.LogInfo, "nlp: Continuing without IPAM config file : %s \n", err.Error())
+			}
+		} else {
+			applyIPPoolConfig()
+		}
+		tk.LogIt(tk.LogInfo, "nlp: IPAM config done\n")
+
 		if _, err := os.Stat(opt.Opts.ConfigPath + "/EPconfig.txt"); errors.Is(err, os.ErrNotExist) {
 			if err != nil {
 				tk.LogIt(tk.LogInfo, "nlp: Continuing without EP config file: %s\n", err.Error())
@@ -1812,11 +1850,18 @@ func NlpInit(bgpPeerMode bool, blackList, whitelist string, ipvsCompat bool) *Nl
 
 	tk.LogIt(tk.LogInfo, "nlp: NLP Subscription done\n")
 
+	// Persisted config replaces the legacy config files
+	if nlpStore != nil {
+		applyStoreBridges()
+	}
+
 	go NlpGet(checkInit)
 	done := <-checkInit
 	waitInit <- true
 
-	go LbSessionGet(done)
+	if nlpStore == nil {
+		go LbSessionGet(done)
+	}
 
 	if ipvsCompat {
 		IPVSInit()
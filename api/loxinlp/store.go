@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"github.com/loxilb-io/loxilb/pkg/store"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// Kinds of network config kept in the config store
+const (
+	StoreKindVlan       = "vlan"
+	StoreKindVlanMember = "vlanmember"
+	StoreKindVxlan      = "vxlan"
+	StoreKindVxlanPeer  = "vxlanpeer"
+	StoreKindAddr       = "addr"
+	StoreKindFdb        = "fdb"
+	StoreKindNeigh      = "neighbor"
+	StoreKindRoute      = "route"
+)
+
+// NetConfigRec - network config made through the api, as kept in the config store
+type NetConfigRec struct {
+	// ID - vlan or vxlan id
+	ID int `json:"id,omitempty"`
+	// Dev - interface the config belongs to
+	Dev string `json:"dev,omitempty"`
+	// Addr - ip address, prefix or vxlan peer
+	Addr string `json:"addr,omitempty"`
+	// Mac - mac address
+	Mac string `json:"mac,omitempty"`
+	// Gw - route gateway
+	Gw string `json:"gw,omitempty"`
+	// Proto - route protocol
+	Proto string `json:"proto,omitempty"`
+	// Tagged - tagged vlan member
+	Tagged bool `json:"tagged,omitempty"`
+}
+
+// nlpStore - config store, nil unless config persistence is enabled
+var nlpStore *store.Store
+
+// NlpStoreRegister - Register the config store used to persist network config
+func NlpStoreRegister(s *store.Store) {
+	nlpStore = s
+}
+
+// normPrefix - normalize an address or prefix so that keys of the same
+// config always match
+func normPrefix(p string, host bool) string {
+	ip, ipn, err := net.ParseCIDR(p)
+	if err != nil {
+		return p
+	}
+	if host {
+		ones, _ := ipn.Mask.Size()
+		return fmt.Sprintf("%s/%d", ip.String(), ones)
+	}
+	return ipn.String()
+}
+
+// netConfigKey - key of a network config record
+func netConfigKey(kind string, r *NetConfigRec) string {
+	switch kind {
+	case StoreKindVlan, StoreKindVxlan:
+		return fmt.Sprintf("%d", r.ID)
+	case StoreKindVlanMember:
+		return fmt.Sprintf("%d/%s/%v", r.ID, r.Dev, r.Tagged)
+	case StoreKindVxlanPeer:
+		return fmt.Sprintf("%d/%s", r.ID, r.Addr)
+	case StoreKindAddr:
+		return normPrefix(r.Addr, true) + "/" + r.Dev
+	case StoreKindFdb:
+		return r.Mac + "/" + r.Dev
+	case StoreKindNeigh:
+		return r.Addr + "/" + r.Dev
+	case StoreKindRoute:
+		return normPrefix(r.Addr, false)
+	}
+	return ""
+}
+
+// NetConfigPersist - Persist a network config change which was made through the api.
+// Removing a vlan or vxlan also removes its members or peers.
+func NetConfigPersist(kind string, add bool, r NetConfigRec) {
+	if nlpStore == nil {
+		return
+	}
+
+	var err error
+	if add {
+		err = nlpStore.Put(kind, netConfigKey(kind, &r), r)
+	} else {
+		err = nlpStore.Delete(kind, netConfigKey(kind, &r))
+		sub := ""
+		if kind == StoreKindVlan {
+			sub = StoreKindVlanMember
+		} else if kind == StoreKindVxlan {
+			sub = StoreKindVxlanPeer
+		}
+		for _, sr := range nlpNetConfigList(sub) {
+			if sr.ID != r.ID {
+				continue
+			}
+			if serr := nlpStore.Delete(sub, netConfigKey(sub, &sr)); serr != nil && err == nil {
+				err = serr
+			}
+		}
+	}
+	if err != nil {
+		tk.LogIt(tk.LogError, "nlp: failed to persist %s %v : %s\n", kind, r, err)
+	}
+}
+
+// nlpNetConfigList - get the persisted network config of a kind
+func nlpNetConfigList(kind string) []NetConfigRec {
+	var res []NetConfigRec
+	if nlpStore == nil || kind == "" {
+		return res
+	}
+	for _, sr := range nlpStore.List(kind) {
+		var r NetConfigRec
+		if err := json.Unmarshal(sr.Val, &r); err != nil {
+			tk.LogIt(tk.LogError, "nlp: bad %s record %s in store : %s\n", kind, sr.Key, err)
+			continue
+		}
+		res = append(res, r)
+	}
+	return res
+}
+
+// applyStoreBridges - create the persisted vlan bridges. Everything else is
+// tied to an interface and gets applied once that interface shows up
+func applyStoreBridges() {
+	for _, r := range nlpNetConfigList(StoreKindVlan) {
+		if err := AddVLANNoHook(r.ID); err != nil {
+			tk.LogIt(tk.LogDebug, "nlp: store vlan %d : %s\n", r.ID, err)
+		}
+	}
+}
+
+// applyStoreConfig - apply the persisted network config of an interface
+func applyStoreConfig(name string) bool {
+	ok := true
+
+	for _, r := range nlpNetConfigList(StoreKindVxlan) {
+		if r.Dev == name {
+			if ret := AddVxLANBridgeNoHook(r.ID, r.Dev); ret != 0 {
+				tk.LogIt(tk.LogError, "nlp: store vxlan %d on %s failed (%d)\n", r.ID, r.Dev, ret)
+				ok = false
+			}
+		}
+	}
+	for _, r := range nlpNetConfigList(StoreKindVlanMember) {
+		if r.Dev == name {
+			if err := AddVLANMemberNoHook(r.ID, r.Dev, r.Tagged); err != nil {
+				tk.LogIt(tk.LogError, "nlp: store vlan %d member %s failed : %s\n", r.ID, r.Dev, err)
+				ok = false
+			}
+		}
+	}
+	for _, r := range nlpNetConfigList(StoreKindVxlanPeer) {
+		if fmt.Sprintf("vxlan%d", r.ID) == name {
+			if ret := AddVxLANPeerNoHook(r.ID, r.Addr); ret != 0 {
+				tk.LogIt(tk.LogError, "nlp: store vxlan %d peer %s failed (%d)\n", r.ID, r.Addr, ret)
+				ok = false
+			}
+		}
+	}
+	for _, r := range nlpNetConfigList(StoreKindAddr) {
+		if r.Dev == name {
+			if ret := AddAddrNoHook(r.Addr, r.Dev); ret != 0 {
+				tk.LogIt(tk.LogError, "nlp: store address %s on %s failed (%d)\n", r.Addr, r.Dev, ret)
+				ok = false
+			}
+		}
+	}
+	for _, r := range nlpNetConfigList(StoreKindFdb) {
+		if r.Dev == name {
+			if err := AddFDBNoHook(r.Mac, r.Dev); err != nil {
+				tk.LogIt(tk.LogError, "nlp: store fdb %s on %s failed : %s\n", r.Mac, r.Dev, err)
+				ok = false
+			}
+		}
+	}
+	for _, r := range nlpNetConfigList(StoreKindNeigh) {
+		if r.Dev == name {
+			if err := AddNeighNoHook(r.Addr, r.Dev, r.Mac); err != nil {
+				tk.LogIt(tk.LogError, "nlp: store neighbor %s on %s failed : %s\n", r.Addr, r.Dev, err)
+				ok = false
+			}
+		}
+	}
+	applyStoreRoutes(name)
+	return ok
+}
+
+// applyStoreRoutes - apply the persisted routes. Routes aren't tied to an
+// interface, so the ones whose gateway isn't reachable yet simply fail and
+// are tried again when the next interface comes up
+func applyStoreRoutes(name string) {
+	for _, r := range nlpNetConfigList(StoreKindRoute) {
+		if err := AddRouteNoHook(r.Addr, r.Gw, r.Proto); err != nil {
+			tk.LogIt(tk.LogDebug, "nlp: store route %s via %s (%s) : %s\n", r.Addr, r.Gw, name, err)
+		}
+	}
+}
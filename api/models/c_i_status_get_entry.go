@@ -19,6 +19,9 @@ import (
 // swagger:model CIStatusGetEntry
 type CIStatusGetEntry struct {
 
+	// Last failed cloud hook operation, if any
+	CloudError string `json:"cloudError,omitempty"`
+
 	// Instance name
 	Instance string `json:"instance,omitempty"`
 
@@ -20,6 +20,9 @@ import (
 // swagger:model EndPoint
 type EndPoint struct {
 
+	// Number of successful probes before an inactive end-point is marked active again
+	ActiveReTries int64 `json:"activeReTries,omitempty"`
+
 	// Host name in CIDR
 	// Required: true
 	HostName *string `json:"hostName"`
@@ -30,9 +33,42 @@ type EndPoint struct {
 	// Endpoint Identifier
 	Name string `json:"name,omitempty"`
 
+	// Max duration (in seconds) between probes of an inactive end-point. It doubles on each failed probe till this max
+	ProbeBackoffMax int64 `json:"probeBackoffMax,omitempty"`
+
+	// Regex the response body of http/https probes has to match
+	ProbeBodyRegex string `json:"probeBodyRegex,omitempty"`
+
 	// How frequently to probe in seconds
 	ProbeDuration int64 `json:"probeDuration,omitempty"`
 
+	// Check command of exec probes and its arguments. The command has to be in /opt/loxilb/probe. The end-point ip and port are passed as last arguments
+	ProbeExec string `json:"probeExec,omitempty"`
+
+	// Set the host state (green, yellow or red) from the first line printed by the check command of exec probes
+	ProbeExecState bool `json:"probeExecState,omitempty"`
+
+	// Service checked by grpc probes. The overall health of the server is checked if not given
+	ProbeGrpcService string `json:"probeGrpcService,omitempty"`
+
+	// Extra headers of http/https probes as "Name: value"
+	ProbeHeaders []string `json:"probeHeaders"`
+
+	// Host header of http/https probes and TLS server name (SNI) of https/grpc probes
+	ProbeHost string `json:"probeHost,omitempty"`
+
+	// Random jitter (in percent, max 50) of the duration between probes
+	ProbeJitter int64 `json:"probeJitter,omitempty"`
+
+	// Path of a value in the json response body of http/https probes e.g "status" or "checks[0].state"
+	ProbeJSONPath string `json:"probeJsonPath,omitempty"`
+
+	// Expected value at probeJsonPath. The path only has to exist if not given
+	ProbeJSONValue string `json:"probeJsonValue,omitempty"`
+
+	// Method of http/https probes, GET if not given
+	ProbeMethod string `json:"probeMethod,omitempty"`
+
 	// The l4port to probe on
 	ProbePort int64 `json:"probePort,omitempty"`
 
@@ -42,8 +78,17 @@ type EndPoint struct {
 	// Response for http/https probes
 	ProbeResp string `json:"probeResp,omitempty"`
 
+	// Status codes accepted by http/https probes e.g "200-399" or "200,204". Redirects are followed and only 200 is accepted if not given
+	ProbeStatus string `json:"probeStatus,omitempty"`
+
+	// Timeout of http/https/grpc probes in seconds
+	ProbeTimeout int64 `json:"probeTimeout,omitempty"`
+
+	// Use TLS for grpc probes
+	ProbeTLS bool `json:"probeTls,omitempty"`
+
 	// Type of probe used
-	// Enum: [tcp udp sctp ping http https none]
+	// Enum: [tcp udp sctp ping http https grpc exec none]
 	ProbeType string `json:"probeType,omitempty"`
 }
 
@@ -78,7 +123,7 @@ var endPointTypeProbeTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","ping","http","https","none"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","ping","http","https","grpc","exec","none"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -106,6 +151,12 @@ const (
 	// EndPointProbeTypeHTTPS captures enum value "https"
 	EndPointProbeTypeHTTPS string = "https"
 
+	// EndPointProbeTypeGrpc captures enum value "grpc"
+	EndPointProbeTypeGrpc string = "grpc"
+
+	// EndPointProbeTypeExec captures enum value "exec"
+	EndPointProbeTypeExec string = "exec"
+
 	// EndPointProbeTypeNone captures enum value "none"
 	EndPointProbeTypeNone string = "none"
 )
@@ -20,6 +20,9 @@ type FirewallOptionEntry struct {
 	// Allow any matching rule
 	Allow bool `json:"allow,omitempty"`
 
+	// Bytes which matched the rule (read-only)
+	Bytes uint64 `json:"bytes,omitempty"`
+
 	// traffic counters
 	Counter string `json:"counter,omitempty"`
 
@@ -29,12 +32,21 @@ type FirewallOptionEntry struct {
 	// Drop any matching rule
 	Drop bool `json:"drop,omitempty"`
 
+	// Time (unix seconds) at which the rule is removed, 0 - never
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+
 	// Set a fwmark for any matching rule
 	FwMark int64 `json:"fwMark,omitempty"`
 
+	// Time the rule was last seen matching in unix seconds, 0 if never (read-only)
+	LastHit int64 `json:"lastHit,omitempty"`
+
 	// Trigger only on default cases
 	OnDefault bool `json:"onDefault,omitempty"`
 
+	// Packets which matched the rule (read-only)
+	Packets uint64 `json:"packets,omitempty"`
+
 	// Record or dump for matching rule
 	Record bool `json:"record,omitempty"`
 
@@ -52,6 +64,15 @@ type FirewallOptionEntry struct {
 
 	// Trap anything matching rule
 	Trap bool `json:"trap,omitempty"`
+
+	// Seconds after which the rule is removed once installed
+	TTL int64 `json:"ttl,omitempty"`
+
+	// Time (unix seconds) the TTL of the rule runs out (read-only)
+	TTLExpiresAt int64 `json:"ttlExpiresAt,omitempty"`
+
+	// Time (unix seconds) from which the rule is installed, 0 - now
+	ValidFrom int64 `json:"validFrom,omitempty"`
 }
 
 // Validate validates this firewall option entry
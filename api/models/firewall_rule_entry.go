@@ -20,6 +20,9 @@ type FirewallRuleEntry struct {
 	// Destination IP in CIDR notation
 	DestinationIP string `json:"destinationIP,omitempty"`
 
+	// Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused
+	DestinationIPSet string `json:"destinationIPSet,omitempty"`
+
 	// Maximum  destination port range
 	MaxDestinationPort int64 `json:"maxDestinationPort,omitempty"`
 
@@ -43,6 +46,9 @@ type FirewallRuleEntry struct {
 
 	// Source IP in CIDR notation
 	SourceIP string `json:"sourceIP,omitempty"`
+
+	// Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused
+	SourceIPSet string `json:"sourceIPSet,omitempty"`
 }
 
 // Validate validates this firewall rule entry
@@ -286,8 +286,20 @@ func (m *LoadbalanceEntry) UnmarshalBinary(b []byte) error {
 // swagger:model LoadbalanceEntryAllowedSourcesItems0
 type LoadbalanceEntryAllowedSourcesItems0 struct {
 
+	// Time (unix seconds) at which the source is removed, 0 - never
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+
 	// IP address for allowed source access
 	Prefix string `json:"prefix,omitempty"`
+
+	// Seconds after which the source is removed once allowed
+	TTL int64 `json:"ttl,omitempty"`
+
+	// Time (unix seconds) the TTL of the source runs out (read-only)
+	TTLExpiresAt int64 `json:"ttlExpiresAt,omitempty"`
+
+	// Time (unix seconds) from which the source is allowed, 0 - now
+	ValidFrom int64 `json:"validFrom,omitempty"`
 }
 
 // Validate validates this loadbalance entry allowed sources items0
@@ -492,6 +504,18 @@ type LoadbalanceEntryServiceArguments struct {
 	// Enum: [0 1 2]
 	Oper int32 `json:"oper,omitempty"`
 
+	// Consecutive connection failures after which an end-point is ejected by outlier detection (0 - disabled)
+	OutlierConsecutiveErrors int32 `json:"outlierConsecutiveErrors,omitempty"`
+
+	// Base ejection time (in seconds) of outlier end-points, doubled on each ejection
+	OutlierEjectTime uint32 `json:"outlierEjectTime,omitempty"`
+
+	// Connection failure percentage after which an end-point is ejected by outlier detection (0 - disabled)
+	OutlierErrorRate uint8 `json:"outlierErrorRate,omitempty"`
+
+	// Max percentage of end-points of the rule ejected at once
+	OutlierMaxEjectPercent uint8 `json:"outlierMaxEjectPercent,omitempty"`
+
 	// (Min) port number for the access
 	// Required: true
 	Port *int64 `json:"port"`
@@ -505,20 +529,23 @@ type LoadbalanceEntryServiceArguments struct {
 	// value for probe retries
 	ProbeRetries int32 `json:"probeRetries,omitempty"`
 
+	// number of successful probes before an end-point is marked active again
+	ProbeRise int32 `json:"probeRise,omitempty"`
+
 	// value for probe timer (in seconds)
 	ProbeTimeout uint32 `json:"probeTimeout,omitempty"`
 
 	// probe port if probetype is tcp/udp/sctp
 	Probeport uint16 `json:"probeport,omitempty"`
 
-	// probe request string
+	// probe request string or check command of exec probes
 	Probereq string `json:"probereq,omitempty"`
 
 	// probe response string
 	Proberesp string `json:"proberesp,omitempty"`
 
 	// probe type for any end-point of this entry
-	// Enum: [tcp udp sctp http https ping none]
+	// Enum: [tcp udp sctp http https ping grpc exec none]
 	Probetype string `json:"probetype,omitempty"`
 
 	// value for access protocol
@@ -532,10 +559,13 @@ type LoadbalanceEntryServiceArguments struct {
 	// Enum: [0 1 2]
 	Security int32 `json:"security,omitempty"`
 
-	// value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 0-default)
-	// Enum: [0 1 2 3 4 5 6]
+	// value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 7-maglev, 8-wlc, 9-lrt with monitor, 0-default)
+	// Enum: [0 1 2 3 4 5 6 7 8 9]
 	Sel int64 `json:"sel,omitempty"`
 
+	// slow-start window (in seconds) over which a recovered end-point ramps up to its full weight
+	SlowStart uint32 `json:"slowStart,omitempty"`
+
 	// snat rule
 	Snat bool `json:"snat,omitempty"`
 }
@@ -670,7 +700,7 @@ var loadbalanceEntryServiceArgumentsTypeProbetypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","http","https","ping","none"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["tcp","udp","sctp","http","https","ping","grpc","exec","none"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -698,6 +728,12 @@ const (
 	// LoadbalanceEntryServiceArgumentsProbetypePing captures enum value "ping"
 	LoadbalanceEntryServiceArgumentsProbetypePing string = "ping"
 
+	// LoadbalanceEntryServiceArgumentsProbetypeGrpc captures enum value "grpc"
+	LoadbalanceEntryServiceArgumentsProbetypeGrpc string = "grpc"
+
+	// LoadbalanceEntryServiceArgumentsProbetypeExec captures enum value "exec"
+	LoadbalanceEntryServiceArgumentsProbetypeExec string = "exec"
+
 	// LoadbalanceEntryServiceArgumentsProbetypeNone captures enum value "none"
 	LoadbalanceEntryServiceArgumentsProbetypeNone string = "none"
 )
@@ -808,7 +844,7 @@ var loadbalanceEntryServiceArgumentsTypeSelPropEnum []interface{}
 
 func init() {
 	var res []int64
-	if err := json.Unmarshal([]byte(`[0,1,2,3,4,5,6]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`[0,1,2,3,4,5,6,7,8,9]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -7,7 +7,6 @@ package models
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -30,8 +29,7 @@ type User struct {
 	// Required: true
 	Password *string `json:"password"`
 
-	// role
-	// Enum: [admin viewer]
+	// Role of the user. One of the built-in roles admin, viewer, auditor, lb-operator, network-admin or a role from the RBAC config
 	Role string `json:"role,omitempty"`
 
 	// username
@@ -47,10 +45,6 @@ func (m *User) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateRole(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validateUsername(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,48 +64,6 @@ func (m *User) validatePassword(formats strfmt.Registry) error {
 	return nil
 }
 
-var userTypeRolePropEnum []interface{}
-
-func init() {
-	var res []string
-	if err := json.Unmarshal([]byte(`["admin","viewer"]`), &res); err != nil {
-		panic(err)
-	}
-	for _, v := range res {
-		userTypeRolePropEnum = append(userTypeRolePropEnum, v)
-	}
-}
-
-const (
-
-	// UserRoleAdmin captures enum value "admin"
-	UserRoleAdmin string = "admin"
-
-	// UserRoleViewer captures enum value "viewer"
-	UserRoleViewer string = "viewer"
-)
-
-// prop value enum
-func (m *User) validateRoleEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, userTypeRolePropEnum, true); err != nil {
-		return err
-	}
-	return nil
-}
-
-func (m *User) validateRole(formats strfmt.Registry) error {
-	if swag.IsZero(m.Role) { // not required
-		return nil
-	}
-
-	// value enum
-	if err := m.validateRoleEnum("role", "body", m.Role); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *User) validateUsername(formats strfmt.Registry) error {
 
 	if err := validate.Required("username", "body", m.Username); err != nil {
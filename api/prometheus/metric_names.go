@@ -47,6 +47,10 @@ const (
 	// into a utilization ratio.
 	MetricConntrackMaxEntries = "loxilb_conntrack_max_entries"
 
+	// Rate of end-point health probes. Canonical-only: it shows whether
+	// probe load is spread evenly over time.
+	MetricEndpointProbeRate = "loxilb_endpoint_probes_per_second"
+
 	LegacyMetricActiveFlowCountTCP  = "active_flow_count_tcp"
 	MetricActiveFlowCountTCP        = "loxilb_active_flow_count_tcp"
 	LegacyMetricActiveFlowCountUDP  = "active_flow_count_udp"
@@ -1068,20 +1068,6 @@ func parseCounterPacketsBytes(counter string) (packets, bytes uint64, ok bool) {
 	return p, b, true
 }
 
-// parseFwCounterPackets parses the same "packets:bytes" data-plane counter and
-// returns only the packet count, which is what a firewall drop counter means.
-func parseFwCounterPackets(counter string) (uint64, bool) {
-	fields := strings.SplitN(counter, ":", 2)
-	if len(fields) == 0 || fields[0] == "" {
-		return 0, false
-	}
-	v, parseErr := strconv.ParseUint(strings.TrimSpace(fields[0]), 10, 64)
-	if parseErr != nil {
-		return 0, false
-	}
-	return v, true
-}
-
 // collectLbRuleTraffic feeds the aggregate traffic counters (processed_* and
 // the per-service service_traffic_*) from the CUMULATIVE data-plane per-endpoint
 // rule counters, accumulating per-cycle deltas.
@@ -1550,6 +1536,76 @@ func RunGetFwRule(ctx context.Context) {
 	}
 }
 
+// collectFwRuleDrops feeds the firewall drop counters from the packet counters
+// of the rules' DP statistics. Like the LB rule counters they are cumulative,
+// so the canonical counters are fed per-cycle deltas
+func collectFwRuleDrops(localFWRuleInfo []cmn.FwRuleMod) {
+	var totalDrops uint64
+	currentFwRules := make(map[string]bool, len(localFWRuleInfo))
+
+	for _, rule := range localFWRuleInfo {
+		counter := rule.Stats.Packets
+
+		// Only SrcIP/DstIP are strings; the six port/proto/pref fields
+		// are integers. The old all-%s format made every one of them
+		// render as a Go error marker, so this label has always read
+		// `10.0.0.0/8_20.0.0.0/8_%!s(uint16=0)_...`. Correcting the
+		// verbs changes the label text, but only from a formatting bug
+		// to the value it was always meant to carry.
+		ruleSpecLabel := fmt.Sprintf("%s_%s_%d_%d_%d_%d_%d_%d",
+			rule.Rule.SrcIP, rule.Rule.DstIP, rule.Rule.SrcPortMin, rule.Rule.SrcPortMax,
+			rule.Rule.DstPortMin, rule.Rule.DstPortMax, rule.Rule.Proto, rule.Rule.Pref)
+
+		// Legacy surface: gauges holding the cumulative DP counter.
+		totalDropsByFwPerRule.WithLabelValues(ruleSpecLabel).Set(float64(counter))
+		totalDrops += counter
+
+		// Canonical surface: a real counter fed per-cycle deltas, keyed
+		// by rule preference so the label is bounded and stable across
+		// an edit to the rule's match fields.
+		ruleID := strconv.Itoa(int(rule.Rule.Pref))
+		currentFwRules[ruleID] = true
+		delta := counter
+		if prev, seen := prevFwRuleDrops[ruleID]; seen && counter >= prev {
+			delta = counter - prev
+		}
+		// On first sight, or a counter reset because the rule was
+		// re-created, the full current value is the delta.
+		if delta > 0 {
+			fwRuleDropPacketsTotal.WithLabelValues(ruleID).Add(float64(delta))
+			fwDropPacketsTotal.Add(float64(delta))
+		}
+		prevFwRuleDrops[ruleID] = counter
+
+		if enableSharedMetrics {
+			AddLabeledMetric("total_fw_drops_per_rule", map[string]string{"fw_rule": ruleSpecLabel}, float64(counter))
+		}
+	}
+
+	// Drop series and baselines for rules that no longer exist, so a
+	// deleted rule stops being exported instead of freezing at its last
+	// value.
+	for ruleID := range prevFwRuleDrops {
+		if !currentFwRules[ruleID] {
+			delete(prevFwRuleDrops, ruleID)
+			fwRuleDropPacketsTotal.DeleteLabelValues(ruleID)
+		}
+	}
+
+	// If there is no localFWRuleInfo, set init value
+	if len(localFWRuleInfo) == 0 {
+		totalDropsByFwPerRule.WithLabelValues("no_rule").Set(float64(0))
+	}
+
+	totalDropsByFw.Set(float64(totalDrops))
+	fwRuleCount.Set(float64(len(localFWRuleInfo)))
+
+	if enableSharedMetrics {
+		SetSharedMetric("total_fw_drops", float64(totalDrops))
+		SetSharedMetric("firewall_rules_count", float64(len(localFWRuleInfo)))
+	}
+}
+
 func RunFwStatistic(ctx context.Context) {
 	for {
 		select {
@@ -1561,79 +1617,7 @@ func RunFwStatistic(ctx context.Context) {
 			copy(localFWRuleInfo, FWRuleInfo)
 			mutex.Unlock()
 
-			var totalDrops uint64
-			currentFwRules := make(map[string]bool, len(localFWRuleInfo))
-
-			for _, rule := range localFWRuleInfo {
-				// The data-plane counter is "packets:bytes" (see
-				// pkg/loxinet/rules.go), so the old strconv.Atoi on the whole
-				// string failed on every rule and left total_fw_drops pinned at
-				// 0 for the lifetime of the process.
-				counter, ok := parseFwCounterPackets(rule.Opts.Counter)
-				if !ok {
-					tk.LogIt(tk.LogDebug, "[Prometheus] Unparsable firewall counter %q for pref %d\n",
-						rule.Opts.Counter, rule.Rule.Pref)
-					continue
-				}
-
-				// Only SrcIP/DstIP are strings; the six port/proto/pref fields
-				// are integers. The old all-%s format made every one of them
-				// render as a Go error marker, so this label has always read
-				// `10.0.0.0/8_20.0.0.0/8_%!s(uint16=0)_...`. Correcting the
-				// verbs changes the label text, but only from a formatting bug
-				// to the value it was always meant to carry.
-				ruleSpecLabel := fmt.Sprintf("%s_%s_%d_%d_%d_%d_%d_%d",
-					rule.Rule.SrcIP, rule.Rule.DstIP, rule.Rule.SrcPortMin, rule.Rule.SrcPortMax,
-					rule.Rule.DstPortMin, rule.Rule.DstPortMax, rule.Rule.Proto, rule.Rule.Pref)
-
-				// Legacy surface: gauges holding the cumulative DP counter.
-				totalDropsByFwPerRule.WithLabelValues(ruleSpecLabel).Set(float64(counter))
-				totalDrops += counter
-
-				// Canonical surface: a real counter fed per-cycle deltas, keyed
-				// by rule preference so the label is bounded and stable across
-				// an edit to the rule's match fields.
-				ruleID := strconv.Itoa(int(rule.Rule.Pref))
-				currentFwRules[ruleID] = true
-				delta := counter
-				if prev, seen := prevFwRuleDrops[ruleID]; seen && counter >= prev {
-					delta = counter - prev
-				}
-				// On first sight, or a counter reset because the rule was
-				// re-created, the full current value is the delta.
-				if delta > 0 {
-					fwRuleDropPacketsTotal.WithLabelValues(ruleID).Add(float64(delta))
-					fwDropPacketsTotal.Add(float64(delta))
-				}
-				prevFwRuleDrops[ruleID] = counter
-
-				if enableSharedMetrics {
-					AddLabeledMetric("total_fw_drops_per_rule", map[string]string{"fw_rule": ruleSpecLabel}, float64(counter))
-				}
-			}
-
-			// Drop series and baselines for rules that no longer exist, so a
-			// deleted rule stops being exported instead of freezing at its last
-			// value.
-			for ruleID := range prevFwRuleDrops {
-				if !currentFwRules[ruleID] {
-					delete(prevFwRuleDrops, ruleID)
-					fwRuleDropPacketsTotal.DeleteLabelValues(ruleID)
-				}
-			}
-
-			// If there is no localFWRuleInfo, set init value
-			if len(localFWRuleInfo) == 0 {
-				totalDropsByFwPerRule.WithLabelValues("no_rule").Set(float64(0))
-			}
-
-			totalDropsByFw.Set(float64(totalDrops))
-			fwRuleCount.Set(float64(len(localFWRuleInfo)))
-
-			if enableSharedMetrics {
-				SetSharedMetric("total_fw_drops", float64(totalDrops))
-				SetSharedMetric("firewall_rules_count", float64(len(localFWRuleInfo)))
-			}
+			collectFwRuleDrops(localFWRuleInfo)
 		}
 		time.Sleep(PromethusDefaultPeriod)
 	}
@@ -242,6 +242,7 @@ func TestUnsampledUtilizationFamiliesAreAbsent(t *testing.T) {
 		MetricSystemMemoryUtilization,
 		MetricSystemDiskUtilization,
 		MetricConntrackMaxEntries,
+		MetricEndpointProbeRate,
 	} {
 		if _, ok := gather(t, name); ok {
 			t.Errorf("family %q must be absent until first sampled, not published as 0", name)
@@ -252,6 +253,7 @@ func TestUnsampledUtilizationFamiliesAreAbsent(t *testing.T) {
 	systemMemoryUtilization.Set(40)
 	systemDiskUtilization.Set(60)
 	SetConntrackMaxEntries(65536)
+	SetEndpointProbeRate(2.5)
 
 	if got := mustGather(t, MetricSystemCPUUtilization); got != 12.5 {
 		t.Errorf("cpu utilization = %v, want 12.5", got)
@@ -259,6 +261,9 @@ func TestUnsampledUtilizationFamiliesAreAbsent(t *testing.T) {
 	if got := mustGather(t, MetricConntrackMaxEntries); got != 65536 {
 		t.Errorf("conntrack max = %v, want 65536", got)
 	}
+	if got := mustGather(t, MetricEndpointProbeRate); got != 2.5 {
+		t.Errorf("endpoint probe rate = %v, want 2.5", got)
+	}
 }
 
 // TestLazyGaugeIsAbsentUntilSet asserts the mechanism itself on a private
@@ -339,32 +344,42 @@ func TestParseCounterPacketsBytes(t *testing.T) {
 	}
 }
 
-// TestParseFwCounterPackets pins the fix for the firewall counter bug: the
-// data-plane string is "packets:bytes", so the previous strconv.Atoi over the
-// whole string failed on every rule and left total_fw_drops at 0 forever.
-func TestParseFwCounterPackets(t *testing.T) {
-	cases := []struct {
-		in      string
-		packets uint64
-		ok      bool
-	}{
-		// The three counter strings observed live on the testbed while the
-		// old strconv.Atoi was rejecting every one of them.
-		{"0:0", 0, true},
-		{"120:7044", 120, true},
-		{"30:1761", 30, true},
-		{"5:100", 5, true},
-		{"12", 12, true},
-		{"", 0, false},
-		{":100", 0, false},
-		{"abc:100", 0, false},
+// TestCollectFwRuleDropsUsesRuleStats replaces the old counter-string parsing
+// test: firewall drops now come from the rules' packet statistics, and the
+// "packets:bytes" counter string is no longer read at all.
+func TestCollectFwRuleDropsUsesRuleStats(t *testing.T) {
+	prevFwRuleDrops = make(map[string]uint64)
+
+	before := gatherOrZero(t, MetricTotalFwDrops)
+	fw := cmn.FwRuleMod{Rule: cmn.FwRuleArg{SrcIP: "10.0.0.0/8", DstIP: "0.0.0.0/0", Pref: 7001}}
+	fw.Opts.Counter = "not-a-counter"
+	fw.Stats.Packets = 120
+
+	// First sight of a rule publishes its full counter
+	collectFwRuleDrops([]cmn.FwRuleMod{fw})
+	if got := mustGather(t, MetricTotalFwDrops) - before; got != 120 {
+		t.Errorf("first cycle published %v drops, want 120", got)
 	}
-	for _, tc := range cases {
-		got, ok := parseFwCounterPackets(tc.in)
-		if ok != tc.ok || got != tc.packets {
-			t.Errorf("parseFwCounterPackets(%q) = (%d, %v), want (%d, %v)",
-				tc.in, got, ok, tc.packets, tc.ok)
-		}
+	if got := mustGather(t, LegacyMetricTotalFwDrops); got != 120 {
+		t.Errorf("legacy drop gauge %v, want the cumulative 120", got)
+	}
+
+	// Later cycles publish the delta, a reset the full value
+	fw.Stats.Packets = 150
+	collectFwRuleDrops([]cmn.FwRuleMod{fw})
+	if got := mustGather(t, MetricTotalFwDrops) - before; got != 150 {
+		t.Errorf("second cycle advanced drops to %v, want 150", got)
+	}
+	fw.Stats.Packets = 5
+	collectFwRuleDrops([]cmn.FwRuleMod{fw})
+	if got := mustGather(t, MetricTotalFwDrops) - before; got != 155 {
+		t.Errorf("after a counter reset drops advanced to %v, want 155", got)
+	}
+
+	// A deleted rule drops its baseline
+	collectFwRuleDrops(nil)
+	if len(prevFwRuleDrops) != 0 {
+		t.Errorf("baselines after deleting the rule = %d, want 0", len(prevFwRuleDrops))
 	}
 }
 
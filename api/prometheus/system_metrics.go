@@ -87,6 +87,10 @@ var (
 		name: MetricConntrackMaxEntries,
 		help: "Capacity of the datapath conntrack table (maximum concurrently tracked sessions).",
 	}
+	endpointProbeRate = &lazyGauge{
+		name: MetricEndpointProbeRate,
+		help: "End-point health probes run per second.",
+	}
 
 	// CPU utilization is a delta between two /proc/stat samples, so the first
 	// sample can only establish a baseline.
@@ -269,3 +273,13 @@ func SetConntrackMaxEntries(n int) {
 	}
 	conntrackMaxEntries.Set(float64(n))
 }
+
+// SetEndpointProbeRate publishes the rate of end-point health probes. It is
+// called from the loxinet ticker, so it must stay safe to call with
+// collection off.
+func SetEndpointProbeRate(rate float64) {
+	if rate < 0 {
+		return
+	}
+	endpointProbeRate.Set(rate)
+}
@@ -31,6 +31,8 @@ import (
 	"github.com/loxilb-io/loxilb/api/restapi/operations/auth"
 	"github.com/loxilb-io/loxilb/api/restapi/operations/metadata"
 	"github.com/loxilb-io/loxilb/api/restapi/operations/users"
+	"github.com/loxilb-io/loxilb/pkg/logrotate"
+	tk "github.com/loxilb-io/loxilib"
 )
 
 //go:generate swagger generate server --target ../../api --name LoxilbRestAPI --spec ../swagger.yml --principal interface{}
@@ -61,6 +63,7 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.JSONConsumer = runtime.JSONConsumer()
 
 	api.JSONProducer = runtime.JSONProducer()
+	api.RegisterProducer("text/event-stream", runtime.TextProducer())
 	// Applies when the "Authorization" header is set
 	api.BearerAuthAuth = handler.BearerAuthAuth
 	// Set your custom authorizer if needed. Default one is security.Authorized()
@@ -68,6 +71,7 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 
 	// Load balancer add and delete and get
 	api.PostConfigLoadbalancerHandler = operations.PostConfigLoadbalancerHandlerFunc(handler.ConfigPostLoadbalancer)
+	api.PostConfigLoadbalancerBatchHandler = operations.PostConfigLoadbalancerBatchHandlerFunc(handler.ConfigPostLoadbalancerBatch)
 	api.DeleteConfigLoadbalancerHosturlHosturlExternalipaddressIPAddressPortPortProtocolProtoHandler = operations.DeleteConfigLoadbalancerHosturlHosturlExternalipaddressIPAddressPortPortProtocolProtoHandlerFunc(handler.ConfigDeleteLoadbalancer)
 	api.DeleteConfigLoadbalancerHosturlHosturlExternalipaddressIPAddressPortPortPortmaxPortmaxProtocolProtoHandler = operations.DeleteConfigLoadbalancerHosturlHosturlExternalipaddressIPAddressPortPortPortmaxPortmaxProtocolProtoHandlerFunc(handler.ConfigDeleteLoadbalancerPortRange)
 	api.DeleteConfigLoadbalancerExternalipaddressIPAddressPortPortProtocolProtoHandler = operations.DeleteConfigLoadbalancerExternalipaddressIPAddressPortPortProtocolProtoHandlerFunc(handler.ConfigDeleteLoadbalancerWithoutPath)
@@ -110,6 +114,23 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.DeleteConfigMirrorIdentIdentHandler = operations.DeleteConfigMirrorIdentIdentHandlerFunc(handler.ConfigDeleteMirror)
 	api.GetConfigMirrorAllHandler = operations.GetConfigMirrorAllHandlerFunc(handler.ConfigGetMirror)
 
+	// IPAM pool Add, Delete and Get
+	api.PostConfigIppoolHandler = operations.PostConfigIppoolHandlerFunc(handler.ConfigPostIPPool)
+	api.DeleteConfigIppoolNameNameHandler = operations.DeleteConfigIppoolNameNameHandlerFunc(handler.ConfigDeleteIPPool)
+	api.GetConfigIppoolAllHandler = operations.GetConfigIppoolAllHandlerFunc(handler.ConfigGetIPPool)
+
+	// IP set Add, Delete, Modify and Get
+	api.PostConfigIpsetHandler = operations.PostConfigIpsetHandlerFunc(handler.ConfigPostIPSet)
+	api.DeleteConfigIpsetNameNameHandler = operations.DeleteConfigIpsetNameNameHandlerFunc(handler.ConfigDeleteIPSet)
+	api.PutConfigIpsetNameNameMembersHandler = operations.PutConfigIpsetNameNameMembersHandlerFunc(handler.ConfigPutIPSetMembers)
+	api.GetConfigIpsetAllHandler = operations.GetConfigIpsetAllHandlerFunc(handler.ConfigGetIPSet)
+
+	// Declarative config apply
+	api.PostConfigApplyHandler = operations.PostConfigApplyHandlerFunc(handler.ConfigPostApply)
+
+	// Watch change events
+	api.GetConfigWatchHandler = operations.GetConfigWatchHandlerFunc(handler.ConfigGetWatch)
+
 	// Status
 	api.GetStatusProcessHandler = operations.GetStatusProcessHandlerFunc(handler.ConfigGetProcess)
 	api.GetStatusDeviceHandler = operations.GetStatusDeviceHandlerFunc(handler.ConfigGetDevice)
@@ -156,6 +177,8 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.GetConfigFirewallAllHandler = operations.GetConfigFirewallAllHandlerFunc(handler.ConfigGetFW)
 	api.PostConfigFirewallHandler = operations.PostConfigFirewallHandlerFunc(handler.ConfigPostFW)
 	api.DeleteConfigFirewallHandler = operations.DeleteConfigFirewallHandlerFunc(handler.ConfigDeleteFW)
+	api.GetConfigFirewallStatsHandler = operations.GetConfigFirewallStatsHandlerFunc(handler.ConfigGetFWStats)
+	api.PostConfigFirewallImportHandler = operations.PostConfigFirewallImportHandlerFunc(handler.ConfigPostFWImport)
 
 	// EndPoint
 	api.GetConfigEndpointAllHandler = operations.GetConfigEndpointAllHandlerFunc(handler.ConfigGetEndPoint)
@@ -207,6 +230,17 @@ func configureAPI(api *operations.LoxilbRestAPIAPI) http.Handler {
 	api.GetMetricsReqcountperclientHandler = operations.GetMetricsReqcountperclientHandlerFunc(handler.ConfigGetReqCounterPerClient)
 	api.GetMetricsHostcountHandler = operations.GetMetricsHostcountHandlerFunc(handler.ConfigGetHostCount)
 
+	// Audit
+	if err := handler.AuditInit(opts.Opts.AuditLog, logrotate.Config{
+		MaxSizeMB:  opts.Opts.LogMaxSize,
+		MaxBackups: opts.Opts.LogMaxBackups,
+		MaxAgeDays: opts.Opts.LogMaxAge,
+		Compress:   !opts.Opts.LogNoCompress,
+	}); err != nil {
+		tk.LogIt(tk.LogError, "api: audit log %s disabled : %s\n", opts.Opts.AuditLog, err)
+	}
+	api.GetAuditHandler = operations.GetAuditHandlerFunc(handler.ConfigGetAudit)
+
 	// Log
 	api.GetLogsHandler = operations.GetLogsHandlerFunc(handler.ConfigGetLogs)
 	api.GetLogArchivesHandler = operations.GetLogArchivesHandlerFunc(handler.ConfigGetLogArchives)
@@ -272,7 +306,9 @@ func configureServer(s *http.Server, scheme, addr string) {
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
-func setupMiddlewares(handler http.Handler) http.Handler {
+func setupMiddlewares(next http.Handler) http.Handler {
+	// Config changes are recorded in the audit log
+	next = handler.AuditMiddleware(next)
 	// User service is disabled, so we need to set a valid token for the Authorization header.
 	if !opts.Opts.UserServiceEnable {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -280,10 +316,10 @@ func setupMiddlewares(handler http.Handler) http.Handler {
 			if r.Header.Get("Authorization") == "" {
 				r.Header.Set("Authorization", "valid")
 			}
-			handler.ServeHTTP(w, r)
+			next.ServeHTTP(w, r)
 		})
 	}
-	return handler
+	return next
 }
 
 // CORS adds Cross-Origin Resource Sharing headers to responses
@@ -37,6 +37,64 @@ func init() {
   "host": "0.0.0.0:11111",
   "basePath": "/netlox/v1",
   "paths": {
+    "/audit": {
+      "get": {
+        "description": "Get the audit log of configuration changes made through the API, newest first. Every non-GET request is recorded along with the principal which made it, the request body and the result code. Paging runs backwards across rotated audit files.",
+        "summary": "Get the audit log",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Number of entries to fetch (default is 100)",
+            "name": "lines",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only get entries made by this user",
+            "name": "user",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only get entries of this operation ID",
+            "name": "operation",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque pagination cursor from a previous response's nextCursor",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/AuditLog"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/auth/login": {
       "post": {
         "security": [],
@@ -355,6 +413,79 @@ func init() {
         }
       }
     },
+    "/config/apply": {
+      "post": {
+        "description": "Compute the difference between the desired state document and the current configuration of loadbalancer, endpoint, firewall, mirror, policy and bfd objects, and apply it in dependency order. Sections missing from the document are left untouched. If any step fails, the already applied steps are rolled back.",
+        "summary": "Apply a desired configuration state",
+        "parameters": [
+          {
+            "description": "Desired state document in the same layout as /config/export, with an optional bfd section",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          },
+          {
+            "type": "boolean",
+            "description": "Only compute and return the changes without applying them",
+            "name": "dryRun",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ConfigApplyResult"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/config/bfd": {
       "post": {
         "description": "Create vlan interface in the device",
@@ -2187,6 +2318,18 @@ func init() {
             "description": "User preference for ordering",
             "name": "preference",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused",
+            "name": "sourceIPSet",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused",
+            "name": "destinationIPSet",
+            "in": "query"
           }
         ],
         "responses": {
@@ -2278,45 +2421,33 @@ func init() {
         }
       }
     },
-    "/config/import": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "summary": "Import configurations",
-        "parameters": [
-          {
-            "type": "file",
-            "description": "The configuration file to upload.",
-            "name": "configuration",
-            "in": "formData"
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "OK"
-          }
-        }
-      }
-    },
-    "/config/ipv4address": {
+    "/config/firewall/import": {
       "post": {
-        "description": "Assign IPv4 addresses in the device",
-        "summary": "Assign IPv4 addresses in the device",
+        "description": "Translate an iptables-save or nft JSON ruleset to firewall and LB rules and add them. Filter rules become firewall rules, SNAT rules become firewall SNAT rules and DNAT rules become LB rules. Existing rules are left untouched unless an imported rule has the same key. Rules which could not be translated are reported back. If any step fails, the already applied steps are rolled back.",
+        "summary": "Import an iptables or nftables ruleset",
         "parameters": [
           {
-            "description": "Attributes for IPv4 address",
+            "description": "Ruleset to import",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/IPv4AddressEntry"
+              "$ref": "#/definitions/FirewallImportEntry"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "Only translate the ruleset and return the changes without applying them",
+            "name": "dryRun",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallImportResult"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -2363,20 +2494,28 @@ func init() {
         }
       }
     },
-    "/config/ipv4address/all": {
+    "/config/firewall/stats": {
       "get": {
-        "description": "Get IPv4 addresses in the device(interface)",
-        "summary": "Get IPv4 addresses in the device(interface)",
+        "description": "Get packets, bytes and the last hit time of firewall rules. If idle is given, only the rules which had no hits for that many seconds are returned.",
+        "summary": "Get firewall rule statistics",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Only get rules which had no hits for these many seconds",
+            "name": "idle",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "ipAttr": {
+                "fwStatsAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/IPv4AddressGetEntry"
+                    "$ref": "#/definitions/FirewallStatsEntry"
                   }
                 }
               }
@@ -2403,31 +2542,40 @@ func init() {
         }
       }
     },
-    "/config/ipv4address/{ip_address}/{mask}/dev/{if_name}": {
-      "delete": {
-        "description": "Delete IPv4 addresses in the device",
-        "summary": "Delete IPv4 addresses in the device",
+    "/config/import": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "summary": "Import configurations",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes IPv4 mask in the device",
-            "name": "mask",
-            "in": "path",
-            "required": true
-          },
+            "type": "file",
+            "description": "The configuration file to upload.",
+            "name": "configuration",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          }
+        }
+      }
+    },
+    "/config/ippool": {
+      "post": {
+        "description": "Create a new IP pool used to allocate LB service IPs.",
+        "summary": "Create a new IP pool",
+        "parameters": [
           {
-            "type": "string",
-            "description": "Attributes of the target device",
-            "name": "if_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes for IP pool",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPPoolEntry"
+            }
           }
         ],
         "responses": {
@@ -2479,32 +2627,23 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer": {
-      "post": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
-        "parameters": [
-          {
-            "description": "Attributes for load balance service",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/LoadbalanceEntry"
-            }
-          }
-        ],
+    "/config/ippool/all": {
+      "get": {
+        "description": "Get all IP pools along with their allocations.",
+        "summary": "Get all IP pools",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/PostSuccess"
-            }
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
-            "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "ipPoolAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPPoolGetEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -2513,60 +2652,64 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Resource not found",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+          }
+        }
+      }
+    },
+    "/config/ippool/name/{name}": {
+      "delete": {
+        "description": "Delete an IP pool. Fails if any of its addresses are in use.",
+        "summary": "Delete an IP pool",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the IP pool",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "503": {
-            "description": "Maintenance mode",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/config/loadbalancer/all": {
-      "get": {
-        "description": "Get all of the load balancer services with conntrack infomation.",
-        "summary": "Get all of the load balancer services",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "404": {
+            "description": "Resource not found",
             "schema": {
-              "type": "object",
-              "properties": {
-                "lbAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/LoadbalanceEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -2584,10 +2727,23 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Delete all load balancer services.",
-        "summary": "Delete all Load balancer services",
+      }
+    },
+    "/config/ipset": {
+      "post": {
+        "description": "Create a named IP set, or replace all members of an existing one. Firewall rules and LB allowed sources cannot refer to IP sets till the datapath supports them.",
+        "summary": "Create or replace an IP set",
+        "parameters": [
+          {
+            "description": "Attributes for IP set",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPSetEntry"
+            }
+          }
+        ],
         "responses": {
           "204": {
             "description": "OK"
@@ -2637,50 +2793,57 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/portmax/{portmax}/protocol/{proto}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
+    "/config/ipset/all": {
+      "get": {
+        "description": "Get all IP sets along with their members.",
+        "summary": "Get all IP sets",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipSetAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPSetEntry"
+                  }
+                }
+              }
+            }
           },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "portmax",
-            "in": "path",
-            "required": true
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/ipset/name/{name}": {
+      "delete": {
+        "description": "Delete an IP set.",
+        "summary": "Delete an IP set",
+        "parameters": [
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
+            "description": "Name of the IP set",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
           }
         ],
         "responses": {
@@ -2732,43 +2895,26 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
+    "/config/ipset/name/{name}/members": {
+      "put": {
+        "description": "Add and remove members of an IP set in one update.",
+        "summary": "Add or remove IP set members",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
+            "description": "Name of the IP set",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
+            "description": "Members to add and remove",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPSetMemberEntry"
+            }
           }
         ],
         "responses": {
@@ -2820,57 +2966,19 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/portmax/{portmax}/protocol/{proto}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
+    "/config/ipv4address": {
+      "post": {
+        "description": "Assign IPv4 addresses in the device",
+        "summary": "Assign IPv4 addresses in the device",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "hosturl",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "portmax",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
+            "description": "Attributes for IPv4 address",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPv4AddressEntry"
+            }
           }
         ],
         "responses": {
@@ -2922,50 +3030,71 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with .",
-        "summary": "Delete an existing Load balancer service",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "hosturl",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes for load balance service",
+    "/config/ipv4address/all": {
+      "get": {
+        "description": "Get IPv4 addresses in the device(interface)",
+        "summary": "Get IPv4 addresses in the device(interface)",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPv4AddressGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/ipv4address/{ip_address}/{mask}/dev/{if_name}": {
+      "delete": {
+        "description": "Delete IPv4 addresses in the device",
+        "summary": "Delete IPv4 addresses in the device",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes IPv4 Address in the device",
             "name": "ip_address",
             "in": "path",
             "required": true
           },
           {
-            "type": "number",
-            "description": "Attributes for load balance service",
-            "name": "port",
+            "type": "string",
+            "description": "Attributes IPv4 mask in the device",
+            "name": "mask",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "description": "Attributes for load balance service",
-            "name": "proto",
+            "description": "Attributes of the target device",
+            "name": "if_name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "option for BGP enable",
-            "name": "bgp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "block value if any",
-            "name": "block",
-            "in": "query"
           }
         ],
         "responses": {
@@ -3017,22 +3146,27 @@ func init() {
         }
       }
     },
-    "/config/loadbalancer/name/{lb_name}": {
-      "delete": {
-        "description": "Delete an existing load balancer service with name.",
-        "summary": "Delete an existing Load balancer service",
+    "/config/loadbalancer": {
+      "post": {
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes for load balance service name",
-            "name": "lb_name",
-            "in": "path",
-            "required": true
+            "description": "Attributes for load balance service",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceEntry"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/PostSuccess"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -3079,20 +3213,23 @@ func init() {
         }
       }
     },
-    "/config/metrics": {
+    "/config/loadbalancer/all": {
       "get": {
-        "summary": "Get prometheus config value",
+        "description": "Get all of the load balancer services with conntrack infomation.",
+        "summary": "Get all of the load balancer services",
         "responses": {
           "200": {
-            "description": "prometheus config value",
-            "schema": {
-              "$ref": "#/definitions/MetricsConfig"
-            }
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "lbAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/LoadbalanceEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -3115,8 +3252,9 @@ func init() {
           }
         }
       },
-      "post": {
-        "summary": "turn on prometheus option",
+      "delete": {
+        "description": "Delete all load balancer services.",
+        "summary": "Delete all Load balancer services",
         "responses": {
           "204": {
             "description": "OK"
@@ -3133,6 +3271,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -3146,12 +3302,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "summary": "turn off prometheus option",
+      }
+    },
+    "/config/loadbalancer/batch": {
+      "post": {
+        "description": "Add or delete a list of load balancer rules as a single transaction. Operations are applied in order. If any of them fails, including programming of the datapath, the operations already applied are rolled back and nothing is changed.",
+        "summary": "Apply a batch of load balancer rule operations",
+        "parameters": [
+          {
+            "description": "List of load balancer rule operations",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatch"
+            }
+          }
+        ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatchResult"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -3165,6 +3338,12 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "409": {
+            "description": "Batch failed and was rolled back",
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatchResult"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -3180,19 +3359,50 @@ func init() {
         }
       }
     },
-    "/config/mirror": {
-      "post": {
-        "description": "Create a new Mirror config.",
-        "summary": "Create a new Mirror config",
+    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/portmax/{portmax}/protocol/{proto}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
         "parameters": [
           {
-            "description": "Attributes for Mirror",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/MirrorEntry"
-            }
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "portmax",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "proto",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3244,57 +3454,43 @@ func init() {
         }
       }
     },
-    "/config/mirror/all": {
-      "get": {
-        "description": "Get",
-        "summary": "Get",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "mirrAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/MirrorGetEntry"
-                  }
-                }
-              }
-            }
+    "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
           },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
           },
-          "503": {
-            "description": "Maintenance mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/mirror/ident/{ident}": {
-      "delete": {
-        "description": "Delete a new Create a Mirror service.",
-        "summary": "Delete a Mirror service",
-        "parameters": [
           {
             "type": "string",
-            "description": "Attributes of Mirror Ident.",
-            "name": "ident",
+            "description": "Attributes for load balance service",
+            "name": "proto",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3346,19 +3542,57 @@ func init() {
         }
       }
     },
-    "/config/neighbor": {
-      "post": {
-        "description": "Assign IPv4 neighbor in the device",
-        "summary": "Assign IPv4 neighbor in the device",
+    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/portmax/{portmax}/protocol/{proto}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
         "parameters": [
           {
-            "description": "Attributes for IPv4 address",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/NeighborEntry"
-            }
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "hosturl",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "portmax",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
+            "name": "proto",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3410,64 +3644,50 @@ func init() {
         }
       }
     },
-    "/config/neighbor/all": {
-      "get": {
-        "description": "Get IPv4 neighbor in the device(interface)",
-        "summary": "Get IPv4 neighbor in the device(interface)",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "neighborAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/NeighborEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintenance mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/neighbor/{ip_address}/dev/{if_name}": {
+    "/config/loadbalancer/hosturl/{hosturl}/externalipaddress/{ip_address}/port/{port}/protocol/{proto}": {
       "delete": {
-        "description": "Delete IPv4 neighbor in the device",
-        "summary": "Delete IPv4 neighbor in the device",
+        "description": "Delete an existing load balancer service with .",
+        "summary": "Delete an existing Load balancer service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes IPv4 Address in the device",
+            "description": "Attributes for load balance service",
+            "name": "hosturl",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for load balance service",
             "name": "ip_address",
             "in": "path",
             "required": true
           },
+          {
+            "type": "number",
+            "description": "Attributes for load balance service",
+            "name": "port",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
-            "description": "Attributes of the target device",
-            "name": "if_name",
+            "description": "Attributes for load balance service",
+            "name": "proto",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "option for BGP enable",
+            "name": "bgp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "block value if any",
+            "name": "block",
+            "in": "query"
           }
         ],
         "responses": {
@@ -3519,17 +3739,20 @@ func init() {
         }
       }
     },
-    "/config/params": {
-      "get": {
-        "description": "Get Operational params of LoxiLB",
-        "summary": "Get Operational params of LoxiLB",
+    "/config/loadbalancer/name/{lb_name}": {
+      "delete": {
+        "description": "Delete an existing load balancer service with name.",
+        "summary": "Delete an existing Load balancer service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Attributes for load balance service name",
+            "name": "lb_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/OperParams"
-            }
-          },
           "204": {
             "description": "OK"
           },
@@ -3576,24 +3799,17 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "description": "Set Operational parameters of LoxiLB",
-        "summary": "Set Operational parameters of LoxiLB",
-        "parameters": [
-          {
-            "description": "Attributes for setting state",
-            "name": "attr",
-            "in": "body",
-            "required": true,
+      }
+    },
+    "/config/metrics": {
+      "get": {
+        "summary": "Get prometheus config value",
+        "responses": {
+          "200": {
+            "description": "prometheus config value",
             "schema": {
-              "$ref": "#/definitions/OperParams"
+              "$ref": "#/definitions/MetricsConfig"
             }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "OK"
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -3607,20 +3823,34 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Resource not found",
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "turn on prometheus option",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -3638,20 +3868,52 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/policy": {
+      },
+      "delete": {
+        "summary": "turn off prometheus option",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/mirror": {
       "post": {
-        "description": "Create a new Policy QoS config.",
-        "summary": "Create a new Policy QoS config",
+        "description": "Create a new Mirror config.",
+        "summary": "Create a new Mirror config",
         "parameters": [
           {
-            "description": "Attributes for Policy",
+            "description": "Attributes for Mirror",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/PolicyEntry"
+              "$ref": "#/definitions/MirrorEntry"
             }
           }
         ],
@@ -3704,7 +3966,7 @@ func init() {
         }
       }
     },
-    "/config/policy/all": {
+    "/config/mirror/all": {
       "get": {
         "description": "Get",
         "summary": "Get",
@@ -3714,10 +3976,10 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "polAttr": {
+                "mirrAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/PolicyEntry"
+                    "$ref": "#/definitions/MirrorGetEntry"
                   }
                 }
               }
@@ -3744,14 +4006,14 @@ func init() {
         }
       }
     },
-    "/config/policy/ident/{ident}": {
+    "/config/mirror/ident/{ident}": {
       "delete": {
-        "description": "Delete a new Create a Policy QoS service.",
-        "summary": "Delete a Policy QoS service",
+        "description": "Delete a new Create a Mirror service.",
+        "summary": "Delete a Mirror service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes of Policy Ident.",
+            "description": "Attributes of Mirror Ident.",
             "name": "ident",
             "in": "path",
             "required": true
@@ -3806,20 +4068,84 @@ func init() {
         }
       }
     },
-    "/config/port/all": {
+    "/config/neighbor": {
+      "post": {
+        "description": "Assign IPv4 neighbor in the device",
+        "summary": "Assign IPv4 neighbor in the device",
+        "parameters": [
+          {
+            "description": "Attributes for IPv4 address",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/NeighborEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/neighbor/all": {
       "get": {
-        "description": "Get all of the port interfaces.",
-        "summary": "Get all of the port interfaces",
+        "description": "Get IPv4 neighbor in the device(interface)",
+        "summary": "Get IPv4 neighbor in the device(interface)",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "portAttr": {
+                "neighborAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/PortEntry"
+                    "$ref": "#/definitions/NeighborEntry"
                   }
                 }
               }
@@ -3846,19 +4172,24 @@ func init() {
         }
       }
     },
-    "/config/route": {
-      "post": {
-        "description": "Create a new route config .",
-        "summary": "Create a new route config",
+    "/config/neighbor/{ip_address}/dev/{if_name}": {
+      "delete": {
+        "description": "Delete IPv4 neighbor in the device",
+        "summary": "Delete IPv4 neighbor in the device",
         "parameters": [
           {
-            "description": "Attributes for load balance service",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RouteEntry"
-            }
+            "type": "string",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes of the target device",
+            "name": "if_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -3910,25 +4241,20 @@ func init() {
         }
       }
     },
-    "/config/route/all": {
+    "/config/params": {
       "get": {
-        "description": "Get all route table",
-        "summary": "Get all route table",
+        "description": "Get Operational params of LoxiLB",
+        "summary": "Get Operational params of LoxiLB",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "object",
-              "properties": {
-                "routeAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/RouteGetEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/OperParams"
             }
           },
+          "204": {
+            "description": "OK"
+          },
           "400": {
             "description": "Malformed arguments for API call",
             "schema": {
@@ -3972,26 +4298,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/route/destinationIPNet/{ip_address}/{mask}": {
-      "delete": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+      },
+      "post": {
+        "description": "Set Operational parameters of LoxiLB",
+        "summary": "Set Operational parameters of LoxiLB",
         "parameters": [
           {
-            "type": "string",
-            "description": "Attributes for destinaion route address",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Attributes for destination route",
-            "name": "mask",
-            "in": "path",
-            "required": true
+            "description": "Attributes for setting state",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/OperParams"
+            }
           }
         ],
         "responses": {
@@ -4043,18 +4362,18 @@ func init() {
         }
       }
     },
-    "/config/session": {
+    "/config/policy": {
       "post": {
-        "description": "Create a new session config for 5G.",
-        "summary": "Create a new session config",
+        "description": "Create a new Policy QoS config.",
+        "summary": "Create a new Policy QoS config",
         "parameters": [
           {
-            "description": "Attributes for 5G service session",
+            "description": "Attributes for Policy",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SessionEntry"
+              "$ref": "#/definitions/PolicyEntry"
             }
           }
         ],
@@ -4107,20 +4426,20 @@ func init() {
         }
       }
     },
-    "/config/session/all": {
+    "/config/policy/all": {
       "get": {
-        "description": "Get all of the port interfaces.",
-        "summary": "Get all of the port interfaces",
+        "description": "Get",
+        "summary": "Get",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "sessionAttr": {
+                "polAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/SessionEntry"
+                    "$ref": "#/definitions/PolicyEntry"
                   }
                 }
               }
@@ -4147,14 +4466,14 @@ func init() {
         }
       }
     },
-    "/config/session/ident/{ident}": {
+    "/config/policy/ident/{ident}": {
       "delete": {
-        "description": "Create a new load balancer service with .",
-        "summary": "Create a new Load balancer service",
+        "description": "Delete a new Create a Policy QoS service.",
+        "summary": "Delete a Policy QoS service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes 5G session Ident.",
+            "description": "Attributes of Policy Ident.",
             "name": "ident",
             "in": "path",
             "required": true
@@ -4209,24 +4528,64 @@ func init() {
         }
       }
     },
-    "/config/sessionulcl": {
-      "post": {
-        "description": "Create a new session config for 5G.",
-        "summary": "Create a new session config",
-        "parameters": [
-          {
-            "description": "Attributes for 5G service session",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SessionUlClEntry"
-            }
-          }
-        ],
+    "/config/port/all": {
+      "get": {
+        "description": "Get all of the port interfaces.",
+        "summary": "Get all of the port interfaces",
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "portAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/PortEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/route": {
+      "post": {
+        "description": "Create a new route config .",
+        "summary": "Create a new route config",
+        "parameters": [
+          {
+            "description": "Attributes for load balance service",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RouteEntry"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -4273,31 +4632,55 @@ func init() {
         }
       }
     },
-    "/config/sessionulcl/all": {
+    "/config/route/all": {
       "get": {
-        "description": "Get",
-        "summary": "Get",
+        "description": "Get all route table",
+        "summary": "Get all route table",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "ulclAttr": {
+                "routeAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/SessionUlClEntry"
+                    "$ref": "#/definitions/RouteGetEntry"
                   }
                 }
               }
             }
           },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -4313,22 +4696,22 @@ func init() {
         }
       }
     },
-    "/config/sessionulcl/ident/{ident}/ulclAddress/{ip_address}": {
+    "/config/route/destinationIPNet/{ip_address}/{mask}": {
       "delete": {
         "description": "Create a new load balancer service with .",
         "summary": "Create a new Load balancer service",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes 5G session Ident.",
-            "name": "ident",
+            "description": "Attributes for destinaion route address",
+            "name": "ip_address",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "Attributes for session ulcl address",
-            "name": "ip_address",
+            "type": "integer",
+            "description": "Attributes for destination route",
+            "name": "mask",
             "in": "path",
             "required": true
           }
@@ -4382,18 +4765,18 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan": {
+    "/config/session": {
       "post": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Add a one of vxlan configuration",
+        "description": "Create a new session config for 5G.",
+        "summary": "Create a new session config",
         "parameters": [
           {
-            "description": "attributes for vxlan member interface",
+            "description": "Attributes for 5G service session",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VxlanBridgeEntry"
+              "$ref": "#/definitions/SessionEntry"
             }
           }
         ],
@@ -4401,14 +4784,32 @@ func init() {
           "204": {
             "description": "OK"
           },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "409": {
-            "description": "Resource Conflict. VxLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -4428,20 +4829,20 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan/all": {
+    "/config/session/all": {
       "get": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Get a list of vxlan configurations",
+        "description": "Get all of the port interfaces.",
+        "summary": "Get all of the port interfaces",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "vxlanAttr": {
+                "sessionAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/VxlanEntry"
+                    "$ref": "#/definitions/SessionEntry"
                   }
                 }
               }
@@ -4468,16 +4869,15 @@ func init() {
         }
       }
     },
-    "/config/tunnel/vxlan/{vxlanID}": {
+    "/config/session/ident/{ident}": {
       "delete": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Delete a one of vxlan configuration",
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
+            "type": "string",
+            "description": "Attributes 5G session Ident.",
+            "name": "ident",
             "in": "path",
             "required": true
           }
@@ -4486,56 +4886,11 @@ func init() {
           "204": {
             "description": "OK"
           },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintenance mode",
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/config/tunnel/vxlan/{vxlanID}/peer": {
-      "post": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Add a one of vxlan remote(peer) ip address configuration",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "attributes for vxlan Peer interface",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/VxlanPeerEntry"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/VxlanPeerEntry"
-            }
           },
           "401": {
             "description": "Invalid authentication credentials",
@@ -4543,51 +4898,20 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "503": {
-            "description": "Maintenance mode",
+          "404": {
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/config/tunnel/vxlan/{vxlanID}/peer/{PeerIP}": {
-      "delete": {
-        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
-        "summary": "Remove a one of vxlan remote(peer) ip address configuration",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
-            "name": "vxlanID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "attributes for vxlan Peer IP address",
-            "name": "PeerIP",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/VxlanEntry"
-            }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -4607,18 +4931,18 @@ func init() {
         }
       }
     },
-    "/config/vlan": {
+    "/config/sessionulcl": {
       "post": {
-        "description": "Create vlan interface in the device",
-        "summary": "Create vlan interface in the device",
+        "description": "Create a new session config for 5G.",
+        "summary": "Create a new session config",
         "parameters": [
           {
-            "description": "Attributes for Vlan Interface",
+            "description": "Attributes for 5G service session",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VlanBridgeEntry"
+              "$ref": "#/definitions/SessionUlClEntry"
             }
           }
         ],
@@ -4671,20 +4995,20 @@ func init() {
         }
       }
     },
-    "/config/vlan/all": {
+    "/config/sessionulcl/all": {
       "get": {
-        "description": "Get vlan in the device",
-        "summary": "Get vlan in the device",
+        "description": "Get",
+        "summary": "Get",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "vlanAttr": {
+                "ulclAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/VlanGetEntry"
+                    "$ref": "#/definitions/SessionUlClEntry"
                   }
                 }
               }
@@ -4711,15 +5035,22 @@ func init() {
         }
       }
     },
-    "/config/vlan/{vlan_id}": {
+    "/config/sessionulcl/ident/{ident}/ulclAddress/{ip_address}": {
       "delete": {
-        "description": "Delete vlan in the device",
-        "summary": "Delete vlan in the device",
+        "description": "Create a new load balancer service with .",
+        "summary": "Create a new Load balancer service",
         "parameters": [
           {
-            "type": "integer",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "vlan_id",
+            "type": "string",
+            "description": "Attributes 5G session Ident.",
+            "name": "ident",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes for session ulcl address",
+            "name": "ip_address",
             "in": "path",
             "required": true
           }
@@ -4773,26 +5104,18 @@ func init() {
         }
       }
     },
-    "/config/vlan/{vlan_id}/member": {
+    "/config/tunnel/vxlan": {
       "post": {
-        "description": "Add a member to interface Vlan{vlan_id}. If the vlan interface does not exist on LoxiLB it returns a '404' error. If such a member is already present on this Vlan interface the API returns '409' sub-code 0. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'. If attr with tagging mode is provided it will be honored in config, if not, the default tagging mode will be set to 'untagged'. Vlan members may be tagged or untagged, but, the Vlan member port may be untagged in only one Vlan interface, deviations from this will cause the API to return '409' sub-code 0.",
-        "summary": "Add a physical port to a vlan interface",
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Add a one of vxlan configuration",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "12 bit vlan_id",
-            "name": "vlan_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Attributes for Vlan Interface",
+            "description": "attributes for vxlan member interface",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/VlanMemberEntry"
+              "$ref": "#/definitions/VxlanBridgeEntry"
             }
           }
         ],
@@ -4800,32 +5123,54 @@ func init() {
           "204": {
             "description": "OK"
           },
-          "400": {
-            "description": "Malformed arguments for API call",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VxLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Vlan interface is not defined",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      }
+    },
+    "/config/tunnel/vxlan/all": {
+      "get": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Get a list of vxlan configurations",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "vxlanAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/VxlanEntry"
+                  }
+                }
+              }
+            }
           },
-          "409": {
-            "description": "Resource Conflict. VLAN member already exists on this VLAN interface OR Vlan member is being added to 2nd Vlan inteface as an untagged member.",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -4845,30 +5190,16 @@ func init() {
         }
       }
     },
-    "/config/vlan/{vlan_id}/member/{if_name}/tagged/{tagged}": {
+    "/config/tunnel/vxlan/{vxlanID}": {
       "delete": {
-        "description": "Remove a vlan member from a vlan interface which is defined by vlan_id. If the Vlan interface does not exist on LoxiLB OR a vlan member 'if_name' is not present on the interface the API will return '404'. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'.",
-        "summary": "Remove a vlan member from a vlan interface",
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Delete a one of vxlan configuration",
         "parameters": [
           {
             "type": "integer",
             "format": "int32",
-            "description": "12 bit vlan_id",
-            "name": "vlan_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Physical port name",
-            "name": "if_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "description": "Tagged status",
-            "name": "tagged",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
             "in": "path",
             "required": true
           }
@@ -4877,24 +5208,12 @@ func init() {
           "204": {
             "description": "OK"
           },
-          "400": {
-            "description": "Malformed arguments for API call",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "401": {
             "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "Vlan interface is not defined/Vlan member is not found on this Vlan interface",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -4910,63 +5229,50 @@ func init() {
         }
       }
     },
-    "/log-archives": {
-      "get": {
-        "description": "Retrieve a list of all rotated log archive files available for download.",
-        "summary": "List available log archives",
-        "responses": {
-          "200": {
-            "description": "List of log archive files",
-            "schema": {
-              "$ref": "#/definitions/LogArchives"
-            }
-          },
-          "500": {
-            "description": "Internal server error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/log-archives/{filename}": {
-      "get": {
-        "description": "Download a log archive file by its name.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "summary": "Download a specific log archive",
+    "/config/tunnel/vxlan/{vxlanID}/peer": {
+      "post": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Add a one of vxlan remote(peer) ip address configuration",
         "parameters": [
           {
-            "type": "string",
-            "description": "Name of the log archive file to download.",
-            "name": "filename",
+            "type": "integer",
+            "format": "int32",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "attributes for vxlan Peer interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/VxlanPeerEntry"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Log archive file download",
+            "description": "OK",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/VxlanPeerEntry"
             }
           },
-          "400": {
-            "description": "Missing or invalid filename",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "404": {
-            "description": "File not found",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal server error",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -4974,57 +5280,48 @@ func init() {
         }
       }
     },
-    "/logs": {
-      "get": {
-        "description": "Fetch log lines newest-first, paging backwards towards the start of the file. When level or keyword is set the server keeps reading backwards until the requested number of *matching* lines has been collected, the start of the file is reached, or the per-request scan cap (32 MiB) is hit — so has_more means \"more matches may exist\", not merely \"more bytes exist\". Offsets in next_cursor are byte offsets into the file the page was read from; for a .log.gz archive they address the uncompressed stream.\n",
-        "summary": "Fetch logs with optional filtering",
+    "/config/tunnel/vxlan/{vxlanID}/peer/{PeerIP}": {
+      "delete": {
+        "description": "Return a list of existing tunnels of a type. If there're no tunnels to return, empty list will be returned.",
+        "summary": "Remove a one of vxlan remote(peer) ip address configuration",
         "parameters": [
           {
-            "type": "string",
-            "description": "Number of log lines to fetch (default is 100). With level or keyword set this is the number of matching lines.",
-            "name": "lines",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Filter logs by level (e.g., INFO, ERROR, DEBUG). Matched as a substring, searched backwards across the whole file rather than within one page.",
-            "name": "level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Filter logs containing a specific keyword or phrase. Matched as a substring, searched backwards across the whole file rather than within one page.",
-            "name": "keyword",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Opaque pagination cursor from a previous response's next_cursor; fetches the next page.",
-            "name": "cursor",
-            "in": "query"
+            "type": "integer",
+            "format": "int32",
+            "description": "vxlan id (24-bit). Allows to remove routes with defined vnid only. Applicable for routes with nexthop_type 'vxlan-tunnel'. Otherwise '400' error will be returned",
+            "name": "vxlanID",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Specific log file to read (default is the current log file). Rotated .log.gz archives are accepted and decompressed transparently.",
-            "name": "file",
-            "in": "query"
+            "description": "attributes for vxlan Peer IP address",
+            "name": "PeerIP",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Logs fetched successfully",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Logs"
+              "$ref": "#/definitions/VxlanEntry"
             }
           },
-          "400": {
-            "description": "Invalid query parameters",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal server error",
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5032,94 +5329,53 @@ func init() {
         }
       }
     },
-    "/meta": {
-      "get": {
-        "security": [],
-        "description": "Returns metadata about required fields for each POST API.",
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "Metadata"
-        ],
-        "summary": "Get metadata for all POST APIs",
-        "operationId": "getMeta",
-        "responses": {
-          "200": {
-            "description": "Successfully retrieved metadata",
-            "schema": {
-              "type": "object",
-              "additionalProperties": true
-            }
-          },
-          "500": {
-            "description": "Internal Server Error"
-          }
-        }
-      }
-    },
-    "/metrics": {
-      "get": {
-        "summary": "Scrape metrics from the cache",
-        "responses": {
-          "200": {
-            "description": "Metrics in prometheus text format",
+    "/config/vlan": {
+      "post": {
+        "description": "Create vlan interface in the device",
+        "summary": "Create vlan interface in the device",
+        "parameters": [
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/VlanBridgeEntry"
             }
           }
-        }
-      }
-    },
-    "/metrics/epdisttraffic": {
-      "get": {
-        "description": "Get metrics related to endpoint distribution traffic per service. The additionalProp is service name.",
-        "summary": "Get endpoint distribution traffic metrics",
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "$ref": "#/definitions/EpDistTrafficMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/errorcount": {
-      "get": {
-        "description": "Get metrics related to error counts.",
-        "summary": "Get error count metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
-              "$ref": "#/definitions/ErrorCountMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "404": {
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/flowcount": {
-      "get": {
-        "description": "Get metrics related to flow counts.",
-        "summary": "Get flow count metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
-              "$ref": "#/definitions/FlowCountMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
           "500": {
@@ -5127,23 +5383,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/fwdrops": {
-      "get": {
-        "description": "Get metrics related to firewall drops.",
-        "summary": "Get firewall drops metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/FwDropsMetrics"
-            }
           },
-          "500": {
-            "description": "Internal service error",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5151,59 +5393,39 @@ func init() {
         }
       }
     },
-    "/metrics/hostcount": {
+    "/config/vlan/all": {
       "get": {
-        "description": "Get metrics related to host counts.",
-        "summary": "Get host count metrics",
+        "description": "Get vlan in the device",
+        "summary": "Get vlan in the device",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/HostCountMetrics"
+              "type": "object",
+              "properties": {
+                "vlanAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/VlanGetEntry"
+                  }
+                }
+              }
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/lbprocessedtraffic": {
-      "get": {
-        "description": "Get metrics related to load balancer processed traffic.",
-        "summary": "Get load balancer processed traffic metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/LbProcessedTrafficMetrics"
-            }
           },
           "500": {
             "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/lbrulecount": {
-      "get": {
-        "description": "Get metrics related to load balancer rule counts.",
-        "summary": "Get load balancer rule count metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/LbRuleCountMetrics"
-            }
           },
-          "500": {
-            "description": "Internal service error",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5211,55 +5433,51 @@ func init() {
         }
       }
     },
-    "/metrics/newflowcount": {
-      "get": {
-        "description": "Get metrics related to new flow counts.",
-        "summary": "Get new flow count metrics",
+    "/config/vlan/{vlan_id}": {
+      "delete": {
+        "description": "Delete vlan in the device",
+        "summary": "Delete vlan in the device",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "vlan_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "$ref": "#/definitions/NewFlowCountMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/processedtraffic": {
-      "get": {
-        "description": "Get metrics related to processed traffic.",
-        "summary": "Get processed traffic metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
-              "$ref": "#/definitions/ProcessedTrafficMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "404": {
+            "description": "Resource not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/reqcountperclient": {
-      "get": {
-        "description": "Get metrics related to request counts per client. The additionalProp is client IP address.",
-        "summary": "Get request count per client metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
-              "$ref": "#/definitions/ReqCountPerClientMetrics"
+              "$ref": "#/definitions/Error"
             }
           },
           "500": {
@@ -5267,23 +5485,9 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/metrics/requestcount": {
-      "get": {
-        "description": "Get metrics related to request counts.",
-        "summary": "Get request count metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/RequestCountMetrics"
-            }
           },
-          "500": {
-            "description": "Internal service error",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5291,35 +5495,37 @@ func init() {
         }
       }
     },
-    "/metrics/servicedisttraffic": {
-      "get": {
-        "description": "Get metrics related to service distribution traffic. The additionalProp is service name.",
-        "summary": "Get service distribution traffic metrics",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/ServiceDistTrafficMetrics"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
+    "/config/vlan/{vlan_id}/member": {
+      "post": {
+        "description": "Add a member to interface Vlan{vlan_id}. If the vlan interface does not exist on LoxiLB it returns a '404' error. If such a member is already present on this Vlan interface the API returns '409' sub-code 0. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'. If attr with tagging mode is provided it will be honored in config, if not, the default tagging mode will be set to 'untagged'. Vlan members may be tagged or untagged, but, the Vlan member port may be untagged in only one Vlan interface, deviations from this will cause the API to return '409' sub-code 0.",
+        "summary": "Add a physical port to a vlan interface",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "12 bit vlan_id",
+            "name": "vlan_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/VlanMemberEntry"
             }
           }
-        }
-      }
-    },
-    "/nodegraph/all": {
-      "get": {
-        "description": "Retrieve a list of all nodes and edges in the current topology.",
-        "summary": "List current topology",
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "$ref": "#/definitions/NodeGraphShcmea"
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -5328,43 +5534,20 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal service error",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "503": {
-            "description": "Maintenance mode",
+          "404": {
+            "description": "Vlan interface is not defined",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/nodegraph/{service}": {
-      "get": {
-        "description": "Retrieve a list of all nodes and edges in the current topology for a specific service.",
-        "summary": "List current topology for a specific service",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Name of the service to filter the topology by.",
-            "name": "service",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/NodeGraphShcmea"
-            }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "409": {
+            "description": "Resource Conflict. VLAN member already exists on this VLAN interface OR Vlan member is being added to 2nd Vlan inteface as an untagged member.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5384,158 +5567,111 @@ func init() {
         }
       }
     },
-    "/oauth/{provider}": {
-      "get": {
-        "security": [],
-        "description": "Initiates the OAuth login flow for the specified provider.",
-        "tags": [
-          "auth"
-        ],
-        "summary": "OAuth login",
+    "/config/vlan/{vlan_id}/member/{if_name}/tagged/{tagged}": {
+      "delete": {
+        "description": "Remove a vlan member from a vlan interface which is defined by vlan_id. If the Vlan interface does not exist on LoxiLB OR a vlan member 'if_name' is not present on the interface the API will return '404'. If the vlan_id passed is less than 2 or greater than 4094 the API will respond with error '400'.",
+        "summary": "Remove a vlan member from a vlan interface",
         "parameters": [
           {
-            "type": "string",
-            "description": "OAuth provider",
-            "name": "provider",
+            "type": "integer",
+            "format": "int32",
+            "description": "12 bit vlan_id",
+            "name": "vlan_id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "302": {
-            "description": "Found",
-            "schema": {
-              "$ref": "#/definitions/OauthMessageResponse"
-            }
           },
-          "400": {
-            "description": "Bad Request",
-            "schema": {
-              "$ref": "#/definitions/OauthErrorResponse"
-            }
-          }
-        }
-      }
-    },
-    "/oauth/{provider}/callback": {
-      "get": {
-        "security": [],
-        "description": "Handles the OAuth callback flow for the specified provider.",
-        "tags": [
-          "auth"
-        ],
-        "summary": "OAuth callback",
-        "parameters": [
           {
             "type": "string",
-            "description": "OAuth provider",
-            "name": "provider",
+            "description": "Physical port name",
+            "name": "if_name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "description": "OAuth code",
-            "name": "code",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "OAuth state",
-            "name": "state",
-            "in": "query",
+            "type": "boolean",
+            "description": "Tagged status",
+            "name": "tagged",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "$ref": "#/definitions/OauthLoginResponse"
+              "$ref": "#/definitions/Error"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
-              "$ref": "#/definitions/OauthErrorResponse"
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Vlan interface is not defined/Vlan member is not found on this Vlan interface",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal Server Error",
+            "description": "Internal service error",
             "schema": {
-              "$ref": "#/definitions/OauthErrorResponse"
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
         }
       }
     },
-    "/oauth/{provider}/token": {
+    "/config/watch": {
       "get": {
-        "security": [],
-        "description": "Handles the OAuth token refresh workflow for the specified provider.",
-        "tags": [
-          "auth"
+        "description": "Stream change events of load balancer rules, endpoint states, HA states, BGP neighbors and ports. Events are sent as server-sent events, or as WebSocket messages if the request is a WebSocket upgrade. Every event carries a resource version. A client can resume a watch after reconnecting by passing the last version it has seen, either as resourceVersion or as the Last-Event-ID header.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
         ],
-        "summary": "OAuth callback",
+        "summary": "Watch change events",
         "parameters": [
           {
             "type": "string",
-            "description": "OAuth provider",
-            "name": "provider",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "OAuth access token",
-            "name": "token",
-            "in": "query",
-            "required": true
+            "description": "Comma separated list of event kinds to watch (loadbalancer, endpoint, hastate, bgpneighbor, port). All kinds are watched if not given",
+            "name": "kinds",
+            "in": "query"
           },
           {
-            "type": "string",
-            "description": "OAuth refresh token",
-            "name": "refreshtoken",
-            "in": "query",
-            "required": true
+            "type": "integer",
+            "description": "Resume the watch after this resource version",
+            "name": "resourceVersion",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/OauthTokenResponse"
-            }
+            "description": "Stream of watch events"
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Malformed arguments for API call",
             "schema": {
-              "$ref": "#/definitions/OauthErrorResponse"
+              "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal Server Error",
-            "schema": {
-              "$ref": "#/definitions/OauthErrorResponse"
-            }
-          }
-        }
-      }
-    },
-    "/status/device": {
-      "get": {
-        "description": "Get a basic info (linux command \"uptime, hostnamectl\") in the device or system.",
-        "summary": "Get a basic info in the device",
-        "responses": {
-          "200": {
-            "description": "Device unique informations",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
-              "$ref": "#/definitions/DeviceInfoEntry"
+              "$ref": "#/definitions/Error"
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "410": {
+            "description": "Resource version is too old or unknown. The client needs to list the objects again and watch from now on",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5555,39 +5691,19 @@ func init() {
         }
       }
     },
-    "/status/filesystem": {
+    "/log-archives": {
       "get": {
-        "description": "Get a File system infomation (linux command \"df\") in the device or system.",
-        "summary": "Get a File System info in the device",
+        "description": "Retrieve a list of all rotated log archive files available for download.",
+        "summary": "List available log archives",
         "responses": {
           "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "filesystemAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/FileSystemInfoEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
+            "description": "List of log archive files",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/LogArchives"
             }
           },
           "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintenance mode",
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5595,39 +5711,101 @@ func init() {
         }
       }
     },
-    "/status/process": {
+    "/log-archives/{filename}": {
       "get": {
-        "description": "Get a process based on high usage CPU(linux command \"top\") in the device or system.",
-        "summary": "Get a process based on CPU usage info in the device",
+        "description": "Download a log archive file by its name.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "summary": "Download a specific log archive",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the log archive file to download.",
+            "name": "filename",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Log archive file download",
             "schema": {
-              "type": "object",
-              "properties": {
-                "processAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/ProcessInfoEntry"
-                  }
-                }
-              }
+              "type": "file"
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "400": {
+            "description": "Missing or invalid filename",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "File not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal service error",
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      }
+    },
+    "/logs": {
+      "get": {
+        "description": "Fetch log lines newest-first, paging backwards towards the start of the file. When level or keyword is set the server keeps reading backwards until the requested number of *matching* lines has been collected, the start of the file is reached, or the per-request scan cap (32 MiB) is hit — so has_more means \"more matches may exist\", not merely \"more bytes exist\". Offsets in next_cursor are byte offsets into the file the page was read from; for a .log.gz archive they address the uncompressed stream.\n",
+        "summary": "Fetch logs with optional filtering",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Number of log lines to fetch (default is 100). With level or keyword set this is the number of matching lines.",
+            "name": "lines",
+            "in": "query"
           },
-          "503": {
-            "description": "Maintenance mode",
+          {
+            "type": "string",
+            "description": "Filter logs by level (e.g., INFO, ERROR, DEBUG). Matched as a substring, searched backwards across the whole file rather than within one page.",
+            "name": "level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Filter logs containing a specific keyword or phrase. Matched as a substring, searched backwards across the whole file rather than within one page.",
+            "name": "keyword",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque pagination cursor from a previous response's next_cursor; fetches the next page.",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specific log file to read (default is the current log file). Rotated .log.gz archives are accepted and decompressed transparently.",
+            "name": "file",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Logs fetched successfully",
+            "schema": {
+              "$ref": "#/definitions/Logs"
+            }
+          },
+          "400": {
+            "description": "Invalid query parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -5635,22 +5813,54 @@ func init() {
         }
       }
     },
-    "/version": {
+    "/meta": {
       "get": {
         "security": [],
-        "description": "Get version information",
-        "summary": "Get version information in the device",
+        "description": "Returns metadata about required fields for each POST API.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "Metadata"
+        ],
+        "summary": "Get metadata for all POST APIs",
+        "operationId": "getMeta",
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Successfully retrieved metadata",
             "schema": {
-              "$ref": "#/definitions/VersionGetEntry"
+              "type": "object",
+              "additionalProperties": true
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "500": {
+            "description": "Internal Server Error"
+          }
+        }
+      }
+    },
+    "/metrics": {
+      "get": {
+        "summary": "Scrape metrics from the cache",
+        "responses": {
+          "200": {
+            "description": "Metrics in prometheus text format",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "/metrics/epdisttraffic": {
+      "get": {
+        "description": "Get metrics related to endpoint distribution traffic per service. The additionalProp is service name.",
+        "summary": "Get endpoint distribution traffic metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/EpDistTrafficMetrics"
             }
           },
           "500": {
@@ -5658,236 +5868,872 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      }
+    },
+    "/metrics/errorcount": {
+      "get": {
+        "description": "Get metrics related to error counts.",
+        "summary": "Get error count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ErrorCountMetrics"
+            }
           },
-          "503": {
-            "description": "Maintenance mode",
+          "500": {
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "BGPApplyPolicyToNeighborMod": {
-      "type": "object",
-      "required": [
-        "ipAddress",
-        "policyType",
-        "routeAction"
-      ],
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "policies": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    },
+    "/metrics/flowcount": {
+      "get": {
+        "description": "Get metrics related to flow counts.",
+        "summary": "Get flow count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FlowCountMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "policyType": {
-          "type": "string",
-          "enum": [
-            "import",
-            "export"
-          ]
-        },
-        "routeAction": {
-          "type": "string",
-          "enum": [
-            "accept",
-            "reject"
-          ]
         }
       }
     },
-    "BGPGlobalConfig": {
-      "type": "object",
-      "required": [
-        "routerId",
-        "localAs"
-      ],
-      "properties": {
-        "SetNextHopSelf": {
-          "description": "Adds policy to set next hop as self, if enabled",
-          "type": "boolean"
-        },
-        "listenPort": {
-          "description": "Listen port (default 179)",
-          "type": "integer"
-        },
-        "localAs": {
-          "description": "Local AS number",
-          "type": "integer"
-        },
-        "routerId": {
-          "description": "BGP Router ID",
-          "type": "string"
+    "/metrics/fwdrops": {
+      "get": {
+        "description": "Get metrics related to firewall drops.",
+        "summary": "Get firewall drops metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FwDropsMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "BGPNeigh": {
-      "type": "object",
-      "required": [
-        "ipAddress",
-        "remoteAs"
-      ],
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "remoteAs": {
-          "description": "Remote AS number",
-          "type": "integer"
-        },
-        "remotePort": {
-          "description": "Remote Connect Port (default 179)",
-          "type": "integer"
-        },
-        "setMultiHop": {
-          "description": "Enable multi-hop peering (if needed)",
-          "type": "boolean"
+    "/metrics/hostcount": {
+      "get": {
+        "description": "Get metrics related to host counts.",
+        "summary": "Get host count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/HostCountMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "BGPNeighGetEntry": {
-      "type": "object",
-      "properties": {
-        "ipAddress": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "remoteAs": {
-          "description": "Remote AS number",
-          "type": "integer"
-        },
-        "state": {
-          "description": "Current state",
-          "type": "string"
-        },
-        "updowntime": {
-          "description": "Current uptime",
-          "type": "string"
+    "/metrics/lbprocessedtraffic": {
+      "get": {
+        "description": "Get metrics related to load balancer processed traffic.",
+        "summary": "Get load balancer processed traffic metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/LbProcessedTrafficMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "BGPPolicyDefinedSetGetEntry": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "list": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/metrics/lbrulecount": {
+      "get": {
+        "description": "Get metrics related to load balancer rule counts.",
+        "summary": "Get load balancer rule count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/LbRuleCountMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "name": {
-          "description": "BGP Defined set Entries",
-          "type": "string"
-        },
-        "prefixList": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyPrefix"
+        }
+      }
+    },
+    "/metrics/newflowcount": {
+      "get": {
+        "description": "Get metrics related to new flow counts.",
+        "summary": "Get new flow count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/NewFlowCountMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinedSetsMod": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "List": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/metrics/processedtraffic": {
+      "get": {
+        "description": "Get metrics related to processed traffic.",
+        "summary": "Get processed traffic metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ProcessedTrafficMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "name": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "prefixList": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyPrefix"
+        }
+      }
+    },
+    "/metrics/reqcountperclient": {
+      "get": {
+        "description": "Get metrics related to request counts per client. The additionalProp is client IP address.",
+        "summary": "Get request count per client metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ReqCountPerClientMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinitionsMod": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "description": "BGP Neighbor IP address",
-          "type": "string"
-        },
-        "statements": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BGPPolicyDefinitionsStatement"
+    "/metrics/requestcount": {
+      "get": {
+        "description": "Get metrics related to request counts.",
+        "summary": "Get request count metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/RequestCountMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BGPPolicyDefinitionsStatement": {
-      "type": "object",
-      "properties": {
-        "actions": {
-          "type": "object",
-          "properties": {
-            "bgpActions": {
-              "type": "object",
-              "properties": {
-                "setAsPathPrepend": {
-                  "type": "object",
-                  "properties": {
-                    "as": {
-                      "type": "string"
-                    },
-                    "repeatN": {
-                      "type": "integer"
-                    }
-                  }
-                },
-                "setCommunity": {
-                  "type": "object",
-                  "properties": {
-                    "options": {
-                      "type": "string"
-                    },
-                    "setCommunityMethod": {
-                      "type": "array",
-                      "items": {
-                        "type": "string"
-                      }
-                    }
-                  }
-                },
-                "setExtCommunity": {
-                  "type": "object",
-                  "properties": {
-                    "options": {
-                      "type": "string"
-                    },
-                    "setCommunityMethod": {
-                      "type": "array",
-                      "items": {
-                        "type": "string"
-                      }
-                    }
-                  }
-                },
-                "setLargeCommunity": {
-                  "type": "object",
+    "/metrics/servicedisttraffic": {
+      "get": {
+        "description": "Get metrics related to service distribution traffic. The additionalProp is service name.",
+        "summary": "Get service distribution traffic metrics",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/ServiceDistTrafficMetrics"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/nodegraph/all": {
+      "get": {
+        "description": "Retrieve a list of all nodes and edges in the current topology.",
+        "summary": "List current topology",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/NodeGraphShcmea"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/nodegraph/{service}": {
+      "get": {
+        "description": "Retrieve a list of all nodes and edges in the current topology for a specific service.",
+        "summary": "List current topology for a specific service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the service to filter the topology by.",
+            "name": "service",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/NodeGraphShcmea"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/oauth/{provider}": {
+      "get": {
+        "security": [],
+        "description": "Initiates the OAuth login flow for the specified provider.",
+        "tags": [
+          "auth"
+        ],
+        "summary": "OAuth login",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "OAuth provider",
+            "name": "provider",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "302": {
+            "description": "Found",
+            "schema": {
+              "$ref": "#/definitions/OauthMessageResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/OauthErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/oauth/{provider}/callback": {
+      "get": {
+        "security": [],
+        "description": "Handles the OAuth callback flow for the specified provider.",
+        "tags": [
+          "auth"
+        ],
+        "summary": "OAuth callback",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "OAuth provider",
+            "name": "provider",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "OAuth code",
+            "name": "code",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "OAuth state",
+            "name": "state",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/OauthLoginResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/OauthErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/OauthErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/oauth/{provider}/token": {
+      "get": {
+        "security": [],
+        "description": "Handles the OAuth token refresh workflow for the specified provider.",
+        "tags": [
+          "auth"
+        ],
+        "summary": "OAuth callback",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "OAuth provider",
+            "name": "provider",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "OAuth access token",
+            "name": "token",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "OAuth refresh token",
+            "name": "refreshtoken",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/OauthTokenResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/OauthErrorResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/OauthErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/status/device": {
+      "get": {
+        "description": "Get a basic info (linux command \"uptime, hostnamectl\") in the device or system.",
+        "summary": "Get a basic info in the device",
+        "responses": {
+          "200": {
+            "description": "Device unique informations",
+            "schema": {
+              "$ref": "#/definitions/DeviceInfoEntry"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status/filesystem": {
+      "get": {
+        "description": "Get a File system infomation (linux command \"df\") in the device or system.",
+        "summary": "Get a File System info in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "filesystemAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/FileSystemInfoEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/status/process": {
+      "get": {
+        "description": "Get a process based on high usage CPU(linux command \"top\") in the device or system.",
+        "summary": "Get a process based on CPU usage info in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "processAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ProcessInfoEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/version": {
+      "get": {
+        "security": [],
+        "description": "Get version information",
+        "summary": "Get version information in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/VersionGetEntry"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AuditEntry": {
+      "type": "object",
+      "properties": {
+        "body": {
+          "description": "Request body. Secrets are masked and large bodies truncated",
+          "type": "string"
+        },
+        "method": {
+          "description": "HTTP method of the request",
+          "type": "string"
+        },
+        "operationId": {
+          "description": "Operation ID of the API call",
+          "type": "string"
+        },
+        "path": {
+          "description": "API path of the request",
+          "type": "string"
+        },
+        "remoteAddr": {
+          "description": "Address the request came from",
+          "type": "string"
+        },
+        "resultCode": {
+          "description": "HTTP result code of the request",
+          "type": "integer"
+        },
+        "role": {
+          "description": "Role of the principal",
+          "type": "string"
+        },
+        "time": {
+          "description": "Time the request was received (RFC3339)",
+          "type": "string"
+        },
+        "user": {
+          "description": "Principal which made the request",
+          "type": "string"
+        }
+      }
+    },
+    "AuditLog": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of entries in this page",
+          "type": "integer"
+        },
+        "entries": {
+          "description": "Audit entries of this page, newest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditEntry"
+          }
+        },
+        "hasMore": {
+          "description": "Whether older entries remain. True implies nextCursor is set",
+          "type": "boolean"
+        },
+        "nextCursor": {
+          "description": "Opaque cursor for the next (older) page",
+          "type": "string"
+        }
+      }
+    },
+    "BGPApplyPolicyToNeighborMod": {
+      "type": "object",
+      "required": [
+        "ipAddress",
+        "policyType",
+        "routeAction"
+      ],
+      "properties": {
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policyType": {
+          "type": "string",
+          "enum": [
+            "import",
+            "export"
+          ]
+        },
+        "routeAction": {
+          "type": "string",
+          "enum": [
+            "accept",
+            "reject"
+          ]
+        }
+      }
+    },
+    "BGPGlobalConfig": {
+      "type": "object",
+      "required": [
+        "routerId",
+        "localAs"
+      ],
+      "properties": {
+        "SetNextHopSelf": {
+          "description": "Adds policy to set next hop as self, if enabled",
+          "type": "boolean"
+        },
+        "listenPort": {
+          "description": "Listen port (default 179)",
+          "type": "integer"
+        },
+        "localAs": {
+          "description": "Local AS number",
+          "type": "integer"
+        },
+        "routerId": {
+          "description": "BGP Router ID",
+          "type": "string"
+        }
+      }
+    },
+    "BGPNeigh": {
+      "type": "object",
+      "required": [
+        "ipAddress",
+        "remoteAs"
+      ],
+      "properties": {
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "remoteAs": {
+          "description": "Remote AS number",
+          "type": "integer"
+        },
+        "remotePort": {
+          "description": "Remote Connect Port (default 179)",
+          "type": "integer"
+        },
+        "setMultiHop": {
+          "description": "Enable multi-hop peering (if needed)",
+          "type": "boolean"
+        }
+      }
+    },
+    "BGPNeighGetEntry": {
+      "type": "object",
+      "properties": {
+        "ipAddress": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "remoteAs": {
+          "description": "Remote AS number",
+          "type": "integer"
+        },
+        "state": {
+          "description": "Current state",
+          "type": "string"
+        },
+        "updowntime": {
+          "description": "Current uptime",
+          "type": "string"
+        }
+      }
+    },
+    "BGPPolicyDefinedSetGetEntry": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "list": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "BGP Defined set Entries",
+          "type": "string"
+        },
+        "prefixList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BGPPolicyPrefix"
+          }
+        }
+      }
+    },
+    "BGPPolicyDefinedSetsMod": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "List": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "prefixList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BGPPolicyPrefix"
+          }
+        }
+      }
+    },
+    "BGPPolicyDefinitionsMod": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "BGP Neighbor IP address",
+          "type": "string"
+        },
+        "statements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BGPPolicyDefinitionsStatement"
+          }
+        }
+      }
+    },
+    "BGPPolicyDefinitionsStatement": {
+      "type": "object",
+      "properties": {
+        "actions": {
+          "type": "object",
+          "properties": {
+            "bgpActions": {
+              "type": "object",
+              "properties": {
+                "setAsPathPrepend": {
+                  "type": "object",
+                  "properties": {
+                    "as": {
+                      "type": "string"
+                    },
+                    "repeatN": {
+                      "type": "integer"
+                    }
+                  }
+                },
+                "setCommunity": {
+                  "type": "object",
+                  "properties": {
+                    "options": {
+                      "type": "string"
+                    },
+                    "setCommunityMethod": {
+                      "type": "array",
+                      "items": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "setExtCommunity": {
+                  "type": "object",
+                  "properties": {
+                    "options": {
+                      "type": "string"
+                    },
+                    "setCommunityMethod": {
+                      "type": "array",
+                      "items": {
+                        "type": "string"
+                      }
+                    }
+                  }
+                },
+                "setLargeCommunity": {
+                  "type": "object",
                   "properties": {
                     "options": {
                       "type": "string"
@@ -6125,6 +6971,10 @@ func init() {
         "sync"
       ],
       "properties": {
+        "cloudError": {
+          "description": "Last failed cloud hook operation, if any",
+          "type": "string"
+        },
         "instance": {
           "description": "Instance name",
           "type": "string"
@@ -6143,6 +6993,43 @@ func init() {
         }
       }
     },
+    "ConfigApplyChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Change needed to reach the desired state",
+          "type": "string",
+          "enum": [
+            "add",
+            "modify",
+            "delete"
+          ]
+        },
+        "key": {
+          "description": "Key identifying the configuration object",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Kind of the configuration object (loadbalancer, endpoint, firewall, mirror, policy, bfd)",
+          "type": "string"
+        }
+      }
+    },
+    "ConfigApplyResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigApplyChange"
+          }
+        },
+        "dryRun": {
+          "description": "True if the changes were only computed and not applied",
+          "type": "boolean"
+        }
+      }
+    },
     "ConntrackEntry": {
       "type": "object",
       "properties": {
@@ -6270,6 +7157,10 @@ func init() {
         "hostName"
       ],
       "properties": {
+        "activeReTries": {
+          "description": "Number of successful probes before an inactive end-point is marked active again",
+          "type": "integer"
+        },
         "hostName": {
           "description": "Host name in CIDR",
           "type": "string"
@@ -6278,13 +7169,60 @@ func init() {
           "description": "Number of inactive retries",
           "type": "integer"
         },
-        "name": {
-          "description": "Endpoint Identifier",
+        "name": {
+          "description": "Endpoint Identifier",
+          "type": "string"
+        },
+        "probeBackoffMax": {
+          "description": "Max duration (in seconds) between probes of an inactive end-point. It doubles on each failed probe till this max",
+          "type": "integer"
+        },
+        "probeBodyRegex": {
+          "description": "Regex the response body of http/https probes has to match",
+          "type": "string"
+        },
+        "probeDuration": {
+          "description": "How frequently to probe in seconds",
+          "type": "integer"
+        },
+        "probeExec": {
+          "description": "Check command of exec probes and its arguments. The command has to be in /opt/loxilb/probe. The end-point ip and port are passed as last arguments",
+          "type": "string"
+        },
+        "probeExecState": {
+          "description": "Set the host state (green, yellow or red) from the first line printed by the check command of exec probes",
+          "type": "boolean"
+        },
+        "probeGrpcService": {
+          "description": "Service checked by grpc probes. The overall health of the server is checked if not given",
+          "type": "string"
+        },
+        "probeHeaders": {
+          "description": "Extra headers of http/https probes as \"Name: value\"",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header of http/https probes and TLS server name (SNI) of https/grpc probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Random jitter (in percent, max 50) of the duration between probes",
+          "type": "integer"
+        },
+        "probeJsonPath": {
+          "description": "Path of a value in the json response body of http/https probes e.g \"status\" or \"checks[0].state\"",
           "type": "string"
         },
-        "probeDuration": {
-          "description": "How frequently to probe in seconds",
-          "type": "integer"
+        "probeJsonValue": {
+          "description": "Expected value at probeJsonPath. The path only has to exist if not given",
+          "type": "string"
+        },
+        "probeMethod": {
+          "description": "Method of http/https probes, GET if not given",
+          "type": "string"
         },
         "probePort": {
           "description": "The l4port to probe on",
@@ -6298,6 +7236,18 @@ func init() {
           "description": "Response for http/https probes",
           "type": "string"
         },
+        "probeStatus": {
+          "description": "Status codes accepted by http/https probes e.g \"200-399\" or \"200,204\". Redirects are followed and only 200 is accepted if not given",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of http/https/grpc probes in seconds",
+          "type": "integer"
+        },
+        "probeTls": {
+          "description": "Use TLS for grpc probes",
+          "type": "boolean"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string",
@@ -6308,6 +7258,8 @@ func init() {
             "ping",
             "http",
             "https",
+            "grpc",
+            "exec",
             "none"
           ]
         }
@@ -6316,6 +7268,10 @@ func init() {
     "EndPointGetEntry": {
       "type": "object",
       "properties": {
+        "activeReTries": {
+          "description": "Number of successful probes before an inactive end-point is marked active again",
+          "type": "integer"
+        },
         "avgDelay": {
           "description": "Average delay seen for endpoint",
           "type": "string"
@@ -6344,10 +7300,57 @@ func init() {
           "description": "Endpoint Identifier",
           "type": "string"
         },
+        "probeBackoffMax": {
+          "description": "Max duration (in seconds) between probes of an inactive end-point. It doubles on each failed probe till this max",
+          "type": "integer"
+        },
+        "probeBodyRegex": {
+          "description": "Regex the response body of http/https probes has to match",
+          "type": "string"
+        },
         "probeDuration": {
           "description": "How frequently to probe in seconds",
           "type": "integer"
         },
+        "probeExec": {
+          "description": "Check command of exec probes and its arguments. The command has to be in /opt/loxilb/probe. The end-point ip and port are passed as last arguments",
+          "type": "string"
+        },
+        "probeExecState": {
+          "description": "Set the host state (green, yellow or red) from the first line printed by the check command of exec probes",
+          "type": "boolean"
+        },
+        "probeGrpcService": {
+          "description": "Service checked by grpc probes. The overall health of the server is checked if not given",
+          "type": "string"
+        },
+        "probeHeaders": {
+          "description": "Extra headers of http/https probes as \"Name: value\"",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header of http/https probes and TLS server name (SNI) of https/grpc probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Random jitter (in percent, max 50) of the duration between probes",
+          "type": "integer"
+        },
+        "probeJsonPath": {
+          "description": "Path of a value in the json response body of http/https probes e.g \"status\" or \"checks[0].state\"",
+          "type": "string"
+        },
+        "probeJsonValue": {
+          "description": "Expected value at probeJsonPath. The path only has to exist if not given",
+          "type": "string"
+        },
+        "probeMethod": {
+          "description": "Method of http/https probes, GET if not given",
+          "type": "string"
+        },
         "probePort": {
           "description": "The l4port to probe on",
           "type": "integer"
@@ -6360,6 +7363,18 @@ func init() {
           "description": "Response for http/https probes",
           "type": "string"
         },
+        "probeStatus": {
+          "description": "Status codes accepted by http/https probes e.g \"200-399\" or \"200,204\". Redirects are followed and only 200 is accepted if not given",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of http/https/grpc probes in seconds",
+          "type": "integer"
+        },
+        "probeTls": {
+          "description": "Use TLS for grpc probes",
+          "type": "boolean"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string"
@@ -6522,6 +7537,88 @@ func init() {
         }
       }
     },
+    "FirewallImportEntry": {
+      "type": "object",
+      "required": [
+        "format",
+        "ruleset"
+      ],
+      "properties": {
+        "format": {
+          "description": "Format of the ruleset - iptables-save or ip6tables-save output, or nft -j list ruleset output",
+          "type": "string",
+          "enum": [
+            "iptables",
+            "ip6tables",
+            "nft"
+          ]
+        },
+        "ruleset": {
+          "description": "Ruleset to import",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallImportResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigApplyChange"
+          }
+        },
+        "dryRun": {
+          "description": "True if the changes were only computed and not applied",
+          "type": "boolean"
+        },
+        "translated": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallImportRule"
+          }
+        },
+        "untranslated": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallImportSkip"
+          }
+        }
+      }
+    },
+    "FirewallImportRule": {
+      "type": "object",
+      "properties": {
+        "keys": {
+          "description": "Keys of the rules the source rule was translated to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "description": "Kind of the rules the source rule was translated to (firewall, loadbalancer)",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Source rule",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallImportSkip": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "Reason the source rule could not be translated",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Source rule",
+          "type": "string"
+        }
+      }
+    },
     "FirewallOptionEntry": {
       "type": "object",
       "properties": {
@@ -6529,6 +7626,11 @@ func init() {
           "description": "Allow any matching rule",
           "type": "boolean"
         },
+        "bytes": {
+          "description": "Bytes which matched the rule (read-only)",
+          "type": "integer",
+          "format": "uint64"
+        },
         "counter": {
           "description": "traffic counters",
           "type": "string"
@@ -6541,14 +7643,27 @@ func init() {
           "description": "Drop any matching rule",
           "type": "boolean"
         },
+        "expiresAt": {
+          "description": "Time (unix seconds) at which the rule is removed, 0 - never",
+          "type": "integer"
+        },
         "fwMark": {
           "description": "Set a fwmark for any matching rule",
           "type": "integer"
         },
+        "lastHit": {
+          "description": "Time the rule was last seen matching in unix seconds, 0 if never (read-only)",
+          "type": "integer"
+        },
         "onDefault": {
           "description": "Trigger only on default cases",
           "type": "boolean"
         },
+        "packets": {
+          "description": "Packets which matched the rule (read-only)",
+          "type": "integer",
+          "format": "uint64"
+        },
         "record": {
           "description": "Record or dump for matching rule",
           "type": "boolean"
@@ -6572,6 +7687,18 @@ func init() {
         "trap": {
           "description": "Trap anything matching rule",
           "type": "boolean"
+        },
+        "ttl": {
+          "description": "Seconds after which the rule is removed once installed",
+          "type": "integer"
+        },
+        "ttlExpiresAt": {
+          "description": "Time (unix seconds) the TTL of the rule runs out (read-only)",
+          "type": "integer"
+        },
+        "validFrom": {
+          "description": "Time (unix seconds) from which the rule is installed, 0 - now",
+          "type": "integer"
         }
       }
     },
@@ -6582,6 +7709,10 @@ func init() {
           "description": "Destination IP in CIDR notation",
           "type": "string"
         },
+        "destinationIPSet": {
+          "description": "Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused",
+          "type": "string"
+        },
         "maxDestinationPort": {
           "description": "Maximum  destination port range",
           "type": "integer"
@@ -6613,6 +7744,38 @@ func init() {
         "sourceIP": {
           "description": "Source IP in CIDR notation",
           "type": "string"
+        },
+        "sourceIPSet": {
+          "description": "Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallStatsEntry": {
+      "type": "object",
+      "properties": {
+        "bytes": {
+          "description": "Bytes which matched the rule",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastHit": {
+          "description": "Time the rule was last seen matching in unix seconds (0 - never)",
+          "type": "integer",
+          "format": "int64"
+        },
+        "packets": {
+          "description": "Packets which matched the rule",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "ruleArguments": {
+          "$ref": "#/definitions/FirewallRuleEntry"
+        },
+        "since": {
+          "description": "Time the rule started matching traffic in unix seconds",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -6677,6 +7840,97 @@ func init() {
         }
       }
     },
+    "IPPoolAllocEntry": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "description": "Allocated IP address",
+          "type": "string"
+        },
+        "owner": {
+          "description": "Service owning the allocation",
+          "type": "string"
+        },
+        "rules": {
+          "description": "Number of LB rules using the address",
+          "type": "integer"
+        }
+      }
+    },
+    "IPPoolEntry": {
+      "type": "object",
+      "required": [
+        "name",
+        "cidr"
+      ],
+      "properties": {
+        "cidr": {
+          "description": "IPv4 or IPv6 subnet of the pool",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the IP pool. Use \"default\" for the pool used by LB rules without a service IP",
+          "type": "string"
+        }
+      }
+    },
+    "IPPoolGetEntry": {
+      "type": "object",
+      "properties": {
+        "allocations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPPoolAllocEntry"
+          }
+        },
+        "cidr": {
+          "description": "IPv4 or IPv6 subnet of the pool",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the IP pool",
+          "type": "string"
+        }
+      }
+    },
+    "IPSetEntry": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "members": {
+          "description": "Member prefixes or addresses of the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the IP set",
+          "type": "string"
+        }
+      }
+    },
+    "IPSetMemberEntry": {
+      "type": "object",
+      "properties": {
+        "add": {
+          "description": "Prefixes or addresses to add to the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "remove": {
+          "description": "Prefixes or addresses to remove from the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "IPv4AddressEntry": {
       "type": "object",
       "required": [
@@ -6838,11 +8092,92 @@ func init() {
         }
       }
     },
-    "LbRuleCountMetrics": {
+    "LbRuleCountMetrics": {
+      "type": "object",
+      "properties": {
+        "lb_rule_count": {
+          "type": "number"
+        }
+      }
+    },
+    "LoadbalanceBatch": {
+      "type": "object",
+      "properties": {
+        "ops": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceBatchOp"
+          }
+        }
+      }
+    },
+    "LoadbalanceBatchItem": {
+      "type": "object",
+      "required": [
+        "index",
+        "status"
+      ],
+      "properties": {
+        "code": {
+          "description": "Error code of a failed operation",
+          "type": "integer"
+        },
+        "error": {
+          "description": "Error message of a failed operation",
+          "type": "string"
+        },
+        "index": {
+          "description": "Position of the operation in the batch",
+          "type": "integer"
+        },
+        "oper": {
+          "description": "Operation performed",
+          "type": "string"
+        },
+        "status": {
+          "description": "Outcome of the operation",
+          "type": "string",
+          "enum": [
+            "applied",
+            "failed",
+            "rolled-back",
+            "skipped"
+          ]
+        }
+      }
+    },
+    "LoadbalanceBatchOp": {
+      "type": "object",
+      "required": [
+        "oper",
+        "rule"
+      ],
+      "properties": {
+        "oper": {
+          "description": "Operation to perform on the load balancer rule",
+          "type": "string",
+          "enum": [
+            "add",
+            "delete"
+          ]
+        },
+        "rule": {
+          "$ref": "#/definitions/LoadbalanceEntry"
+        }
+      }
+    },
+    "LoadbalanceBatchResult": {
       "type": "object",
       "properties": {
-        "lb_rule_count": {
-          "type": "number"
+        "committed": {
+          "description": "True if all operations of the batch were applied",
+          "type": "boolean"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceBatchItem"
+          }
         }
       }
     },
@@ -6858,9 +8193,25 @@ func init() {
           "type": "array",
           "items": {
             "properties": {
+              "expiresAt": {
+                "description": "Time (unix seconds) at which the source is removed, 0 - never",
+                "type": "integer"
+              },
               "prefix": {
                 "description": "IP address for allowed source access",
                 "type": "string"
+              },
+              "ttl": {
+                "description": "Seconds after which the source is removed once allowed",
+                "type": "integer"
+              },
+              "ttlExpiresAt": {
+                "description": "Time (unix seconds) the TTL of the source runs out (read-only)",
+                "type": "integer"
+              },
+              "validFrom": {
+                "description": "Time (unix seconds) from which the source is allowed, 0 - now",
+                "type": "integer"
               }
             }
           }
@@ -6978,6 +8329,26 @@ func init() {
                 2
               ]
             },
+            "outlierConsecutiveErrors": {
+              "description": "Consecutive connection failures after which an end-point is ejected by outlier detection (0 - disabled)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "outlierEjectTime": {
+              "description": "Base ejection time (in seconds) of outlier end-points, doubled on each ejection",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "outlierErrorRate": {
+              "description": "Connection failure percentage after which an end-point is ejected by outlier detection (0 - disabled)",
+              "type": "integer",
+              "format": "uint8"
+            },
+            "outlierMaxEjectPercent": {
+              "description": "Max percentage of end-points of the rule ejected at once",
+              "type": "integer",
+              "format": "uint8"
+            },
             "port": {
               "description": "(Min) port number for the access",
               "type": "integer"
@@ -6995,6 +8366,11 @@ func init() {
               "type": "integer",
               "format": "int32"
             },
+            "probeRise": {
+              "description": "number of successful probes before an end-point is marked active again",
+              "type": "integer",
+              "format": "int32"
+            },
             "probeTimeout": {
               "description": "value for probe timer (in seconds)",
               "type": "integer",
@@ -7006,7 +8382,7 @@ func init() {
               "format": "uint16"
             },
             "probereq": {
-              "description": "probe request string",
+              "description": "probe request string or check command of exec probes",
               "type": "string"
             },
             "proberesp": {
@@ -7023,6 +8399,8 @@ func init() {
                 "http",
                 "https",
                 "ping",
+                "grpc",
+                "exec",
                 "none"
               ]
             },
@@ -7051,7 +8429,7 @@ func init() {
               ]
             },
             "sel": {
-              "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 0-default)",
+              "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 7-maglev, 8-wlc, 9-lrt with monitor, 0-default)",
               "type": "integer",
               "enum": [
                 0,
@@ -7060,9 +8438,17 @@ func init() {
                 3,
                 4,
                 5,
-                6
+                6,
+                7,
+                8,
+                9
               ]
             },
+            "slowStart": {
+              "description": "slow-start window (in seconds) over which a recovered end-point ramps up to its full weight",
+              "type": "integer",
+              "format": "uint32"
+            },
             "snat": {
               "description": "snat rule",
               "type": "boolean"
@@ -7998,11 +9384,8 @@ func init() {
           "type": "string"
         },
         "role": {
-          "type": "string",
-          "enum": [
-            "admin",
-            "viewer"
-          ]
+          "description": "Role of the user. One of the built-in roles admin, viewer, auditor, lb-operator, network-admin or a role from the RBAC config",
+          "type": "string"
         },
         "username": {
           "type": "string"
@@ -8070,109 +9453,446 @@ func init() {
         }
       }
     },
-    "VlanMemberEntry": {
-      "type": "object",
-      "properties": {
-        "dev": {
-          "description": "Interface device name",
-          "type": "string"
-        },
-        "tagged": {
-          "description": "Tagged status added",
-          "type": "boolean"
+    "VlanMemberEntry": {
+      "type": "object",
+      "properties": {
+        "dev": {
+          "description": "Interface device name",
+          "type": "string"
+        },
+        "tagged": {
+          "description": "Tagged status added",
+          "type": "boolean"
+        }
+      }
+    },
+    "VxlanBridgeEntry": {
+      "type": "object",
+      "required": [
+        "epIntf",
+        "vxlanID"
+      ],
+      "properties": {
+        "epIntf": {
+          "type": "string"
+        },
+        "vxlanID": {
+          "type": "integer"
+        }
+      }
+    },
+    "VxlanEntry": {
+      "type": "object",
+      "required": [
+        "vxlanName",
+        "epIntf",
+        "vxlanID",
+        "peerIP"
+      ],
+      "properties": {
+        "epIntf": {
+          "type": "string"
+        },
+        "peerIP": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "vxlanID": {
+          "type": "integer"
+        },
+        "vxlanName": {
+          "type": "string"
+        }
+      }
+    },
+    "VxlanPeerEntry": {
+      "type": "object",
+      "required": [
+        "peerIP"
+      ],
+      "properties": {
+        "peerIP": {
+          "type": "string"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "BearerAuth": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "BearerAuth": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Loxilb REST API for Baremetal Scenarios",
+    "title": "Loxilb Rest API",
+    "version": "0.0.1"
+  },
+  "host": "0.0.0.0:11111",
+  "basePath": "/netlox/v1",
+  "paths": {
+    "/audit": {
+      "get": {
+        "description": "Get the audit log of configuration changes made through the API, newest first. Every non-GET request is recorded along with the principal which made it, the request body and the result code. Paging runs backwards across rotated audit files.",
+        "summary": "Get the audit log",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Number of entries to fetch (default is 100)",
+            "name": "lines",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only get entries made by this user",
+            "name": "user",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only get entries of this operation ID",
+            "name": "operation",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Opaque pagination cursor from a previous response's nextCursor",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/AuditLog"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/login": {
+      "post": {
+        "security": [],
+        "description": "Authenticates a user and returns a JWT token if the credentials are valid.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "auth"
+        ],
+        "summary": "User login",
+        "parameters": [
+          {
+            "description": "User credentials",
+            "name": "user",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/LoginResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/logout": {
+      "post": {
+        "security": [
+          {
+            "BearerAuth": []
+          }
+        ],
+        "description": "Invalidates the user's token and logs them out.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "auth"
+        ],
+        "summary": "User logout",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/MessageResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/auth/token/upgrade": {
+      "post": {
+        "description": "Using manual token, It need to upgrade the token.",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "auth"
+        ],
+        "summary": "Upgrade token",
+        "parameters": [
+          {
+            "description": "license as a token",
+            "name": "token",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UpdateLicenseRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/UpdateLicenseRequest"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Unauthorized",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "VxlanBridgeEntry": {
-      "type": "object",
-      "required": [
-        "epIntf",
-        "vxlanID"
-      ],
-      "properties": {
-        "epIntf": {
-          "type": "string"
-        },
-        "vxlanID": {
-          "type": "integer"
+    "/auth/users": {
+      "get": {
+        "description": "Retrieves all users from the database and returns them as a JSON response.",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Fetch all users",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/User"
+              }
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
-      }
-    },
-    "VxlanEntry": {
-      "type": "object",
-      "required": [
-        "vxlanName",
-        "epIntf",
-        "vxlanID",
-        "peerIP"
-      ],
-      "properties": {
-        "epIntf": {
-          "type": "string"
-        },
-        "peerIP": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "security": [],
+        "description": "Creates a new user in the system",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Create a new user",
+        "parameters": [
+          {
+            "description": "User data",
+            "name": "user",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        },
-        "vxlanID": {
-          "type": "integer"
-        },
-        "vxlanName": {
-          "type": "string"
         }
       }
     },
-    "VxlanPeerEntry": {
-      "type": "object",
-      "required": [
-        "peerIP"
-      ],
-      "properties": {
-        "peerIP": {
-          "type": "string"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "BearerAuth": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "BearerAuth": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Loxilb REST API for Baremetal Scenarios",
-    "title": "Loxilb Rest API",
-    "version": "0.0.1"
-  },
-  "host": "0.0.0.0:11111",
-  "basePath": "/netlox/v1",
-  "paths": {
-    "/auth/login": {
-      "post": {
-        "security": [],
-        "description": "Authenticates a user and returns a JWT token if the credentials are valid.",
+    "/auth/users/{id}": {
+      "put": {
+        "security": [
+          {
+            "BearerAuth": []
+          }
+        ],
+        "description": "Updates an existing user with the provided JSON payload",
+        "consumes": [
+          "application/json"
+        ],
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "users"
+        ],
+        "summary": "Update user",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "User ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "User data",
+            "name": "user",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "security": [
+          {
+            "BearerAuth": []
+          }
+        ],
+        "description": "Deletes a user by its ID",
         "consumes": [
           "application/json"
         ],
@@ -8180,41 +9900,100 @@ func init() {
           "application/json"
         ],
         "tags": [
-          "auth"
+          "users"
         ],
-        "summary": "User login",
+        "summary": "Delete user",
         "parameters": [
           {
-            "description": "User credentials",
-            "name": "user",
+            "type": "integer",
+            "description": "User ID",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/MessageResponse"
+            }
+          },
+          "500": {
+            "description": "Internal Server Error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/apply": {
+      "post": {
+        "description": "Compute the difference between the desired state document and the current configuration of loadbalancer, endpoint, firewall, mirror, policy and bfd objects, and apply it in dependency order. Sections missing from the document are left untouched. If any step fails, the already applied steps are rolled back.",
+        "summary": "Apply a desired configuration state",
+        "parameters": [
+          {
+            "description": "Desired state document in the same layout as /config/export, with an optional bfd section",
+            "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/User"
+              "type": "object"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "Only compute and return the changes without applying them",
+            "name": "dryRun",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/LoginResponse"
+              "$ref": "#/definitions/ConfigApplyResult"
             }
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "401": {
-            "description": "Unauthorized",
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal Server Error",
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8222,39 +10001,171 @@ func init() {
         }
       }
     },
-    "/auth/logout": {
+    "/config/bfd": {
       "post": {
-        "security": [
+        "description": "Create vlan interface in the device",
+        "summary": "Create vlan interface in the device",
+        "parameters": [
           {
-            "BearerAuth": []
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BfdEntry"
+            }
           }
         ],
-        "description": "Invalidates the user's token and logs them out.",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "auth"
-        ],
-        "summary": "User logout",
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. BFD session not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bfd/all": {
+      "get": {
+        "description": "Get BFD session inforrmation",
+        "summary": "Get BFD session inforrmation in the device",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/MessageResponse"
+              "type": "object",
+              "properties": {
+                "Attr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BfdGetEntry"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bfd/remoteIP/{remote_ip}": {
+      "delete": {
+        "description": "Delete a BFD session",
+        "summary": "Delete a BFD session",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Remote IP address",
+            "name": "remote_ip",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Cluster instance name",
+            "name": "instance",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. BFD session already exists",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal Server Error",
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8262,224 +10173,127 @@ func init() {
         }
       }
     },
-    "/auth/token/upgrade": {
+    "/config/bgp/global": {
       "post": {
-        "description": "Using manual token, It need to upgrade the token.",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "auth"
-        ],
-        "summary": "Upgrade token",
+        "description": "Adds a BGP global config",
+        "summary": "Adds a BGP global config",
         "parameters": [
           {
-            "description": "license as a token",
-            "name": "token",
+            "description": "Attributes of bgp global config",
+            "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/UpdateLicenseRequest"
+              "$ref": "#/definitions/BGPGlobalConfig"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/UpdateLicenseRequest"
-            }
+          "204": {
+            "description": "OK"
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "401": {
-            "description": "Unauthorized",
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal Server Error",
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/auth/users": {
-      "get": {
-        "description": "Retrieves all users from the database and returns them as a JSON response.",
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "users"
-        ],
-        "summary": "Fetch all users",
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "404": {
+            "description": "Resource not found",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/User"
-              }
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict.",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal Server Error",
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      },
+      }
+    },
+    "/config/bgp/neigh": {
       "post": {
-        "security": [],
-        "description": "Creates a new user in the system",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "users"
-        ],
-        "summary": "Create a new user",
+        "description": "Adds a BGP Neighbor",
+        "summary": "Adds a BGP Neighbor",
         "parameters": [
           {
-            "description": "User data",
-            "name": "user",
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/BGPNeigh"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "$ref": "#/definitions/User"
-            }
+          "204": {
+            "description": "OK"
           },
           "400": {
-            "description": "Bad Request",
+            "description": "Malformed arguments for API call",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
-          "500": {
-            "description": "Internal Server Error",
+          "401": {
+            "description": "Invalid authentication credentials",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      }
-    },
-    "/auth/users/{id}": {
-      "put": {
-        "security": [
-          {
-            "BearerAuth": []
-          }
-        ],
-        "description": "Updates an existing user with the provided JSON payload",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "users"
-        ],
-        "summary": "Update user",
-        "parameters": [
-          {
-            "type": "integer",
-            "description": "User ID",
-            "name": "id",
-            "in": "path",
-            "required": true
           },
-          {
-            "description": "User data",
-            "name": "user",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Capacity insufficient",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/Error"
             }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
+          },
+          "404": {
+            "description": "Resource not found",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/Error"
             }
           },
-          "400": {
-            "description": "Bad Request",
+          "409": {
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "500": {
-            "description": "Internal Server Error",
+            "description": "Internal service error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          }
-        }
-      },
-      "delete": {
-        "security": [
-          {
-            "BearerAuth": []
-          }
-        ],
-        "description": "Deletes a user by its ID",
-        "consumes": [
-          "application/json"
-        ],
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "users"
-        ],
-        "summary": "Delete user",
-        "parameters": [
-          {
-            "type": "integer",
-            "description": "User ID",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/MessageResponse"
-            }
           },
-          "500": {
-            "description": "Internal Server Error",
+          "503": {
+            "description": "Maintenance mode",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8487,22 +10301,25 @@ func init() {
         }
       }
     },
-    "/config/bfd": {
-      "post": {
-        "description": "Create vlan interface in the device",
-        "summary": "Create vlan interface in the device",
-        "parameters": [
-          {
-            "description": "Attributes for Vlan Interface",
-            "name": "attr",
-            "in": "body",
-            "required": true,
+    "/config/bgp/neigh/all": {
+      "get": {
+        "description": "Get the all of BGP Neighbor",
+        "summary": "Get the all of BGP Neighbor",
+        "responses": {
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/BfdEntry"
+              "type": "object",
+              "properties": {
+                "bgpNeiAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BGPNeighGetEntry"
+                  }
+                }
+              }
             }
-          }
-        ],
-        "responses": {
+          },
           "204": {
             "description": "OK"
           },
@@ -8531,7 +10348,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session not found",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8551,23 +10368,34 @@ func init() {
         }
       }
     },
-    "/config/bfd/all": {
-      "get": {
-        "description": "Get BFD session inforrmation",
-        "summary": "Get BFD session inforrmation in the device",
+    "/config/bgp/neigh/{ip_address}": {
+      "delete": {
+        "description": "Delete a BGP Neighbor",
+        "summary": "Delete a BGP neighbor",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Neighbor IP address",
+            "name": "ip_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Remote ASN number",
+            "name": "remoteAs",
+            "in": "query"
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "Attr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/BfdGetEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -8576,6 +10404,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. Neigh already exists",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -8591,23 +10437,19 @@ func init() {
         }
       }
     },
-    "/config/bfd/remoteIP/{remote_ip}": {
-      "delete": {
-        "description": "Delete a BFD session",
-        "summary": "Delete a BFD session",
+    "/config/bgp/policy/apply": {
+      "post": {
+        "description": "Apply BGP Policy in neighbor",
+        "summary": "Apply BGP Policy in neighbor",
         "parameters": [
           {
-            "type": "string",
-            "description": "Remote IP address",
-            "name": "remote_ip",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Cluster instance name",
-            "name": "instance",
-            "in": "query"
+            "description": "Attributes of bgp neighbor",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
+            }
           }
         ],
         "responses": {
@@ -8639,7 +10481,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session already exists",
+            "description": "Resource Conflict.",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8657,20 +10499,18 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/bgp/global": {
-      "post": {
-        "description": "Adds a BGP global config",
-        "summary": "Adds a BGP global config",
+      },
+      "delete": {
+        "description": "Delete BGP Policy in neighbor. It don't need \"routeAction\" in the attr body",
+        "summary": "Delete BGP Policy in neighbor",
         "parameters": [
           {
-            "description": "Attributes of bgp global config",
+            "description": "Attributes of bgp neighbor",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPGlobalConfig"
+              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
             }
           }
         ],
@@ -8703,7 +10543,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict.",
+            "description": "Resource Conflict. Neigh already exists",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -8723,18 +10563,25 @@ func init() {
         }
       }
     },
-    "/config/bgp/neigh": {
+    "/config/bgp/policy/definedsets/{defineset_type}": {
       "post": {
-        "description": "Adds a BGP Neighbor",
-        "summary": "Adds a BGP Neighbor",
+        "description": "Adds a BGP definedsets for making Policy",
+        "summary": "Adds a BGP  definedsets for making Policy",
         "parameters": [
+          {
+            "type": "string",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
+            "in": "path",
+            "required": true
+          },
           {
             "description": "Attributes of bgp neighbor",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPNeigh"
+              "$ref": "#/definitions/BGPPolicyDefinedSetsMod"
             }
           }
         ],
@@ -8787,20 +10634,36 @@ func init() {
         }
       }
     },
-    "/config/bgp/neigh/all": {
+    "/config/bgp/policy/definedsets/{defineset_type}/{type_name}": {
       "get": {
-        "description": "Get the all of BGP Neighbor",
-        "summary": "Get the all of BGP Neighbor",
+        "description": "Get the all of BGP, prefix/neighbor/community/extcommunity/aspath/largecommunity",
+        "summary": "Get the all of BGP definedsets",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "type name",
+            "name": "type_name",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "bgpNeiAttr": {
+                "definedsetsAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BGPNeighGetEntry"
+                    "$ref": "#/definitions/BGPPolicyDefinedSetGetEntry"
                   }
                 }
               }
@@ -8852,26 +10715,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/bgp/neigh/{ip_address}": {
+      },
       "delete": {
-        "description": "Delete a BGP Neighbor",
-        "summary": "Delete a BGP neighbor",
+        "description": "Delete a BGP definedsets",
+        "summary": "Delete a BGP definedsets",
         "parameters": [
           {
             "type": "string",
-            "description": "Neighbor IP address",
-            "name": "ip_address",
+            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
+            "name": "defineset_type",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "description": "Remote ASN number",
-            "name": "remoteAs",
-            "in": "query"
+            "type": "string",
+            "description": "type name",
+            "name": "type_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -8923,10 +10784,10 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/apply": {
+    "/config/bgp/policy/definitions": {
       "post": {
-        "description": "Apply BGP Policy in neighbor",
-        "summary": "Apply BGP Policy in neighbor",
+        "description": "Adds a BGP Policy",
+        "summary": "Adds a BGP Policy",
         "parameters": [
           {
             "description": "Attributes of bgp neighbor",
@@ -8934,7 +10795,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
+              "$ref": "#/definitions/BGPPolicyDefinitionsMod"
             }
           }
         ],
@@ -8985,19 +10846,59 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/config/bgp/policy/definitions/all": {
+      "get": {
+        "description": "Get BGP Policy definitions",
+        "summary": "Get BGP Policy definitions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "bgpPolicyAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/BGPPolicyDefinitionsMod"
+                  }
+                }
+              }
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/bgp/policy/definitions/{policy_name}": {
       "delete": {
-        "description": "Delete BGP Policy in neighbor. It don't need \"routeAction\" in the attr body",
-        "summary": "Delete BGP Policy in neighbor",
+        "description": "Delete a BGP Policy",
+        "summary": "Delete a BGP policy",
         "parameters": [
           {
-            "description": "Attributes of bgp neighbor",
-            "name": "attr",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BGPApplyPolicyToNeighborMod"
-            }
+            "type": "string",
+            "description": "The name of the community",
+            "name": "policy_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -9049,25 +10950,18 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definedsets/{defineset_type}": {
+    "/config/cistate": {
       "post": {
-        "description": "Adds a BGP definedsets for making Policy",
-        "summary": "Adds a BGP  definedsets for making Policy",
+        "description": "Informs Current Cluster Instance state in the device",
+        "summary": "Informs Current Cluster Instance state in the device",
         "parameters": [
           {
-            "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "Attributes of bgp neighbor",
+            "description": "Attributes for CI State",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPPolicyDefinedSetsMod"
+              "$ref": "#/definitions/CIStatusEntry"
             }
           }
         ],
@@ -9120,41 +11014,102 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definedsets/{defineset_type}/{type_name}": {
+    "/config/cistate/all": {
       "get": {
-        "description": "Get the all of BGP, prefix/neighbor/community/extcommunity/aspath/largecommunity",
-        "summary": "Get the all of BGP definedsets",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
+        "description": "Get Cluster Instance State in the device",
+        "summary": "Get Cluster Instance State in the device",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "Attr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/CIStatusGetEntry"
+                  }
+                }
+              }
+            }
           },
-          {
-            "type": "string",
-            "description": "type name",
-            "name": "type_name",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
-        ],
+        }
+      }
+    },
+    "/config/conntrack/all": {
+      "get": {
+        "description": "Get all of the conntrack infomation for all of the service.",
+        "summary": "Get all of the conntrack entries.",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "definedsetsAttr": {
+                "ctAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BGPPolicyDefinedSetGetEntry"
+                    "$ref": "#/definitions/ConntrackEntry"
                   }
                 }
               }
             }
           },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/cors": {
+      "post": {
+        "description": "Post full K8s metadata set (Pod, Service, Endpoint, Node, Namespace)",
+        "summary": "Post full K8s metadata set (Pod, Service, Endpoint, Node, Namespace)",
+        "parameters": [
+          {
+            "description": "Attributes for Vlan Interface",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/CorsEntry"
+            }
+          }
+        ],
+        "responses": {
           "204": {
             "description": "OK"
           },
@@ -9183,7 +11138,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "description": "Resource Conflict. BFD session not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9201,22 +11156,40 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/config/cors/all": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "summary": "Get all related K8s metadata (Pod, Service, Endpoint, Node, Namespace)",
+        "responses": {
+          "200": {
+            "description": "get cors list",
+            "schema": {
+              "properties": {
+                "corsAttr": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/config/cors/{cors_url}": {
       "delete": {
-        "description": "Delete a BGP definedsets",
-        "summary": "Delete a BGP definedsets",
+        "description": "Delete a BFD session",
+        "summary": "Delete a BFD session",
         "parameters": [
           {
             "type": "string",
-            "description": "defineset type one of prefix/neighbor/community/extcommunity/aspath/largecommunity",
-            "name": "defineset_type",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "type name",
-            "name": "type_name",
+            "description": "cors url ex) http://localhost:3000",
+            "name": "cors_url",
             "in": "path",
             "required": true
           }
@@ -9250,7 +11223,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict. BFD session already exists",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9270,18 +11243,18 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definitions": {
+    "/config/endpoint": {
       "post": {
-        "description": "Adds a BGP Policy",
-        "summary": "Adds a BGP Policy",
+        "description": "Adds a LB endpoint for monitoring",
+        "summary": "Adds a LB endpoint for monitoring",
         "parameters": [
           {
-            "description": "Attributes of bgp neighbor",
+            "description": "Attributes of end point",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BGPPolicyDefinitionsMod"
+              "$ref": "#/definitions/EndPoint"
             }
           }
         ],
@@ -9334,20 +11307,20 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definitions/all": {
+    "/config/endpoint/all": {
       "get": {
-        "description": "Get BGP Policy definitions",
-        "summary": "Get BGP Policy definitions",
+        "description": "Get End-Points State in loxilb",
+        "summary": "Get End-Points State in loxilb",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "bgpPolicyAttr": {
+                "Attr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/BGPPolicyDefinitionsMod"
+                    "$ref": "#/definitions/EndPointGetEntry"
                   }
                 }
               }
@@ -9374,17 +11347,35 @@ func init() {
         }
       }
     },
-    "/config/bgp/policy/definitions/{policy_name}": {
+    "/config/endpoint/epipaddress/{ip_address}": {
       "delete": {
-        "description": "Delete a BGP Policy",
-        "summary": "Delete a BGP policy",
+        "description": "Delete an LB end-point from monitoring",
+        "summary": "Delete an LB end-point from monitoring",
         "parameters": [
           {
             "type": "string",
-            "description": "The name of the community",
-            "name": "policy_name",
+            "description": "Attributes of end point",
+            "name": "ip_address",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Endpoint Identifier",
+            "name": "name",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Probe type",
+            "name": "probe_type",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "description": "Probe port",
+            "name": "probe_port",
+            "in": "query"
           }
         ],
         "responses": {
@@ -9416,7 +11407,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. Neigh already exists",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9436,18 +11427,18 @@ func init() {
         }
       }
     },
-    "/config/cistate": {
+    "/config/endpointhoststate": {
       "post": {
-        "description": "Informs Current Cluster Instance state in the device",
-        "summary": "Informs Current Cluster Instance state in the device",
+        "description": "Sets the state of a host which can have multiple endpoints",
+        "summary": "Sets the state of a host",
         "parameters": [
           {
-            "description": "Attributes for CI State",
+            "description": "Attributes of end point",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CIStatusEntry"
+              "$ref": "#/definitions/EndPointHostState"
             }
           }
         ],
@@ -9500,63 +11491,32 @@ func init() {
         }
       }
     },
-    "/config/cistate/all": {
-      "get": {
-        "description": "Get Cluster Instance State in the device",
-        "summary": "Get Cluster Instance State in the device",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "Attr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/CIStatusGetEntry"
-                  }
-                }
-              }
-            }
-          },
-          "401": {
-            "description": "Invalid authentication credentials",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "500": {
-            "description": "Internal service error",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "503": {
-            "description": "Maintenance mode",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          }
-        }
-      }
-    },
-    "/config/conntrack/all": {
+    "/config/export": {
       "get": {
-        "description": "Get all of the conntrack infomation for all of the service.",
-        "summary": "Get all of the conntrack entries.",
+        "description": "Export cluster, endpoint, firewall, loadbalancer, mirror, and policy configurations as a JSON file.",
+        "produces": [
+          "application/json"
+        ],
+        "summary": "Export all configurations",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma-separated list of components to export (cluster, endpoint, firewall, loadbalancer, mirror, policy). If not specified, all components are exported.",
+            "name": "components",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "Configuration JSON file download",
             "schema": {
-              "type": "object",
-              "properties": {
-                "ctAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/ConntrackEntry"
-                  }
-                }
-              }
+              "type": "file"
+            }
+          },
+          "400": {
+            "description": "Invalid parameters",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -9580,18 +11540,18 @@ func init() {
         }
       }
     },
-    "/config/cors": {
+    "/config/fdb": {
       "post": {
-        "description": "Post full K8s metadata set (Pod, Service, Endpoint, Node, Namespace)",
-        "summary": "Post full K8s metadata set (Pod, Service, Endpoint, Node, Namespace)",
+        "description": "Assign FDB in the device",
+        "summary": "Assign FDB in the device",
         "parameters": [
           {
-            "description": "Attributes for Vlan Interface",
+            "description": "Attributes for IPv4 address",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/CorsEntry"
+              "$ref": "#/definitions/FDBEntry"
             }
           }
         ],
@@ -9624,7 +11584,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session not found",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9644,38 +11604,62 @@ func init() {
         }
       }
     },
-    "/config/cors/all": {
+    "/config/fdb/all": {
       "get": {
-        "produces": [
-          "application/json"
-        ],
-        "summary": "Get all related K8s metadata (Pod, Service, Endpoint, Node, Namespace)",
+        "description": "Get FDB in the device(interface).",
+        "summary": "Get FDB in the device(interface)",
         "responses": {
           "200": {
-            "description": "get cors list",
+            "description": "OK",
             "schema": {
+              "type": "object",
               "properties": {
-                "corsAttr": {
+                "fdbAttr": {
                   "type": "array",
                   "items": {
-                    "type": "string"
+                    "$ref": "#/definitions/FDBEntry"
                   }
                 }
               }
             }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "/config/cors/{cors_url}": {
+    "/config/fdb/{mac_address}/dev/{if_name}": {
       "delete": {
-        "description": "Delete a BFD session",
-        "summary": "Delete a BFD session",
+        "description": "Delete FDB in the device",
+        "summary": "Delete FDB in the device",
         "parameters": [
           {
             "type": "string",
-            "description": "cors url ex) http://localhost:3000",
-            "name": "cors_url",
+            "description": "Attributes IPv4 Address in the device",
+            "name": "mac_address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Attributes of the target device",
+            "name": "if_name",
             "in": "path",
             "required": true
           }
@@ -9709,7 +11693,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict. BFD session already exists",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9729,18 +11713,18 @@ func init() {
         }
       }
     },
-    "/config/endpoint": {
+    "/config/firewall": {
       "post": {
-        "description": "Adds a LB endpoint for monitoring",
-        "summary": "Adds a LB endpoint for monitoring",
+        "description": "Create a new firewall config for security.",
+        "summary": "Create a new firewall config",
         "parameters": [
           {
-            "description": "Attributes of end point",
+            "description": "Attributes for  firewall sevice",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/EndPoint"
+              "$ref": "#/definitions/FirewallEntry"
             }
           }
         ],
@@ -9773,7 +11757,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict.",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9791,25 +11775,86 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/config/endpoint/all": {
-      "get": {
-        "description": "Get End-Points State in loxilb",
-        "summary": "Get End-Points State in loxilb",
+      },
+      "delete": {
+        "description": "Delete of the firewall service.",
+        "summary": "Delete of the firewall service",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Source IP address",
+            "name": "sourceIP",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Destination IP in CIDR notation",
+            "name": "destinationIP",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Minimum source port range",
+            "name": "minSourcePort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum source port range",
+            "name": "maxSourcePort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Minimum destination port range",
+            "name": "minDestinationPort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Maximum destination port range",
+            "name": "maxDestinationPort",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "the protocol",
+            "name": "protocol",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "the incoming port",
+            "name": "portName",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "User preference for ordering",
+            "name": "preference",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused",
+            "name": "sourceIPSet",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused",
+            "name": "destinationIPSet",
+            "in": "query"
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "Attr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/EndPointGetEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
             }
           },
           "401": {
@@ -9818,6 +11863,24 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -9833,45 +11896,23 @@ func init() {
         }
       }
     },
-    "/config/endpoint/epipaddress/{ip_address}": {
-      "delete": {
-        "description": "Delete an LB end-point from monitoring",
-        "summary": "Delete an LB end-point from monitoring",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Attributes of end point",
-            "name": "ip_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Endpoint Identifier",
-            "name": "name",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Probe type",
-            "name": "probe_type",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "description": "Probe port",
-            "name": "probe_port",
-            "in": "query"
-          }
-        ],
+    "/config/firewall/all": {
+      "get": {
+        "description": "Get all of the firewall configuration.",
+        "summary": "Get all of the firewall config",
         "responses": {
-          "204": {
-            "description": "OK"
-          },
-          "400": {
-            "description": "Malformed arguments for API call",
+          "200": {
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "fwAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/FirewallEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -9880,24 +11921,6 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
-          "403": {
-            "description": "Capacity insufficient",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "404": {
-            "description": "Resource not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
-          "409": {
-            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
-            "schema": {
-              "$ref": "#/definitions/Error"
-            }
-          },
           "500": {
             "description": "Internal service error",
             "schema": {
@@ -9913,24 +11936,33 @@ func init() {
         }
       }
     },
-    "/config/endpointhoststate": {
+    "/config/firewall/import": {
       "post": {
-        "description": "Sets the state of a host which can have multiple endpoints",
-        "summary": "Sets the state of a host",
+        "description": "Translate an iptables-save or nft JSON ruleset to firewall and LB rules and add them. Filter rules become firewall rules, SNAT rules become firewall SNAT rules and DNAT rules become LB rules. Existing rules are left untouched unless an imported rule has the same key. Rules which could not be translated are reported back. If any step fails, the already applied steps are rolled back.",
+        "summary": "Import an iptables or nftables ruleset",
         "parameters": [
           {
-            "description": "Attributes of end point",
+            "description": "Ruleset to import",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/EndPointHostState"
+              "$ref": "#/definitions/FirewallImportEntry"
             }
+          },
+          {
+            "type": "boolean",
+            "description": "Only translate the ruleset and return the changes without applying them",
+            "name": "dryRun",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "OK"
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/FirewallImportResult"
+            }
           },
           "400": {
             "description": "Malformed arguments for API call",
@@ -9957,7 +11989,7 @@ func init() {
             }
           },
           "409": {
-            "description": "Resource Conflict.",
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -9977,32 +12009,31 @@ func init() {
         }
       }
     },
-    "/config/export": {
+    "/config/firewall/stats": {
       "get": {
-        "description": "Export cluster, endpoint, firewall, loadbalancer, mirror, and policy configurations as a JSON file.",
-        "produces": [
-          "application/json"
-        ],
-        "summary": "Export all configurations",
+        "description": "Get packets, bytes and the last hit time of firewall rules. If idle is given, only the rules which had no hits for that many seconds are returned.",
+        "summary": "Get firewall rule statistics",
         "parameters": [
           {
-            "type": "string",
-            "description": "Comma-separated list of components to export (cluster, endpoint, firewall, loadbalancer, mirror, policy). If not specified, all components are exported.",
-            "name": "components",
+            "type": "integer",
+            "description": "Only get rules which had no hits for these many seconds",
+            "name": "idle",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Configuration JSON file download",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "400": {
-            "description": "Invalid parameters",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "type": "object",
+              "properties": {
+                "fwStatsAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/FirewallStatsEntry"
+                  }
+                }
+              }
             }
           },
           "401": {
@@ -10026,18 +12057,39 @@ func init() {
         }
       }
     },
-    "/config/fdb": {
+    "/config/import": {
       "post": {
-        "description": "Assign FDB in the device",
-        "summary": "Assign FDB in the device",
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "summary": "Import configurations",
         "parameters": [
           {
-            "description": "Attributes for IPv4 address",
+            "type": "file",
+            "description": "The configuration file to upload.",
+            "name": "configuration",
+            "in": "formData"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "OK"
+          }
+        }
+      }
+    },
+    "/config/ippool": {
+      "post": {
+        "description": "Create a new IP pool used to allocate LB service IPs.",
+        "summary": "Create a new IP pool",
+        "parameters": [
+          {
+            "description": "Attributes for IP pool",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/FDBEntry"
+              "$ref": "#/definitions/IPPoolEntry"
             }
           }
         ],
@@ -10090,20 +12142,20 @@ func init() {
         }
       }
     },
-    "/config/fdb/all": {
+    "/config/ippool/all": {
       "get": {
-        "description": "Get FDB in the device(interface).",
-        "summary": "Get FDB in the device(interface)",
+        "description": "Get all IP pools along with their allocations.",
+        "summary": "Get all IP pools",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
               "type": "object",
               "properties": {
-                "fdbAttr": {
+                "ipPoolAttr": {
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/FDBEntry"
+                    "$ref": "#/definitions/IPPoolGetEntry"
                   }
                 }
               }
@@ -10130,22 +12182,15 @@ func init() {
         }
       }
     },
-    "/config/fdb/{mac_address}/dev/{if_name}": {
+    "/config/ippool/name/{name}": {
       "delete": {
-        "description": "Delete FDB in the device",
-        "summary": "Delete FDB in the device",
+        "description": "Delete an IP pool. Fails if any of its addresses are in use.",
+        "summary": "Delete an IP pool",
         "parameters": [
           {
             "type": "string",
-            "description": "Attributes IPv4 Address in the device",
-            "name": "mac_address",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Attributes of the target device",
-            "name": "if_name",
+            "description": "Name of the IP pool",
+            "name": "name",
             "in": "path",
             "required": true
           }
@@ -10199,18 +12244,18 @@ func init() {
         }
       }
     },
-    "/config/firewall": {
+    "/config/ipset": {
       "post": {
-        "description": "Create a new firewall config for security.",
-        "summary": "Create a new firewall config",
+        "description": "Create a named IP set, or replace all members of an existing one. Firewall rules and LB allowed sources cannot refer to IP sets till the datapath supports them.",
+        "summary": "Create or replace an IP set",
         "parameters": [
           {
-            "description": "Attributes for  firewall sevice",
+            "description": "Attributes for IP set",
             "name": "attr",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/FirewallEntry"
+              "$ref": "#/definitions/IPSetEntry"
             }
           }
         ],
@@ -10261,64 +12306,59 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "description": "Delete of the firewall service.",
-        "summary": "Delete of the firewall service",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Source IP address",
-            "name": "sourceIP",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Destination IP in CIDR notation",
-            "name": "destinationIP",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Minimum source port range",
-            "name": "minSourcePort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Maximum source port range",
-            "name": "maxSourcePort",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "Minimum destination port range",
-            "name": "minDestinationPort",
-            "in": "query"
+      }
+    },
+    "/config/ipset/all": {
+      "get": {
+        "description": "Get all IP sets along with their members.",
+        "summary": "Get all IP sets",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "ipSetAttr": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/IPSetEntry"
+                  }
+                }
+              }
+            }
           },
-          {
-            "type": "integer",
-            "description": "Maximum destination port range",
-            "name": "maxDestinationPort",
-            "in": "query"
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
-          {
-            "type": "integer",
-            "description": "the protocol",
-            "name": "protocol",
-            "in": "query"
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/config/ipset/name/{name}": {
+      "delete": {
+        "description": "Delete an IP set.",
+        "summary": "Delete an IP set",
+        "parameters": [
           {
             "type": "string",
-            "description": "the incoming port",
-            "name": "portName",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "description": "User preference for ordering",
-            "name": "preference",
-            "in": "query"
+            "description": "Name of the IP set",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -10370,27 +12410,58 @@ func init() {
         }
       }
     },
-    "/config/firewall/all": {
-      "get": {
-        "description": "Get all of the firewall configuration.",
-        "summary": "Get all of the firewall config",
+    "/config/ipset/name/{name}/members": {
+      "put": {
+        "description": "Add and remove members of an IP set in one update.",
+        "summary": "Add or remove IP set members",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Name of the IP set",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "Members to add and remove",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/IPSetMemberEntry"
+            }
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "OK",
+          "204": {
+            "description": "OK"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
             "schema": {
-              "type": "object",
-              "properties": {
-                "fwAttr": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/FirewallEntry"
-                  }
-                }
-              }
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Capacity insufficient",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
-          "401": {
-            "description": "Invalid authentication credentials",
+          "404": {
+            "description": "Resource not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Resource Conflict. VLAN already exists OR dependency VRF/VNET not found",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -10410,27 +12481,6 @@ func init() {
         }
       }
     },
-    "/config/import": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "summary": "Import configurations",
-        "parameters": [
-          {
-            "type": "file",
-            "description": "The configuration file to upload.",
-            "name": "configuration",
-            "in": "formData"
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "OK"
-          }
-        }
-      }
-    },
     "/config/ipv4address": {
       "post": {
         "description": "Assign IPv4 addresses in the device",
@@ -10769,6 +12819,61 @@ func init() {
         }
       }
     },
+    "/config/loadbalancer/batch": {
+      "post": {
+        "description": "Add or delete a list of load balancer rules as a single transaction. Operations are applied in order. If any of them fails, including programming of the datapath, the operations already applied are rolled back and nothing is changed.",
+        "summary": "Apply a batch of load balancer rule operations",
+        "parameters": [
+          {
+            "description": "List of load balancer rule operations",
+            "name": "attr",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatchResult"
+            }
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Batch failed and was rolled back",
+            "schema": {
+              "$ref": "#/definitions/LoadbalanceBatchResult"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/config/loadbalancer/externalipaddress/{ip_address}/port/{port}/portmax/{portmax}/protocol/{proto}": {
       "delete": {
         "description": "Delete an existing load balancer service with .",
@@ -13042,6 +15147,65 @@ func init() {
         }
       }
     },
+    "/config/watch": {
+      "get": {
+        "description": "Stream change events of load balancer rules, endpoint states, HA states, BGP neighbors and ports. Events are sent as server-sent events, or as WebSocket messages if the request is a WebSocket upgrade. Every event carries a resource version. A client can resume a watch after reconnecting by passing the last version it has seen, either as resourceVersion or as the Last-Event-ID header.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "summary": "Watch change events",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Comma separated list of event kinds to watch (loadbalancer, endpoint, hastate, bgpneighbor, port). All kinds are watched if not given",
+            "name": "kinds",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Resume the watch after this resource version",
+            "name": "resourceVersion",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Stream of watch events"
+          },
+          "400": {
+            "description": "Malformed arguments for API call",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Invalid authentication credentials",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "410": {
+            "description": "Resource version is too old or unknown. The client needs to list the objects again and watch from now on",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "Internal service error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "Maintenance mode",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/log-archives": {
       "get": {
         "description": "Retrieve a list of all rotated log archive files available for download.",
@@ -13802,6 +15966,71 @@ func init() {
     }
   },
   "definitions": {
+    "AuditEntry": {
+      "type": "object",
+      "properties": {
+        "body": {
+          "description": "Request body. Secrets are masked and large bodies truncated",
+          "type": "string"
+        },
+        "method": {
+          "description": "HTTP method of the request",
+          "type": "string"
+        },
+        "operationId": {
+          "description": "Operation ID of the API call",
+          "type": "string"
+        },
+        "path": {
+          "description": "API path of the request",
+          "type": "string"
+        },
+        "remoteAddr": {
+          "description": "Address the request came from",
+          "type": "string"
+        },
+        "resultCode": {
+          "description": "HTTP result code of the request",
+          "type": "integer"
+        },
+        "role": {
+          "description": "Role of the principal",
+          "type": "string"
+        },
+        "time": {
+          "description": "Time the request was received (RFC3339)",
+          "type": "string"
+        },
+        "user": {
+          "description": "Principal which made the request",
+          "type": "string"
+        }
+      }
+    },
+    "AuditLog": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "Number of entries in this page",
+          "type": "integer"
+        },
+        "entries": {
+          "description": "Audit entries of this page, newest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/AuditEntry"
+          }
+        },
+        "hasMore": {
+          "description": "Whether older entries remain. True implies nextCursor is set",
+          "type": "boolean"
+        },
+        "nextCursor": {
+          "description": "Opaque cursor for the next (older) page",
+          "type": "string"
+        }
+      }
+    },
     "BGPApplyPolicyToNeighborMod": {
       "type": "object",
       "required": [
@@ -14712,6 +16941,10 @@ func init() {
         "sync"
       ],
       "properties": {
+        "cloudError": {
+          "description": "Last failed cloud hook operation, if any",
+          "type": "string"
+        },
         "instance": {
           "description": "Instance name",
           "type": "string"
@@ -14730,6 +16963,43 @@ func init() {
         }
       }
     },
+    "ConfigApplyChange": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "description": "Change needed to reach the desired state",
+          "type": "string",
+          "enum": [
+            "add",
+            "modify",
+            "delete"
+          ]
+        },
+        "key": {
+          "description": "Key identifying the configuration object",
+          "type": "string"
+        },
+        "kind": {
+          "description": "Kind of the configuration object (loadbalancer, endpoint, firewall, mirror, policy, bfd)",
+          "type": "string"
+        }
+      }
+    },
+    "ConfigApplyResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigApplyChange"
+          }
+        },
+        "dryRun": {
+          "description": "True if the changes were only computed and not applied",
+          "type": "boolean"
+        }
+      }
+    },
     "ConntrackEntry": {
       "type": "object",
       "properties": {
@@ -14857,6 +17127,10 @@ func init() {
         "hostName"
       ],
       "properties": {
+        "activeReTries": {
+          "description": "Number of successful probes before an inactive end-point is marked active again",
+          "type": "integer"
+        },
         "hostName": {
           "description": "Host name in CIDR",
           "type": "string"
@@ -14869,10 +17143,57 @@ func init() {
           "description": "Endpoint Identifier",
           "type": "string"
         },
+        "probeBackoffMax": {
+          "description": "Max duration (in seconds) between probes of an inactive end-point. It doubles on each failed probe till this max",
+          "type": "integer"
+        },
+        "probeBodyRegex": {
+          "description": "Regex the response body of http/https probes has to match",
+          "type": "string"
+        },
         "probeDuration": {
           "description": "How frequently to probe in seconds",
           "type": "integer"
         },
+        "probeExec": {
+          "description": "Check command of exec probes and its arguments. The command has to be in /opt/loxilb/probe. The end-point ip and port are passed as last arguments",
+          "type": "string"
+        },
+        "probeExecState": {
+          "description": "Set the host state (green, yellow or red) from the first line printed by the check command of exec probes",
+          "type": "boolean"
+        },
+        "probeGrpcService": {
+          "description": "Service checked by grpc probes. The overall health of the server is checked if not given",
+          "type": "string"
+        },
+        "probeHeaders": {
+          "description": "Extra headers of http/https probes as \"Name: value\"",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header of http/https probes and TLS server name (SNI) of https/grpc probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Random jitter (in percent, max 50) of the duration between probes",
+          "type": "integer"
+        },
+        "probeJsonPath": {
+          "description": "Path of a value in the json response body of http/https probes e.g \"status\" or \"checks[0].state\"",
+          "type": "string"
+        },
+        "probeJsonValue": {
+          "description": "Expected value at probeJsonPath. The path only has to exist if not given",
+          "type": "string"
+        },
+        "probeMethod": {
+          "description": "Method of http/https probes, GET if not given",
+          "type": "string"
+        },
         "probePort": {
           "description": "The l4port to probe on",
           "type": "integer"
@@ -14885,6 +17206,18 @@ func init() {
           "description": "Response for http/https probes",
           "type": "string"
         },
+        "probeStatus": {
+          "description": "Status codes accepted by http/https probes e.g \"200-399\" or \"200,204\". Redirects are followed and only 200 is accepted if not given",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of http/https/grpc probes in seconds",
+          "type": "integer"
+        },
+        "probeTls": {
+          "description": "Use TLS for grpc probes",
+          "type": "boolean"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string",
@@ -14895,6 +17228,8 @@ func init() {
             "ping",
             "http",
             "https",
+            "grpc",
+            "exec",
             "none"
           ]
         }
@@ -14903,6 +17238,10 @@ func init() {
     "EndPointGetEntry": {
       "type": "object",
       "properties": {
+        "activeReTries": {
+          "description": "Number of successful probes before an inactive end-point is marked active again",
+          "type": "integer"
+        },
         "avgDelay": {
           "description": "Average delay seen for endpoint",
           "type": "string"
@@ -14931,10 +17270,57 @@ func init() {
           "description": "Endpoint Identifier",
           "type": "string"
         },
+        "probeBackoffMax": {
+          "description": "Max duration (in seconds) between probes of an inactive end-point. It doubles on each failed probe till this max",
+          "type": "integer"
+        },
+        "probeBodyRegex": {
+          "description": "Regex the response body of http/https probes has to match",
+          "type": "string"
+        },
         "probeDuration": {
           "description": "How frequently to probe in seconds",
           "type": "integer"
         },
+        "probeExec": {
+          "description": "Check command of exec probes and its arguments. The command has to be in /opt/loxilb/probe. The end-point ip and port are passed as last arguments",
+          "type": "string"
+        },
+        "probeExecState": {
+          "description": "Set the host state (green, yellow or red) from the first line printed by the check command of exec probes",
+          "type": "boolean"
+        },
+        "probeGrpcService": {
+          "description": "Service checked by grpc probes. The overall health of the server is checked if not given",
+          "type": "string"
+        },
+        "probeHeaders": {
+          "description": "Extra headers of http/https probes as \"Name: value\"",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "probeHost": {
+          "description": "Host header of http/https probes and TLS server name (SNI) of https/grpc probes",
+          "type": "string"
+        },
+        "probeJitter": {
+          "description": "Random jitter (in percent, max 50) of the duration between probes",
+          "type": "integer"
+        },
+        "probeJsonPath": {
+          "description": "Path of a value in the json response body of http/https probes e.g \"status\" or \"checks[0].state\"",
+          "type": "string"
+        },
+        "probeJsonValue": {
+          "description": "Expected value at probeJsonPath. The path only has to exist if not given",
+          "type": "string"
+        },
+        "probeMethod": {
+          "description": "Method of http/https probes, GET if not given",
+          "type": "string"
+        },
         "probePort": {
           "description": "The l4port to probe on",
           "type": "integer"
@@ -14947,6 +17333,18 @@ func init() {
           "description": "Response for http/https probes",
           "type": "string"
         },
+        "probeStatus": {
+          "description": "Status codes accepted by http/https probes e.g \"200-399\" or \"200,204\". Redirects are followed and only 200 is accepted if not given",
+          "type": "string"
+        },
+        "probeTimeout": {
+          "description": "Timeout of http/https/grpc probes in seconds",
+          "type": "integer"
+        },
+        "probeTls": {
+          "description": "Use TLS for grpc probes",
+          "type": "boolean"
+        },
         "probeType": {
           "description": "Type of probe used",
           "type": "string"
@@ -15115,6 +17513,88 @@ func init() {
         }
       }
     },
+    "FirewallImportEntry": {
+      "type": "object",
+      "required": [
+        "format",
+        "ruleset"
+      ],
+      "properties": {
+        "format": {
+          "description": "Format of the ruleset - iptables-save or ip6tables-save output, or nft -j list ruleset output",
+          "type": "string",
+          "enum": [
+            "iptables",
+            "ip6tables",
+            "nft"
+          ]
+        },
+        "ruleset": {
+          "description": "Ruleset to import",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallImportResult": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ConfigApplyChange"
+          }
+        },
+        "dryRun": {
+          "description": "True if the changes were only computed and not applied",
+          "type": "boolean"
+        },
+        "translated": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallImportRule"
+          }
+        },
+        "untranslated": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/FirewallImportSkip"
+          }
+        }
+      }
+    },
+    "FirewallImportRule": {
+      "type": "object",
+      "properties": {
+        "keys": {
+          "description": "Keys of the rules the source rule was translated to",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "kind": {
+          "description": "Kind of the rules the source rule was translated to (firewall, loadbalancer)",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Source rule",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallImportSkip": {
+      "type": "object",
+      "properties": {
+        "reason": {
+          "description": "Reason the source rule could not be translated",
+          "type": "string"
+        },
+        "rule": {
+          "description": "Source rule",
+          "type": "string"
+        }
+      }
+    },
     "FirewallOptionEntry": {
       "type": "object",
       "properties": {
@@ -15122,6 +17602,11 @@ func init() {
           "description": "Allow any matching rule",
           "type": "boolean"
         },
+        "bytes": {
+          "description": "Bytes which matched the rule (read-only)",
+          "type": "integer",
+          "format": "uint64"
+        },
         "counter": {
           "description": "traffic counters",
           "type": "string"
@@ -15134,14 +17619,27 @@ func init() {
           "description": "Drop any matching rule",
           "type": "boolean"
         },
+        "expiresAt": {
+          "description": "Time (unix seconds) at which the rule is removed, 0 - never",
+          "type": "integer"
+        },
         "fwMark": {
           "description": "Set a fwmark for any matching rule",
           "type": "integer"
         },
+        "lastHit": {
+          "description": "Time the rule was last seen matching in unix seconds, 0 if never (read-only)",
+          "type": "integer"
+        },
         "onDefault": {
           "description": "Trigger only on default cases",
           "type": "boolean"
         },
+        "packets": {
+          "description": "Packets which matched the rule (read-only)",
+          "type": "integer",
+          "format": "uint64"
+        },
         "record": {
           "description": "Record or dump for matching rule",
           "type": "boolean"
@@ -15165,6 +17663,18 @@ func init() {
         "trap": {
           "description": "Trap anything matching rule",
           "type": "boolean"
+        },
+        "ttl": {
+          "description": "Seconds after which the rule is removed once installed",
+          "type": "integer"
+        },
+        "ttlExpiresAt": {
+          "description": "Time (unix seconds) the TTL of the rule runs out (read-only)",
+          "type": "integer"
+        },
+        "validFrom": {
+          "description": "Time (unix seconds) from which the rule is installed, 0 - now",
+          "type": "integer"
         }
       }
     },
@@ -15175,6 +17685,10 @@ func init() {
           "description": "Destination IP in CIDR notation",
           "type": "string"
         },
+        "destinationIPSet": {
+          "description": "Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused",
+          "type": "string"
+        },
         "maxDestinationPort": {
           "description": "Maximum  destination port range",
           "type": "integer"
@@ -15206,6 +17720,38 @@ func init() {
         "sourceIP": {
           "description": "Source IP in CIDR notation",
           "type": "string"
+        },
+        "sourceIPSet": {
+          "description": "Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused",
+          "type": "string"
+        }
+      }
+    },
+    "FirewallStatsEntry": {
+      "type": "object",
+      "properties": {
+        "bytes": {
+          "description": "Bytes which matched the rule",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastHit": {
+          "description": "Time the rule was last seen matching in unix seconds (0 - never)",
+          "type": "integer",
+          "format": "int64"
+        },
+        "packets": {
+          "description": "Packets which matched the rule",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "ruleArguments": {
+          "$ref": "#/definitions/FirewallRuleEntry"
+        },
+        "since": {
+          "description": "Time the rule started matching traffic in unix seconds",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -15273,6 +17819,97 @@ func init() {
         }
       }
     },
+    "IPPoolAllocEntry": {
+      "type": "object",
+      "properties": {
+        "ip": {
+          "description": "Allocated IP address",
+          "type": "string"
+        },
+        "owner": {
+          "description": "Service owning the allocation",
+          "type": "string"
+        },
+        "rules": {
+          "description": "Number of LB rules using the address",
+          "type": "integer"
+        }
+      }
+    },
+    "IPPoolEntry": {
+      "type": "object",
+      "required": [
+        "name",
+        "cidr"
+      ],
+      "properties": {
+        "cidr": {
+          "description": "IPv4 or IPv6 subnet of the pool",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the IP pool. Use \"default\" for the pool used by LB rules without a service IP",
+          "type": "string"
+        }
+      }
+    },
+    "IPPoolGetEntry": {
+      "type": "object",
+      "properties": {
+        "allocations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IPPoolAllocEntry"
+          }
+        },
+        "cidr": {
+          "description": "IPv4 or IPv6 subnet of the pool",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the IP pool",
+          "type": "string"
+        }
+      }
+    },
+    "IPSetEntry": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "members": {
+          "description": "Member prefixes or addresses of the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "description": "Name of the IP set",
+          "type": "string"
+        }
+      }
+    },
+    "IPSetMemberEntry": {
+      "type": "object",
+      "properties": {
+        "add": {
+          "description": "Prefixes or addresses to add to the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "remove": {
+          "description": "Prefixes or addresses to remove from the IP set",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "IPv4AddressEntry": {
       "type": "object",
       "required": [
@@ -15448,6 +18085,87 @@ func init() {
         }
       }
     },
+    "LoadbalanceBatch": {
+      "type": "object",
+      "properties": {
+        "ops": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceBatchOp"
+          }
+        }
+      }
+    },
+    "LoadbalanceBatchItem": {
+      "type": "object",
+      "required": [
+        "index",
+        "status"
+      ],
+      "properties": {
+        "code": {
+          "description": "Error code of a failed operation",
+          "type": "integer"
+        },
+        "error": {
+          "description": "Error message of a failed operation",
+          "type": "string"
+        },
+        "index": {
+          "description": "Position of the operation in the batch",
+          "type": "integer"
+        },
+        "oper": {
+          "description": "Operation performed",
+          "type": "string"
+        },
+        "status": {
+          "description": "Outcome of the operation",
+          "type": "string",
+          "enum": [
+            "applied",
+            "failed",
+            "rolled-back",
+            "skipped"
+          ]
+        }
+      }
+    },
+    "LoadbalanceBatchOp": {
+      "type": "object",
+      "required": [
+        "oper",
+        "rule"
+      ],
+      "properties": {
+        "oper": {
+          "description": "Operation to perform on the load balancer rule",
+          "type": "string",
+          "enum": [
+            "add",
+            "delete"
+          ]
+        },
+        "rule": {
+          "$ref": "#/definitions/LoadbalanceEntry"
+        }
+      }
+    },
+    "LoadbalanceBatchResult": {
+      "type": "object",
+      "properties": {
+        "committed": {
+          "description": "True if all operations of the batch were applied",
+          "type": "boolean"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/LoadbalanceBatchItem"
+          }
+        }
+      }
+    },
     "LoadbalanceEntry": {
       "type": "object",
       "required": [
@@ -15544,6 +18262,26 @@ func init() {
                 2
               ]
             },
+            "outlierConsecutiveErrors": {
+              "description": "Consecutive connection failures after which an end-point is ejected by outlier detection (0 - disabled)",
+              "type": "integer",
+              "format": "int32"
+            },
+            "outlierEjectTime": {
+              "description": "Base ejection time (in seconds) of outlier end-points, doubled on each ejection",
+              "type": "integer",
+              "format": "uint32"
+            },
+            "outlierErrorRate": {
+              "description": "Connection failure percentage after which an end-point is ejected by outlier detection (0 - disabled)",
+              "type": "integer",
+              "format": "uint8"
+            },
+            "outlierMaxEjectPercent": {
+              "description": "Max percentage of end-points of the rule ejected at once",
+              "type": "integer",
+              "format": "uint8"
+            },
             "port": {
               "description": "(Min) port number for the access",
               "type": "integer"
@@ -15561,6 +18299,11 @@ func init() {
               "type": "integer",
               "format": "int32"
             },
+            "probeRise": {
+              "description": "number of successful probes before an end-point is marked active again",
+              "type": "integer",
+              "format": "int32"
+            },
             "probeTimeout": {
               "description": "value for probe timer (in seconds)",
               "type": "integer",
@@ -15572,7 +18315,7 @@ func init() {
               "format": "uint16"
             },
             "probereq": {
-              "description": "probe request string",
+              "description": "probe request string or check command of exec probes",
               "type": "string"
             },
             "proberesp": {
@@ -15589,6 +18332,8 @@ func init() {
                 "http",
                 "https",
                 "ping",
+                "grpc",
+                "exec",
                 "none"
               ]
             },
@@ -15617,7 +18362,7 @@ func init() {
               ]
             },
             "sel": {
-              "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 0-default)",
+              "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 7-maglev, 8-wlc, 9-lrt with monitor, 0-default)",
               "type": "integer",
               "enum": [
                 0,
@@ -15626,9 +18371,17 @@ func init() {
                 3,
                 4,
                 5,
-                6
+                6,
+                7,
+                8,
+                9
               ]
             },
+            "slowStart": {
+              "description": "slow-start window (in seconds) over which a recovered end-point ramps up to its full weight",
+              "type": "integer",
+              "format": "uint32"
+            },
             "snat": {
               "description": "snat rule",
               "type": "boolean"
@@ -15639,9 +18392,25 @@ func init() {
     },
     "LoadbalanceEntryAllowedSourcesItems0": {
       "properties": {
+        "expiresAt": {
+          "description": "Time (unix seconds) at which the source is removed, 0 - never",
+          "type": "integer"
+        },
         "prefix": {
           "description": "IP address for allowed source access",
           "type": "string"
+        },
+        "ttl": {
+          "description": "Seconds after which the source is removed once allowed",
+          "type": "integer"
+        },
+        "ttlExpiresAt": {
+          "description": "Time (unix seconds) the TTL of the source runs out (read-only)",
+          "type": "integer"
+        },
+        "validFrom": {
+          "description": "Time (unix seconds) from which the source is allowed, 0 - now",
+          "type": "integer"
         }
       }
     },
@@ -15750,6 +18519,26 @@ func init() {
             2
           ]
         },
+        "outlierConsecutiveErrors": {
+          "description": "Consecutive connection failures after which an end-point is ejected by outlier detection (0 - disabled)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "outlierEjectTime": {
+          "description": "Base ejection time (in seconds) of outlier end-points, doubled on each ejection",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "outlierErrorRate": {
+          "description": "Connection failure percentage after which an end-point is ejected by outlier detection (0 - disabled)",
+          "type": "integer",
+          "format": "uint8"
+        },
+        "outlierMaxEjectPercent": {
+          "description": "Max percentage of end-points of the rule ejected at once",
+          "type": "integer",
+          "format": "uint8"
+        },
         "port": {
           "description": "(Min) port number for the access",
           "type": "integer"
@@ -15767,6 +18556,11 @@ func init() {
           "type": "integer",
           "format": "int32"
         },
+        "probeRise": {
+          "description": "number of successful probes before an end-point is marked active again",
+          "type": "integer",
+          "format": "int32"
+        },
         "probeTimeout": {
           "description": "value for probe timer (in seconds)",
           "type": "integer",
@@ -15778,7 +18572,7 @@ func init() {
           "format": "uint16"
         },
         "probereq": {
-          "description": "probe request string",
+          "description": "probe request string or check command of exec probes",
           "type": "string"
         },
         "proberesp": {
@@ -15795,6 +18589,8 @@ func init() {
             "http",
             "https",
             "ping",
+            "grpc",
+            "exec",
             "none"
           ]
         },
@@ -15823,7 +18619,7 @@ func init() {
           ]
         },
         "sel": {
-          "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 0-default)",
+          "description": "value for load balance algorithim(0-rr, 1-hash, 2-priority, 3-persist, 4-lc, 5-n2, 6-n3, 7-maglev, 8-wlc, 9-lrt with monitor, 0-default)",
           "type": "integer",
           "enum": [
             0,
@@ -15832,9 +18628,17 @@ func init() {
             3,
             4,
             5,
-            6
+            6,
+            7,
+            8,
+            9
           ]
         },
+        "slowStart": {
+          "description": "slow-start window (in seconds) over which a recovered end-point ramps up to its full weight",
+          "type": "integer",
+          "format": "uint32"
+        },
         "snat": {
           "description": "snat rule",
           "type": "boolean"
@@ -17119,11 +19923,8 @@ func init() {
           "type": "string"
         },
         "role": {
-          "type": "string",
-          "enum": [
-            "admin",
-            "viewer"
-          ]
+          "description": "Role of the user. One of the built-in roles admin, viewer, auditor, lb-operator, network-admin or a role from the RBAC config",
+          "type": "string"
         },
         "username": {
           "type": "string"
@@ -0,0 +1,549 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// ApplyDoc - desired state document for declarative config apply. It uses the
+// same layout as DumpFile so that an export can be edited and applied back.
+// A missing section means that kind of object is left untouched, while an
+// empty section means all objects of that kind are to be deleted
+type ApplyDoc struct {
+	Lbrule   []cmn.LbRuleMod   `json:"loadbalancer"`
+	Endpoint []cmn.EndPointMod `json:"endpoint"`
+	Firewall []cmn.FwRuleMod   `json:"firewall"`
+	Mirror   []cmn.MirrMod     `json:"mirror"`
+	Policy   []cmn.PolMod      `json:"policy"`
+	BFD      []cmn.BFDMod      `json:"bfd"`
+}
+
+// Apply change actions
+const (
+	ApplyActAdd    = "add"
+	ApplyActModify = "modify"
+	ApplyActDelete = "delete"
+)
+
+// applyKinds - object kinds in dependency order. Additions are done in this
+// order and deletions in the reverse order
+var applyKinds = []string{"bfd", "endpoint", "loadbalancer", "firewall", "mirror", "policy"}
+
+// applyObj - a configuration object along with the hooks to add or delete it.
+// fixed holds the parts which can't be changed in place. An object is modified
+// by adding the new one over the current one when both have the same fixed
+// parts, otherwise the current one is deleted first
+type applyObj struct {
+	key   string
+	cmp   string
+	fixed string
+	add   func() error
+	del   func() error
+}
+
+// ApplyChange - a single change needed to reach the desired state
+type ApplyChange struct {
+	Kind   string `json:"kind"`
+	Action string `json:"action"`
+	Key    string `json:"key"`
+	old    *applyObj
+	new    *applyObj
+}
+
+// inPlace - check if a change is a modification done in place
+func (c *ApplyChange) inPlace() bool {
+	return c.Action == ApplyActModify && c.old.fixed != "" && c.old.fixed == c.new.fixed
+}
+
+// applyStep - an executed step and the way to undo it
+type applyStep struct {
+	desc string
+	undo func() error
+}
+
+// applyMtx - serializes declarative applies
+var applyMtx sync.Mutex
+
+func applyCmpString(v any) string {
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func applyRet(_ int, err error) error {
+	return err
+}
+
+// applyLbKey - key of a LB rule, the same as in watch events and the config store
+func applyLbKey(lb cmn.LbRuleMod) string {
+	return cmn.LbServKey(lb.Serv)
+}
+
+// applyLbResolve - resolve the service IP of a LB rule which names an IPAM
+// pool to the address already allocated to it. The rule then matches the
+// current one and keeps its VIP when it is re-added
+func applyLbResolve(lb cmn.LbRuleMod, pools []cmn.IPPoolGetMod) cmn.LbRuleMod {
+	if net.ParseIP(lb.Serv.ServIP) != nil {
+		return lb
+	}
+
+	pName := lb.Serv.ServIP
+	if pName == "" {
+		pName = cmn.IPPoolDefault
+	}
+	owner := cmn.LbServOwnerKey(&lb.Serv)
+	for _, p := range pools {
+		if p.Name != pName {
+			continue
+		}
+		for _, a := range p.Allocs {
+			if a.Owner == owner {
+				lb.Serv.ServIP = a.IP
+				return lb
+			}
+		}
+	}
+	return lb
+}
+
+// applyLbNormalize - strip run-time state of a LB rule so that it can be compared.
+// Outlier detection parameters get their defaults. Other parameters which get a
+// default value when left unset are taken from the current rule
+func applyLbNormalize(lb cmn.LbRuleMod, cur *cmn.LbRuleMod) cmn.LbRuleMod {
+	n := lb
+	n.Serv.Oper = 0
+	if n.Serv.ServPortMax == n.Serv.ServPort {
+		n.Serv.ServPortMax = 0
+	}
+	cmn.LbServOutlierNormalize(&n.Serv)
+	if cur != nil {
+		if n.Serv.InactiveTimeout == 0 {
+			n.Serv.InactiveTimeout = cur.Serv.InactiveTimeout
+		}
+		if n.Serv.PersistTimeout == 0 {
+			n.Serv.PersistTimeout = cur.Serv.PersistTimeout
+		}
+		if n.Serv.ProbeType != "" && n.Serv.ProbeType != "none" {
+			n.Serv.Monitor = cur.Serv.Monitor
+		}
+	}
+	n.Eps = nil
+	for _, ep := range lb.Eps {
+		ep.State = ""
+		ep.Counters = ""
+		n.Eps = append(n.Eps, ep)
+	}
+	sort.Slice(n.Eps, func(i, j int) bool {
+		if n.Eps[i].EpIP != n.Eps[j].EpIP {
+			return n.Eps[i].EpIP < n.Eps[j].EpIP
+		}
+		return n.Eps[i].EpPort < n.Eps[j].EpPort
+	})
+	n.SecIPs = append([]cmn.LbSecIPArg{}, lb.SecIPs...)
+	sort.Slice(n.SecIPs, func(i, j int) bool { return n.SecIPs[i].SecIP < n.SecIPs[j].SecIP })
+	n.SrcIPs = append([]cmn.LbAllowedSrcIPArg{}, lb.SrcIPs...)
+	for i := range n.SrcIPs {
+		n.SrcIPs[i].TTLExpiresAt = 0
+	}
+	sort.Slice(n.SrcIPs, func(i, j int) bool { return n.SrcIPs[i].Prefix < n.SrcIPs[j].Prefix })
+	return n
+}
+
+// applyFwOptsCmp - compare string of firewall rule options. The time a TTL
+// runs out is left out as it is not part of the rule as given
+func applyFwOptsCmp(opts cmn.FwOptArg) string {
+	opts.TTLExpiresAt = 0
+	return applyCmpString(opts)
+}
+
+// applyLbFixed - compare string of the parts of a normalized LB rule which
+// the rule update path of NetLbRuleAdd can't change. Updating a rule in place
+// keeps the sessions of the end-points which stay
+func applyLbFixed(lb cmn.LbRuleMod) string {
+	lb.Eps = nil
+	lb.SrcIPs = nil
+	lb.Serv.ProbeType = ""
+	lb.Serv.ProbePort = 0
+	lb.Serv.ProbeReq = ""
+	lb.Serv.ProbeResp = ""
+	lb.Serv.PersistTimeout = 0
+	lb.Serv.Sel = 0
+	lb.Serv.SlowStart = 0
+	lb.Serv.OutlierConsecErrors = 0
+	lb.Serv.OutlierErrorRate = 0
+	lb.Serv.OutlierEjectTime = 0
+	lb.Serv.OutlierMaxEjectPercent = 0
+	lb.Serv.ProxyProtocolV2 = false
+	return applyCmpString(lb)
+}
+
+func applyEpKey(ep cmn.EndPointMod) string {
+	if ep.Name != "" {
+		return ep.Name
+	}
+	return ep.HostName + "_" + ep.ProbeType + "_" + strconv.Itoa(int(ep.ProbePort))
+}
+
+func applyEpNormalize(ep cmn.EndPointMod) cmn.EndPointMod {
+	n := ep
+	n.Name = applyEpKey(ep)
+	n.MinDelay = ""
+	n.AvgDelay = ""
+	n.MaxDelay = ""
+	n.CurrState = ""
+	return n
+}
+
+// applyCollect - gather the current and desired objects of every kind present
+// in the document
+func applyCollect(doc *ApplyDoc) (map[string][]*applyObj, map[string][]*applyObj, error) {
+	cur := make(map[string][]*applyObj)
+	want := make(map[string][]*applyObj)
+
+	if doc.Lbrule != nil {
+		lbs, err := ApiHooks.NetLbRuleGet()
+		if err != nil {
+			return nil, nil, err
+		}
+		// No pools means no pool allocated VIPs
+		pools, _ := ApiHooks.NetIPPoolGet()
+		curMap := make(map[string]*cmn.LbRuleMod)
+		for i := range lbs {
+			lb := lbs[i]
+			// SNAT rules are owned by firewall rules
+			if lb.Serv.Snat {
+				continue
+			}
+			key := applyLbKey(lb)
+			curMap[key] = &lb
+			n := applyLbNormalize(lb, nil)
+			cur["loadbalancer"] = append(cur["loadbalancer"], &applyObj{
+				key: key, cmp: applyCmpString(n), fixed: applyLbFixed(n),
+				add: func() error { return applyRet(ApiHooks.NetLbRuleAdd(&lb)) },
+				del: func() error { return applyRet(ApiHooks.NetLbRuleDel(&lb)) },
+			})
+		}
+		for i := range doc.Lbrule {
+			lb := applyLbResolve(doc.Lbrule[i], pools)
+			key := applyLbKey(lb)
+			n := applyLbNormalize(lb, curMap[key])
+			want["loadbalancer"] = append(want["loadbalancer"], &applyObj{
+				key: key, cmp: applyCmpString(n), fixed: applyLbFixed(n),
+				add: func() error { return applyRet(ApiHooks.NetLbRuleAdd(&lb)) },
+				del: func() error { return applyRet(ApiHooks.NetLbRuleDel(&lb)) },
+			})
+		}
+	}
+
+	if doc.Endpoint != nil {
+		eps, err := ApiHooks.NetEpHostGet()
+		if err != nil {
+			return nil, nil, err
+		}
+		// End-points used by LB rules are created along with the rules and
+		// are left to them
+		lbs := doc.Lbrule
+		if lbs == nil {
+			lbs, _ = ApiHooks.NetLbRuleGet()
+		}
+		inUse := make(map[string]bool)
+		for _, lb := range lbs {
+			for _, ep := range lb.Eps {
+				inUse[ep.EpIP] = true
+			}
+		}
+		for i := range eps {
+			ep := applyEpNormalize(eps[i])
+			if inUse[ep.HostName] {
+				continue
+			}
+			// End-points are always modified in place
+			cur["endpoint"] = append(cur["endpoint"], &applyObj{
+				key: applyEpKey(ep), cmp: applyCmpString(ep), fixed: applyEpKey(ep),
+				add: func() error { return applyRet(ApiHooks.NetEpHostAdd(&ep)) },
+				del: func() error { return applyRet(ApiHooks.NetEpHostDel(&ep)) },
+			})
+		}
+		for i := range doc.Endpoint {
+			ep := applyEpNormalize(doc.Endpoint[i])
+			if inUse[ep.HostName] {
+				continue
+			}
+			want["endpoint"] = append(want["endpoint"], &applyObj{
+				key: applyEpKey(ep), cmp: applyCmpString(ep), fixed: applyEpKey(ep),
+				add: func() error { return applyRet(ApiHooks.NetEpHostAdd(&ep)) },
+				del: func() error { return applyRet(ApiHooks.NetEpHostDel(&ep)) },
+			})
+		}
+	}
+
+	if doc.Firewall != nil {
+		fws, err := GetFirewallConfig()
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range fws {
+			fw := fws[i]
+			fw.Opts.Counter = ""
+			cur["firewall"] = append(cur["firewall"], &applyObj{
+				key: applyCmpString(fw.Rule), cmp: applyFwOptsCmp(fw.Opts),
+				add: func() error { return applyRet(ApiHooks.NetFwRuleAdd(&fw)) },
+				del: func() error { return applyRet(ApiHooks.NetFwRuleDel(&fw)) },
+			})
+		}
+		for i := range doc.Firewall {
+			fw := doc.Firewall[i]
+			fw.Opts.Counter = ""
+			want["firewall"] = append(want["firewall"], &applyObj{
+				key: applyCmpString(fw.Rule), cmp: applyFwOptsCmp(fw.Opts),
+				add: func() error { return applyRet(ApiHooks.NetFwRuleAdd(&fw)) },
+				del: func() error { return applyRet(ApiHooks.NetFwRuleDel(&fw)) },
+			})
+		}
+	}
+
+	if doc.Mirror != nil {
+		mirrs, err := ApiHooks.NetMirrorGet()
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range mirrs {
+			mirr := cmn.MirrMod{Ident: mirrs[i].Ident, Info: mirrs[i].Info, Target: mirrs[i].Target}
+			cur["mirror"] = append(cur["mirror"], &applyObj{
+				key: mirr.Ident, cmp: applyCmpString(mirr),
+				add: func() error { return applyRet(ApiHooks.NetMirrorAdd(&mirr)) },
+				del: func() error { return applyRet(ApiHooks.NetMirrorDel(&mirr)) },
+			})
+		}
+		for i := range doc.Mirror {
+			mirr := doc.Mirror[i]
+			want["mirror"] = append(want["mirror"], &applyObj{
+				key: mirr.Ident, cmp: applyCmpString(mirr),
+				add: func() error { return applyRet(ApiHooks.NetMirrorAdd(&mirr)) },
+				del: func() error { return applyRet(ApiHooks.NetMirrorDel(&mirr)) },
+			})
+		}
+	}
+
+	if doc.Policy != nil {
+		pols, err := ApiHooks.NetPolicerGet()
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := range pols {
+			pol := pols[i]
+			cur["policy"] = append(cur["policy"], &applyObj{
+				key: pol.Ident, cmp: applyCmpString(pol),
+				add: func() error { return applyRet(ApiHooks.NetPolicerAdd(&pol)) },
+				del: func() error { return applyRet(ApiHooks.NetPolicerDel(&pol)) },
+			})
+		}
+		for i := range doc.Policy {
+			pol := doc.Policy[i]
+			want["policy"] = append(want["policy"], &applyObj{
+				key: pol.Ident, cmp: applyCmpString(pol),
+				add: func() error { return applyRet(ApiHooks.NetPolicerAdd(&pol)) },
+				del: func() error { return applyRet(ApiHooks.NetPolicerDel(&pol)) },
+			})
+		}
+	}
+
+	if doc.BFD != nil {
+		bfds, err := ApiHooks.NetBFDGet()
+		if err != nil {
+			// No BFD sessions are running
+			bfds = nil
+		}
+		for i := range bfds {
+			bfd := bfds[i]
+			bfd.State = ""
+			cur["bfd"] = append(cur["bfd"], &applyObj{
+				key: bfd.Instance + "/" + bfd.RemoteIP.String(), cmp: applyCmpString(bfd),
+				add: func() error { return applyRet(ApiHooks.NetBFDAdd(&bfd)) },
+				del: func() error { return applyRet(ApiHooks.NetBFDDel(&bfd)) },
+			})
+		}
+		for i := range doc.BFD {
+			bfd := doc.BFD[i]
+			bfd.State = ""
+			want["bfd"] = append(want["bfd"], &applyObj{
+				key: bfd.Instance + "/" + bfd.RemoteIP.String(), cmp: applyCmpString(bfd),
+				add: func() error { return applyRet(ApiHooks.NetBFDAdd(&bfd)) },
+				del: func() error { return applyRet(ApiHooks.NetBFDDel(&bfd)) },
+			})
+		}
+	}
+
+	return cur, want, nil
+}
+
+// ApplyDiff - compute the changes needed to reach the desired state
+func ApplyDiff(doc *ApplyDoc) ([]ApplyChange, error) {
+	var changes []ApplyChange
+
+	cur, want, err := applyCollect(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kind := range applyKinds {
+		curMap := make(map[string]*applyObj)
+		for _, o := range cur[kind] {
+			curMap[o.key] = o
+		}
+		wantMap := make(map[string]*applyObj)
+		for _, o := range want[kind] {
+			if wantMap[o.key] != nil {
+				return nil, fmt.Errorf("%s %s specified more than once", kind, o.key)
+			}
+			wantMap[o.key] = o
+		}
+
+		var kc []ApplyChange
+		for key, o := range wantMap {
+			c := curMap[key]
+			if c == nil {
+				kc = append(kc, ApplyChange{Kind: kind, Action: ApplyActAdd, Key: key, new: o})
+			} else if c.cmp != o.cmp {
+				kc = append(kc, ApplyChange{Kind: kind, Action: ApplyActModify, Key: key, old: c, new: o})
+			}
+		}
+		for key, c := range curMap {
+			if wantMap[key] == nil {
+				kc = append(kc, ApplyChange{Kind: kind, Action: ApplyActDelete, Key: key, old: c})
+			}
+		}
+		sort.Slice(kc, func(i, j int) bool {
+			if kc[i].Action != kc[j].Action {
+				return kc[i].Action < kc[j].Action
+			}
+			return kc[i].Key < kc[j].Key
+		})
+		changes = append(changes, kc...)
+	}
+
+	return changes, nil
+}
+
+// ApplyChanges - apply the changes in dependency order. Objects to be deleted
+// or re-created are removed first, last dependent first, then new and modified
+// objects are added. On failure, all executed steps are undone
+func ApplyChanges(changes []ApplyChange) error {
+	var steps []applyStep
+	var err error
+
+	kindOrder := make(map[string]int)
+	for i, kind := range applyKinds {
+		kindOrder[kind] = i
+	}
+
+	var dels, adds []ApplyChange
+	for _, c := range changes {
+		if c.Action != ApplyActAdd {
+			dels = append(dels, c)
+		}
+		if c.Action != ApplyActDelete {
+			adds = append(adds, c)
+		}
+	}
+	sort.SliceStable(dels, func(i, j int) bool { return kindOrder[dels[i].Kind] > kindOrder[dels[j].Kind] })
+	sort.SliceStable(adds, func(i, j int) bool { return kindOrder[adds[i].Kind] < kindOrder[adds[j].Kind] })
+
+	for _, c := range dels {
+		desc := fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Key)
+		if c.inPlace() {
+			continue
+		}
+		if err = c.old.del(); err != nil {
+			break
+		}
+		steps = append(steps, applyStep{desc: desc, undo: c.old.add})
+	}
+
+	if err == nil {
+		for _, c := range adds {
+			desc := fmt.Sprintf("%s %s %s", c.Action, c.Kind, c.Key)
+			if err = c.new.add(); err != nil {
+				break
+			}
+			undo := c.new.del
+			if c.inPlace() {
+				undo = c.old.add
+			}
+			steps = append(steps, applyStep{desc: desc, undo: undo})
+		}
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	tk.LogIt(tk.LogError, "api: config apply failed: %v, rolling back %d steps\n", err, len(steps))
+	for i := len(steps) - 1; i >= 0; i-- {
+		if uErr := steps[i].undo(); uErr != nil {
+			tk.LogIt(tk.LogError, "api: config apply rollback of %s failed: %v\n", steps[i].desc, uErr)
+		}
+	}
+	return err
+}
+
+func ConfigPostApply(params operations.PostConfigApplyParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Config apply %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var doc ApplyDoc
+	jsonData, err := json.Marshal(params.Attr)
+	if err == nil {
+		err = json.Unmarshal(jsonData, &doc)
+	}
+	if err != nil {
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("Invalid JSON format: " + err.Error())}
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	applyMtx.Lock()
+	defer applyMtx.Unlock()
+
+	changes, err := ApplyDiff(&doc)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+
+	if !dryRun {
+		if err := ApplyChanges(changes); err != nil {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("config apply rolled back: " + err.Error())}
+		}
+	}
+
+	result := models.ConfigApplyResult{DryRun: dryRun, Changes: make([]*models.ConfigApplyChange, 0)}
+	for _, c := range changes {
+		result.Changes = append(result.Changes, &models.ConfigApplyChange{Kind: c.Kind, Action: c.Action, Key: c.Key})
+	}
+
+	return operations.NewPostConfigApplyOK().WithPayload(&result)
+}
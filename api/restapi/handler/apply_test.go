@@ -0,0 +1,345 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+// applyTestHooks - LB rules and IPAM pools kept the way loxinet reports them
+type applyTestHooks struct {
+	cmn.NetHookInterface
+	lbs     []cmn.LbRuleMod
+	eps     []cmn.EndPointMod
+	fws     []cmn.FwRuleMod
+	pools   []cmn.IPPoolGetMod
+	failKey string
+	nAlloc  int
+	nDel    int
+}
+
+func (h *applyTestHooks) NetLbRuleGet() ([]cmn.LbRuleMod, error) {
+	return append([]cmn.LbRuleMod{}, h.lbs...), nil
+}
+
+func (h *applyTestHooks) NetEpHostGet() ([]cmn.EndPointMod, error) {
+	return append([]cmn.EndPointMod{}, h.eps...), nil
+}
+
+func (h *applyTestHooks) NetFwRuleGet() ([]cmn.FwRuleMod, error) {
+	return append([]cmn.FwRuleMod{}, h.fws...), nil
+}
+
+func (h *applyTestHooks) NetIPPoolGet() ([]cmn.IPPoolGetMod, error) {
+	return h.pools, nil
+}
+
+func (h *applyTestHooks) find(serv cmn.LbServiceArg) int {
+	for i := range h.lbs {
+		if cmn.LbServKey(h.lbs[i].Serv) == cmn.LbServKey(serv) {
+			return i
+		}
+	}
+	return -1
+}
+
+// NetLbRuleAdd - add a rule allocating its VIP from a pool and filling in
+// defaults like loxinet does. An existing rule is updated in place
+func (h *applyTestHooks) NetLbRuleAdd(lm *cmn.LbRuleMod) (int, error) {
+	n := *lm
+	if net.ParseIP(n.Serv.ServIP) == nil {
+		owner := cmn.LbServOwnerKey(&n.Serv)
+		p := &h.pools[0]
+		n.Serv.ServIP = ""
+		for _, a := range p.Allocs {
+			if a.Owner == owner {
+				n.Serv.ServIP = a.IP
+			}
+		}
+		if n.Serv.ServIP == "" {
+			h.nAlloc++
+			n.Serv.ServIP = fmt.Sprintf("20.0.0.%d", h.nAlloc)
+			p.Allocs = append(p.Allocs, cmn.IPPoolAllocMod{IP: n.Serv.ServIP, Owner: owner, Rules: 1})
+		}
+	}
+	if cmn.LbServKey(n.Serv) == h.failKey {
+		return -1, errors.New("lb rule add failed")
+	}
+	cmn.LbServOutlierNormalize(&n.Serv)
+	n.Eps = nil
+	for _, ep := range lm.Eps {
+		ep.State = "active"
+		ep.Counters = "0:0"
+		n.Eps = append(n.Eps, ep)
+	}
+	if idx := h.find(n.Serv); idx >= 0 {
+		e := h.lbs[idx]
+		if applyCmpString(e) == applyCmpString(n) {
+			return -1, errors.New("lb rule exists")
+		}
+		if e.Serv.Name != n.Serv.Name || e.Serv.Mode != n.Serv.Mode {
+			return -1, errors.New("lb rule can't be modified")
+		}
+		h.lbs[idx] = n
+		return 0, nil
+	}
+	h.lbs = append(h.lbs, n)
+	return 0, nil
+}
+
+func (h *applyTestHooks) NetLbRuleDel(lm *cmn.LbRuleMod) (int, error) {
+	idx := h.find(lm.Serv)
+	if idx < 0 {
+		return -1, errors.New("no such lb rule")
+	}
+	h.lbs = append(h.lbs[:idx], h.lbs[idx+1:]...)
+	h.nDel++
+	return 0, nil
+}
+
+func applyTestLb(ip string, port uint16, eps ...string) cmn.LbRuleMod {
+	lb := cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: ip, ServPort: port, Proto: "tcp", Sel: cmn.LbSelRr}}
+	for _, ep := range eps {
+		lb.Eps = append(lb.Eps, cmn.LbEndPointArg{EpIP: ep, EpPort: 8080, Weight: 1})
+	}
+	return lb
+}
+
+func setupApplyHooks(t *testing.T, lbs ...cmn.LbRuleMod) *applyTestHooks {
+	hooks := &applyTestHooks{pools: []cmn.IPPoolGetMod{{IPPoolMod: cmn.IPPoolMod{Name: cmn.IPPoolDefault, CIDR: "20.0.0.0/24"}}}}
+	for i := range lbs {
+		if _, err := hooks.NetLbRuleAdd(&lbs[i]); err != nil {
+			t.Fatalf("lb rule add: %v", err)
+		}
+	}
+	saved := ApiHooks
+	ApiHooks = hooks
+	t.Cleanup(func() { ApiHooks = saved })
+	return hooks
+}
+
+func TestApplyDiff(t *testing.T) {
+	setupApplyHooks(t,
+		applyTestLb("10.0.0.1", 80, "31.0.0.1", "31.0.0.2"),
+		applyTestLb("10.0.0.2", 80, "31.0.0.3"))
+
+	doc := ApplyDoc{Lbrule: []cmn.LbRuleMod{
+		applyTestLb("10.0.0.1", 80, "31.0.0.2", "31.0.0.4"),
+		applyTestLb("10.0.0.3", 80, "31.0.0.5"),
+	}}
+	changes, err := ApplyDiff(&doc)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	want := []ApplyChange{
+		{Kind: "loadbalancer", Action: ApplyActAdd, Key: "10.0.0.3:80-80/tcp"},
+		{Kind: "loadbalancer", Action: ApplyActDelete, Key: "10.0.0.2:80-80/tcp"},
+		{Kind: "loadbalancer", Action: ApplyActModify, Key: "10.0.0.1:80-80/tcp"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i := range want {
+		c := changes[i]
+		if c.Kind != want[i].Kind || c.Action != want[i].Action || c.Key != want[i].Key {
+			t.Errorf("change %d = %s %s %s, want %+v", i, c.Action, c.Kind, c.Key, want[i])
+		}
+	}
+
+	// Sections missing from the document are left alone
+	if changes, err := ApplyDiff(&ApplyDoc{}); err != nil || len(changes) != 0 {
+		t.Errorf("empty doc changes = %+v, %v", changes, err)
+	}
+
+	// An empty section deletes everything of its kind
+	if changes, _ := ApplyDiff(&ApplyDoc{Lbrule: []cmn.LbRuleMod{}}); len(changes) != 2 {
+		t.Errorf("empty section changes = %+v", changes)
+	}
+
+	dup := ApplyDoc{Lbrule: []cmn.LbRuleMod{applyTestLb("10.0.0.1", 80), applyTestLb("10.0.0.1", 80)}}
+	if _, err := ApplyDiff(&dup); err == nil {
+		t.Errorf("duplicate rules accepted")
+	}
+}
+
+func TestApplyNoop(t *testing.T) {
+	hooks := setupApplyHooks(t)
+
+	pooled := applyTestLb("", 80, "31.0.0.2", "31.0.0.1")
+	named := applyTestLb(cmn.IPPoolDefault, 443, "31.0.0.3")
+	named.Serv.Name = "web"
+	ejecting := applyTestLb("10.0.0.1", 80, "31.0.0.4")
+	ejecting.Serv.OutlierConsecErrors = 3
+	ejecting.Serv.ServPortMax = 80
+	doc := ApplyDoc{Lbrule: []cmn.LbRuleMod{pooled, named, ejecting}}
+
+	changes, err := ApplyDiff(&doc)
+	if err != nil || len(changes) != 3 {
+		t.Fatalf("first apply changes = %+v, %v", changes, err)
+	}
+	if err := ApplyChanges(changes); err != nil {
+		t.Fatalf("first apply: %v", err)
+	}
+	if len(hooks.lbs) != 3 || hooks.nAlloc != 2 {
+		t.Fatalf("got %d rules with %d allocations", len(hooks.lbs), hooks.nAlloc)
+	}
+
+	// Re-applying the same document is a no-op
+	changes, err = ApplyDiff(&doc)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("re-apply changes = %+v, %v", changes, err)
+	}
+
+	// A changed pool allocated rule is modified in place and keeps its VIP
+	vip := hooks.pools[0].Allocs[0].IP
+	if hooks.pools[0].Allocs[0].Owner != cmn.LbServOwnerKey(&pooled.Serv) {
+		vip = hooks.pools[0].Allocs[1].IP
+	}
+	doc.Lbrule[0].Eps = doc.Lbrule[0].Eps[:1]
+	changes, _ = ApplyDiff(&doc)
+	if len(changes) != 1 || changes[0].Action != ApplyActModify || changes[0].Key != vip+":80-80/tcp" {
+		t.Fatalf("modify changes = %+v", changes)
+	}
+	if err := ApplyChanges(changes); err != nil {
+		t.Fatalf("modify apply: %v", err)
+	}
+	if idx := hooks.find(cmn.LbServiceArg{ServIP: vip, ServPort: 80, Proto: "tcp"}); idx < 0 || len(hooks.lbs[idx].Eps) != 1 {
+		t.Errorf("modified rule not found at %s: %+v", vip, hooks.lbs)
+	}
+	if hooks.nAlloc != 2 {
+		t.Errorf("modify allocated a new vip")
+	}
+	if hooks.nDel != 0 {
+		t.Errorf("end-point change re-created the rule")
+	}
+}
+
+func TestApplyModify(t *testing.T) {
+	tests := []struct {
+		name     string
+		change   func(lb *cmn.LbRuleMod)
+		recreate bool
+	}{
+		{"endpoints", func(lb *cmn.LbRuleMod) { lb.Eps = lb.Eps[1:] }, false},
+		{"weights", func(lb *cmn.LbRuleMod) { lb.Eps[0].Weight = 5 }, false},
+		{"selection", func(lb *cmn.LbRuleMod) { lb.Serv.Sel = cmn.LbSelHash }, false},
+		{"outlier", func(lb *cmn.LbRuleMod) { lb.Serv.OutlierErrorRate = 50 }, false},
+		{"name", func(lb *cmn.LbRuleMod) { lb.Serv.Name = "web" }, true},
+		{"mode", func(lb *cmn.LbRuleMod) { lb.Serv.Mode = cmn.LBModeFullNAT }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hooks := setupApplyHooks(t, applyTestLb("10.0.0.1", 80, "31.0.0.1", "31.0.0.2"))
+			lb := applyTestLb("10.0.0.1", 80, "31.0.0.1", "31.0.0.2")
+			tt.change(&lb)
+			doc := ApplyDoc{Lbrule: []cmn.LbRuleMod{lb}}
+
+			changes, err := ApplyDiff(&doc)
+			if err != nil || len(changes) != 1 || changes[0].Action != ApplyActModify {
+				t.Fatalf("changes = %+v, %v", changes, err)
+			}
+			if err := ApplyChanges(changes); err != nil {
+				t.Fatalf("apply: %v", err)
+			}
+			if recreated := hooks.nDel != 0; recreated != tt.recreate {
+				t.Errorf("rule re-created = %v, want %v", recreated, tt.recreate)
+			}
+			if changes, _ := ApplyDiff(&doc); len(changes) != 0 {
+				t.Errorf("re-apply changes = %+v", changes)
+			}
+		})
+	}
+}
+
+func TestApplyEndpointsInUse(t *testing.T) {
+	hooks := setupApplyHooks(t, applyTestLb("10.0.0.1", 80, "31.0.0.1"))
+	hooks.eps = []cmn.EndPointMod{
+		{HostName: "31.0.0.1", ProbeType: "ping"},
+		{HostName: "32.0.0.1", ProbeType: "ping"},
+	}
+
+	// End-points used by LB rules are left to the rules either way
+	doc := ApplyDoc{Endpoint: []cmn.EndPointMod{
+		{HostName: "31.0.0.1", ProbeType: "tcp", ProbePort: 8080},
+		{HostName: "32.0.0.1", ProbeType: "ping"},
+	}}
+	changes, err := ApplyDiff(&doc)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("changes = %+v, %v", changes, err)
+	}
+}
+
+func TestApplyRollback(t *testing.T) {
+	hooks := setupApplyHooks(t,
+		applyTestLb("10.0.0.1", 80, "31.0.0.1"),
+		applyTestLb("10.0.0.2", 80, "31.0.0.2"))
+	before := applyCmpString(hooks.lbs)
+
+	hooks.failKey = "10.0.0.4:80-80/tcp"
+	doc := ApplyDoc{Lbrule: []cmn.LbRuleMod{
+		applyTestLb("10.0.0.1", 80, "31.0.0.9"),
+		applyTestLb("10.0.0.3", 80, "31.0.0.3"),
+		applyTestLb("10.0.0.4", 80, "31.0.0.4"),
+	}}
+	changes, err := ApplyDiff(&doc)
+	if err != nil || len(changes) != 4 {
+		t.Fatalf("changes = %+v, %v", changes, err)
+	}
+	if err := ApplyChanges(changes); err == nil {
+		t.Fatalf("failed apply not reported")
+	}
+
+	hooks.failKey = ""
+	if changes, _ := ApplyDiff(&ApplyDoc{Lbrule: []cmn.LbRuleMod{
+		applyTestLb("10.0.0.1", 80, "31.0.0.1"),
+		applyTestLb("10.0.0.2", 80, "31.0.0.2"),
+	}}); len(changes) != 0 {
+		t.Errorf("state not rolled back, changes = %+v", changes)
+	}
+	if len(hooks.lbs) != 2 {
+		t.Errorf("state not rolled back: %s, was %s", applyCmpString(hooks.lbs), before)
+	}
+}
+
+// TestApplyTTL - rules with a TTL read back with the time it runs out are
+// the rules as given
+func TestApplyTTL(t *testing.T) {
+	lb := applyTestLb("10.0.0.1", 80, "31.0.0.1")
+	lb.SrcIPs = []cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 60}}
+	fw := cmn.FwRuleMod{Rule: cmn.FwRuleArg{SrcIP: "10.0.0.0/8", DstIP: "0.0.0.0/0"}, Opts: cmn.FwOptArg{Drop: true, TTL: 60}}
+
+	hooks := setupApplyHooks(t, lb)
+	hooks.lbs[0].SrcIPs = []cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 60, TTLExpiresAt: 1000}}
+	hooks.fws = []cmn.FwRuleMod{fw}
+	hooks.fws[0].Opts.TTLExpiresAt = 1000
+
+	doc := ApplyDoc{Lbrule: []cmn.LbRuleMod{lb}, Firewall: []cmn.FwRuleMod{fw}}
+	if changes, err := ApplyDiff(&doc); err != nil || len(changes) != 0 {
+		t.Errorf("changes = %+v, %v", changes, err)
+	}
+
+	doc.Firewall[0].Opts.TTL = 120
+	doc.Lbrule[0].SrcIPs = []cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 120}}
+	if changes, _ := ApplyDiff(&doc); len(changes) != 2 {
+		t.Errorf("TTL changes = %+v", changes)
+	}
+}
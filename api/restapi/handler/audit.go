@@ -0,0 +1,373 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	opts "github.com/loxilb-io/loxilb/options"
+	"github.com/loxilb-io/loxilb/pkg/logrotate"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+const (
+	// auditMaxBody caps the part of a request body kept in an audit entry
+	auditMaxBody = 16 << 10
+	// auditMasked replaces secrets found in request bodies
+	auditMasked = "******"
+)
+
+// auditSecrets are the (lower-cased) body fields never written to the audit log
+var auditSecrets = []string{"password", "secret", "token", "licensekey"}
+
+// audit is the audit log of API requests which change config
+var audit struct {
+	mtx  sync.Mutex
+	path string
+	w    io.WriteCloser
+}
+
+// auditCtxKey is the request context key of the audit entry being built
+type auditCtxKey struct{}
+
+// AuditInit opens the audit log at path. An empty path disables auditing.
+func AuditInit(path string, cfg logrotate.Config) error {
+	if path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	w, err := logrotate.New(path, cfg)
+	if err != nil {
+		return err
+	}
+
+	audit.mtx.Lock()
+	if audit.w != nil {
+		audit.w.Close()
+	}
+	audit.path = path
+	audit.w = w
+	audit.mtx.Unlock()
+
+	tk.LogIt(tk.LogInfo, "api: audit log %s opened\n", path)
+	return nil
+}
+
+// auditEnabled tells whether API requests are being audited
+func auditEnabled() bool {
+	audit.mtx.Lock()
+	defer audit.mtx.Unlock()
+	return audit.w != nil
+}
+
+// auditWrite appends an entry to the audit log
+func auditWrite(e *models.AuditEntry) {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	line = append(line, '\n')
+
+	audit.mtx.Lock()
+	defer audit.mtx.Unlock()
+	if audit.w == nil {
+		return
+	}
+	if _, err := audit.w.Write(line); err != nil {
+		tk.LogIt(tk.LogError, "api: audit log write failed : %s\n", err)
+	}
+}
+
+// auditMask masks secrets in a decoded JSON body
+func auditMask(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, sub := range val {
+			lk := strings.ToLower(k)
+			secret := false
+			for _, s := range auditSecrets {
+				if strings.Contains(lk, s) {
+					secret = true
+					break
+				}
+			}
+			if secret {
+				val[k] = auditMasked
+			} else {
+				auditMask(sub)
+			}
+		}
+	case []interface{}:
+		for _, sub := range val {
+			auditMask(sub)
+		}
+	}
+}
+
+// auditBody turns a request body into what is kept in the audit log.
+// JSON bodies get their secrets masked, bodies over auditMaxBody are truncated
+// and, as they can't be parsed, kept only if they carry no secrets
+func auditBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if len(body) > auditMaxBody {
+		lb := bytes.ToLower(body[:auditMaxBody])
+		for _, s := range auditSecrets {
+			if bytes.Contains(lb, []byte(s)) {
+				return "(truncated body with secrets omitted)"
+			}
+		}
+		return string(body[:auditMaxBody]) + "...(truncated)"
+	}
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+	auditMask(v)
+	masked, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(masked)
+}
+
+// auditPrincipal records the principal of an authenticated request in its
+// audit entry. It is called by the authorizer, which is the first place the
+// principal of a request is known
+func auditPrincipal(r *http.Request, principal interface{}) {
+	e, ok := r.Context().Value(auditCtxKey{}).(*models.AuditEntry)
+	if !ok {
+		return
+	}
+	if info, ok := principal.(string); ok {
+		// Local users are "user|role" and OAuth2 users "user|role|refresh-token"
+		parts := strings.Split(info, "|")
+		e.User = parts[0]
+		if len(parts) > 1 {
+			e.Role = parts[1]
+		}
+		return
+	}
+	if opts.Opts.ManualTokenEnable {
+		e.User = "manual-token"
+	} else {
+		e.User = "anonymous"
+	}
+}
+
+// auditResponseWriter keeps the result code of a request
+type auditResponseWriter struct {
+	http.ResponseWriter
+	code int
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	w.code = code
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// AuditMiddleware records every API request which isn't a read, along with
+// its principal, operation, body and result code, in the audit log
+func AuditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !auditEnabled() || r.Method == http.MethodGet || r.Method == http.MethodHead ||
+			r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		e := &models.AuditEntry{
+			Time:       time.Now().UTC().Format(time.RFC3339Nano),
+			User:       "-",
+			Method:     r.Method,
+			Path:       r.URL.Path,
+			RemoteAddr: r.RemoteAddr,
+		}
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			e.OperationID = route.Operation.ID
+		}
+		if r.Body != nil {
+			body, _ := io.ReadAll(io.LimitReader(r.Body, auditMaxBody+1))
+			e.Body = auditBody(body)
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+		}
+
+		aw := &auditResponseWriter{ResponseWriter: w, code: http.StatusOK}
+		next.ServeHTTP(aw, r.WithContext(context.WithValue(r.Context(), auditCtxKey{}, e)))
+		e.ResultCode = int64(aw.code)
+		auditWrite(e)
+	})
+}
+
+// auditFiles returns the audit log followed by its rotated backups, newest first
+func auditFiles(path string) []string {
+	ext := filepath.Ext(path)
+	backups, _ := filepath.Glob(strings.TrimSuffix(path, ext) + "-*" + ext + "*")
+	// Backup names carry their rotation time, so they sort chronologically
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	return append([]string{path}, backups...)
+}
+
+// auditOpen opens an audit file for paging
+func auditOpen(path string) (io.ReaderAt, int64, func(), error) {
+	if strings.HasSuffix(path, ".gz") {
+		data, err := inflateArchive(path)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		return bytes.NewReader(data), int64(len(data)), func() {}, nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, nil, err
+	}
+	return f, info.Size(), func() { f.Close() }, nil
+}
+
+// auditCollect gathers up to n entries matching match, reading backwards from
+// endPos. It returns the entries newest-first, the offset the next page should
+// read back from and the number of bytes examined
+func auditCollect(src io.ReaderAt, endPos int64, n int, match func(*models.AuditEntry) bool, scanCap int64) ([]*models.AuditEntry, int64, int64) {
+	var page []*models.AuditEntry
+
+	pos := endPos
+	for pos > 0 && endPos-pos < scanCap {
+		records, stop := readRecordsBefore(src, pos, filterScanBatchLines)
+		for _, rec := range records {
+			var e models.AuditEntry
+			if err := json.Unmarshal([]byte(rec.text), &e); err != nil || !match(&e) {
+				continue
+			}
+			page = append(page, &e)
+			if len(page) == n {
+				return page, rec.offset, endPos - rec.offset
+			}
+		}
+		if stop >= pos {
+			pos = 0
+			break
+		}
+		pos = stop
+	}
+	return page, pos, endPos - pos
+}
+
+// ConfigGetAudit - Get the audit log, newest first, paging backwards across
+// rotated audit files
+func ConfigGetAudit(params operations.GetAuditParams, principal interface{}) middleware.Responder {
+	audit.mtx.Lock()
+	path := audit.path
+	audit.mtx.Unlock()
+	if path == "" {
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("audit log is disabled")}
+	}
+
+	lines := defaultLogLines
+	if params.Lines != nil && *params.Lines > 0 {
+		lines = int(*params.Lines)
+	}
+	if lines > maxLogLines {
+		lines = maxLogLines
+	}
+	cursor, err := decodeCursor(derefString(params.Cursor))
+	if err != nil {
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("invalid cursor format")}
+	}
+
+	user := derefString(params.User)
+	oper := derefString(params.Operation)
+	match := func(e *models.AuditEntry) bool {
+		return (user == "" || e.User == user) && (oper == "" || e.OperationID == oper)
+	}
+
+	files := auditFiles(path)
+	first, endPos := 0, int64(-1)
+	if cursor != nil {
+		for i, f := range files {
+			if filepath.Base(f) == cursor.Filename {
+				first, endPos = i, cursor.Offset
+				break
+			}
+		}
+	}
+
+	entries := []*models.AuditEntry{}
+	scanned := int64(0)
+	next := ""
+	for i := first; i < len(files); i++ {
+		src, size, done, err := auditOpen(files[i])
+		if err != nil {
+			if i == 0 && errors.Is(err, os.ErrNotExist) {
+				// Nothing has been audited yet
+				continue
+			}
+			tk.LogIt(tk.LogError, "api: audit file %s : %s\n", files[i], err)
+			continue
+		}
+		// A cursor into a file which has since rotated restarts from its tail
+		if i != first || endPos < 0 || endPos > size {
+			endPos = size
+		}
+		page, pos, n := auditCollect(src, endPos, lines-len(entries), match, maxFilterScanBytes-scanned)
+		done()
+		entries = append(entries, page...)
+		scanned += n
+
+		name := filepath.Base(files[i])
+		if pos > 0 {
+			next = encodeCursor(LogCursor{Filename: name, Offset: pos, FileSize: size})
+			break
+		}
+		if i+1 < len(files) && (len(entries) == lines || scanned >= maxFilterScanBytes) {
+			// Resume from the end of the next older file
+			next = encodeCursor(LogCursor{Filename: filepath.Base(files[i+1]), Offset: -1})
+			break
+		}
+	}
+
+	hasMore := next != ""
+	return operations.NewGetAuditOK().WithPayload(&models.AuditLog{
+		Entries:    entries,
+		Count:      int64(len(entries)),
+		HasMore:    hasMore,
+		NextCursor: next,
+	})
+}
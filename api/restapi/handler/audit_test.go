@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	"github.com/loxilb-io/loxilb/pkg/logrotate"
+)
+
+// setupAudit points the audit log at a fresh file for the duration of a test
+func setupAudit(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "audit.log")
+	if err := AuditInit(path, logrotate.Config{}); err != nil {
+		t.Fatalf("audit init: %v", err)
+	}
+	t.Cleanup(func() {
+		audit.mtx.Lock()
+		audit.w.Close()
+		audit.w = nil
+		audit.path = ""
+		audit.mtx.Unlock()
+	})
+	return path
+}
+
+// getAudit fetches one page of the audit log
+func getAudit(t *testing.T, params operations.GetAuditParams) *models.AuditLog {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	ConfigGetAudit(params, nil).WriteResponse(rec, runtime.JSONProducer())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("get audit: %d %s", rec.Code, rec.Body.String())
+	}
+	var res models.AuditLog
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode audit page: %v", err)
+	}
+	return &res
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	setupAudit(t)
+
+	var gotBody string
+	h := AuditMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := Authorized().Authorize(r, "alice|lb-operator"); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.Method == "POST" {
+			b, _ := io.ReadAll(r.Body)
+			gotBody = string(b)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	body := `{"username":"bob","password":"hunter2"}`
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("POST", "/netlox/v1/auth/users", strings.NewReader(body)))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/netlox/v1/config/loadbalancer/all", nil))
+
+	if gotBody != body {
+		t.Fatalf("handler got body %q, want %q", gotBody, body)
+	}
+
+	res := getAudit(t, operations.GetAuditParams{})
+	if len(res.Entries) != 1 || res.HasMore {
+		t.Fatalf("want exactly the POST audited, got %+v", res)
+	}
+	e := res.Entries[0]
+	if e.User != "alice" || e.Role != "lb-operator" || e.Method != "POST" ||
+		e.Path != "/netlox/v1/auth/users" || e.ResultCode != http.StatusNoContent {
+		t.Fatalf("unexpected audit entry %+v", e)
+	}
+	if strings.Contains(e.Body, "hunter2") || !strings.Contains(e.Body, "bob") {
+		t.Fatalf("secrets not masked in audit body %q", e.Body)
+	}
+}
+
+func TestAuditPaging(t *testing.T) {
+	path := setupAudit(t)
+
+	// An older, rotated audit file followed by the live one
+	var old strings.Builder
+	for i := 0; i < 5; i++ {
+		line, _ := json.Marshal(&models.AuditEntry{User: "old", OperationID: fmt.Sprintf("op%d", i)})
+		old.Write(append(line, '\n'))
+	}
+	backup := strings.TrimSuffix(path, ".log") + "-20260101-000000.000.log"
+	if err := os.WriteFile(backup, []byte(old.String()), 0o600); err != nil {
+		t.Fatalf("write backup: %v", err)
+	}
+	for i := 5; i < 10; i++ {
+		auditWrite(&models.AuditEntry{User: "new", OperationID: fmt.Sprintf("op%d", i)})
+	}
+
+	var seen []string
+	lines := int64(3)
+	params := operations.GetAuditParams{Lines: &lines}
+	for pages := 0; ; pages++ {
+		if pages > 10 {
+			t.Fatalf("paging does not terminate")
+		}
+		res := getAudit(t, params)
+		for _, e := range res.Entries {
+			seen = append(seen, e.OperationID)
+		}
+		if !res.HasMore {
+			break
+		}
+		cursor := res.NextCursor
+		params.Cursor = &cursor
+	}
+	want := "op9,op8,op7,op6,op5,op4,op3,op2,op1,op0"
+	if got := strings.Join(seen, ","); got != want {
+		t.Fatalf("paged %s, want %s", got, want)
+	}
+
+	user := "old"
+	res := getAudit(t, operations.GetAuditParams{User: &user})
+	if len(res.Entries) != 5 || res.Entries[0].OperationID != "op4" {
+		t.Fatalf("user filter across files: %+v", res.Entries)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/loxilb-io/loxilb/api/restapi/operations/auth"
 	cmn "github.com/loxilb-io/loxilb/common"
 	opts "github.com/loxilb-io/loxilb/options"
+	"github.com/loxilb-io/loxilb/pkg/user"
 	tk "github.com/loxilb-io/loxilib"
 )
 
@@ -88,35 +89,39 @@ func AuthPostLogout(params auth.PostAuthLogoutParams, principal interface{}) mid
 	return auth.NewPostAuthLogoutOK()
 }
 
+// RoleAuthorize checks that the principal of an authenticated request may
+// call method on the API path, based on the role of the user
+func RoleAuthorize(principal interface{}, method, path string) error {
+	permitInfo, ok := principal.(string)
+	if !ok {
+		return errors.New("Invalid user info. Please contact the administrator")
+	}
+	// Local users are "user|role" and OAuth2 users "user|role|refresh-token"
+	userNameAndRole := strings.Split(permitInfo, "|")
+	if len(userNameAndRole) < 2 {
+		return errors.New("Invalid user info. Please contact the administrator")
+	}
+	role := userNameAndRole[1]
+	if !user.RoleAllowed(role, method, path) {
+		tk.LogIt(tk.LogDebug, "api: user %s (%s) denied %s %s\n", userNameAndRole[0], role, method, path)
+		return errors.New("Permission denied")
+	}
+	return nil
+}
+
 // Authorized function to handle authorization logic
 // requests are authorized based on the role of the user
 func Authorized() runtime.Authorizer {
-	// TODO: Add more roles and permissions logic for oauth users
-	if opts.Opts.UserServiceEnable {
+	if opts.Opts.UserServiceEnable || opts.Opts.Oauth2Enable {
 		return runtime.AuthorizerFunc(func(param *http.Request, principal interface{}) error {
-			permitInfo := principal.(string)
-			// Viewer user can only GET requests
-			UserNameAndRole := strings.Split(permitInfo, "|")
-			if len(UserNameAndRole) != 2 {
-				return errors.New("Invalid user info. Please contact the administrator")
-			}
-			UserRole := UserNameAndRole[1]
-			// Viewer user can only GET requests except for logout
-			if strings.Contains(UserRole, "viewer") && param.Method == "POST" && param.URL.Path == "/netlox/v1/auth/logout" {
-				return nil
-			} else if strings.Contains(UserRole, "viewer") && param.Method != "GET" {
-				return errors.New("Permission denied")
-			}
-			return nil
-		})
-	} else {
-		return runtime.AuthorizerFunc(func(_ *http.Request, _ interface{}) error {
-
-			return nil
+			auditPrincipal(param, principal)
+			return RoleAuthorize(principal, param.Method, param.URL.Path)
 		})
-
 	}
-
+	return runtime.AuthorizerFunc(func(param *http.Request, principal interface{}) error {
+		auditPrincipal(param, principal)
+		return nil
+	})
 }
 
 // ManualTokenValidate function
@@ -38,6 +38,8 @@ type DumpFile struct {
 	Firewall  []cmn.FwRuleMod   `json:"firewall,omitempty"`
 	Mirror    []cmn.MirrMod     `json:"mirror,omitempty"`
 	Policy    []cmn.PolMod      `json:"policy,omitempty"`
+	IPPool    []cmn.IPPoolMod   `json:"ippool,omitempty"`
+	IPSet     []cmn.IPSetMod    `json:"ipset,omitempty"`
 	Timestamp string            `json:"timestamp"`
 	Version   string            `json:"version"`
 }
@@ -211,6 +213,22 @@ func DumpConfiguration() (map[string]any, *ErrorResponse) {
 		return nil, &ErrorResponse{Payload: ResultErrorResponseErrorMessage("Failed to get Policy config: " + err.Error())}
 	}
 
+	// Get IPAM pool configuration
+	ipPools, err := ApiHooks.NetIPPoolGet()
+	if err != nil {
+		return nil, &ErrorResponse{Payload: ResultErrorResponseErrorMessage("Failed to get IPPool config: " + err.Error())}
+	}
+	var ipPoolConfig []cmn.IPPoolMod
+	for _, pool := range ipPools {
+		ipPoolConfig = append(ipPoolConfig, pool.IPPoolMod)
+	}
+
+	// Get IP set configuration
+	ipSetConfig, err := ApiHooks.NetIPSetGet()
+	if err != nil {
+		return nil, &ErrorResponse{Payload: ResultErrorResponseErrorMessage("Failed to get IPSet config: " + err.Error())}
+	}
+
 	// Create export configuration structure
 	exportConfig := map[string]any{
 		"timestamp":    time.Now().Format(time.RFC3339),
@@ -221,6 +239,8 @@ func DumpConfiguration() (map[string]any, *ErrorResponse) {
 		"firewall":     firewallConfig,
 		"mirror":       mirrorConfig,
 		"policy":       policyConfig,
+		"ippool":       ipPoolConfig,
+		"ipset":        ipSetConfig,
 	}
 	return exportConfig, nil
 }
@@ -243,6 +263,25 @@ func GetFirewallConfig() ([]cmn.FwRuleMod, error) {
 
 func AddImportConfiguration(importData *DumpFile) error {
 
+	// Process IPAM pool configurations. These need to exist before
+	// any LB rule which gets its service IP from a pool
+	for _, pool := range importData.IPPool {
+		_, err := ApiHooks.NetIPPoolAdd(&pool)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to add IPPool config: %v\n", err)
+			return err
+		}
+	}
+
+	// Process IP set configurations
+	for _, set := range importData.IPSet {
+		_, err := ApiHooks.NetIPSetAdd(&set)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to add IPSet config: %v\n", err)
+			return err
+		}
+	}
+
 	// Process Load Balancer configurations
 	for _, lb := range importData.Lbrule {
 		_, err := ApiHooks.NetLbRuleAdd(&lb)
@@ -341,6 +380,20 @@ func DeleteAllConfiguration(jsonData []byte) (string, error) {
 			return err.Error(), nil
 		}
 	}
+	for _, pool := range exportConfig.IPPool {
+		_, err := ApiHooks.NetIPPoolDel(&pool)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to delete IPPool config: %v\n", err)
+			return err.Error(), nil
+		}
+	}
+	for _, set := range exportConfig.IPSet {
+		_, err := ApiHooks.NetIPSetDel(&set)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to delete IPSet config: %v\n", err)
+			return err.Error(), nil
+		}
+	}
 	// Note : Cluster configurations are not deleted
 	return "", nil
 }
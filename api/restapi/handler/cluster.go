@@ -39,6 +39,7 @@ func ConfigGetCIState(params operations.GetConfigCistateAllParams, principal int
 		tempResult.Instance = h.Instance
 		tempResult.State = h.State
 		tempResult.Vip = h.Vip.String()
+		tempResult.CloudError = h.CloudErr
 		result = append(result, &tempResult)
 	}
 
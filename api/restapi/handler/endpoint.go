@@ -41,6 +41,7 @@ func ConfigGetEndPoint(params operations.GetConfigEndpointAllParams, principal i
 		tmpEP.HostName = ep.HostName
 		tmpEP.Name = ep.Name
 		tmpEP.InactiveReTries = int64(ep.InActTries)
+		tmpEP.ActiveReTries = int64(ep.ActTries)
 		tmpEP.ProbeType = ep.ProbeType
 		tmpEP.ProbeReq = ep.ProbeReq
 		tmpEP.ProbeResp = ep.ProbeResp
@@ -51,6 +52,20 @@ func ConfigGetEndPoint(params operations.GetConfigEndpointAllParams, principal i
 		tmpEP.MaxDelay = ep.MaxDelay
 		tmpEP.CurrState = ep.CurrState
 		tmpEP.ProbePort = int64(ep.ProbePort)
+		tmpEP.ProbeTimeout = int64(ep.ProbeTimeout)
+		tmpEP.ProbeBackoffMax = int64(ep.ProbeBackoffMax)
+		tmpEP.ProbeJitter = int64(ep.ProbeJitter)
+		tmpEP.ProbeMethod = ep.ProbeMethod
+		tmpEP.ProbeHost = ep.ProbeHost
+		tmpEP.ProbeHeaders = ep.ProbeHeaders
+		tmpEP.ProbeStatus = ep.ProbeStatus
+		tmpEP.ProbeBodyRegex = ep.ProbeBodyRegex
+		tmpEP.ProbeJSONPath = ep.ProbeJSONPath
+		tmpEP.ProbeJSONValue = ep.ProbeJSONValue
+		tmpEP.ProbeGrpcService = ep.ProbeGRPCService
+		tmpEP.ProbeTLS = ep.ProbeTLS
+		tmpEP.ProbeExec = ep.ProbeExec
+		tmpEP.ProbeExecState = ep.ProbeExecState
 
 		result = append(result, &tmpEP)
 	}
@@ -67,10 +82,25 @@ func ConfigPostEndPoint(params operations.PostConfigEndpointParams, principal in
 	EP.Name = params.Attr.Name
 	EP.ProbeType = params.Attr.ProbeType
 	EP.InActTries = int(params.Attr.InactiveReTries)
+	EP.ActTries = int(params.Attr.ActiveReTries)
 	EP.ProbeReq = params.Attr.ProbeReq
 	EP.ProbeResp = params.Attr.ProbeResp
 	EP.ProbeDuration = uint32(params.Attr.ProbeDuration)
 	EP.ProbePort = uint16(params.Attr.ProbePort)
+	EP.ProbeTimeout = uint32(params.Attr.ProbeTimeout)
+	EP.ProbeBackoffMax = uint32(params.Attr.ProbeBackoffMax)
+	EP.ProbeJitter = uint8(params.Attr.ProbeJitter)
+	EP.ProbeMethod = params.Attr.ProbeMethod
+	EP.ProbeHost = params.Attr.ProbeHost
+	EP.ProbeHeaders = params.Attr.ProbeHeaders
+	EP.ProbeStatus = params.Attr.ProbeStatus
+	EP.ProbeBodyRegex = params.Attr.ProbeBodyRegex
+	EP.ProbeJSONPath = params.Attr.ProbeJSONPath
+	EP.ProbeJSONValue = params.Attr.ProbeJSONValue
+	EP.ProbeGRPCService = params.Attr.ProbeGrpcService
+	EP.ProbeTLS = params.Attr.ProbeTLS
+	EP.ProbeExec = params.Attr.ProbeExec
+	EP.ProbeExecState = params.Attr.ProbeExecState
 
 	_, err := ApiHooks.NetEpHostAdd(&EP)
 	if err != nil {
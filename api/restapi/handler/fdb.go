@@ -29,6 +29,8 @@ func ConfigPostFDB(params operations.PostConfigFdbParams, principal interface{})
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindFdb, true,
+		loxinlp.NetConfigRec{Mac: *params.Attr.MacAddress, Dev: *params.Attr.Dev})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -38,6 +40,7 @@ func ConfigDeleteFDB(params operations.DeleteConfigFdbMacAddressDevIfNameParams,
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindFdb, false, loxinlp.NetConfigRec{Mac: params.MacAddress, Dev: params.IfName})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -40,6 +40,8 @@ func ConfigPostFW(params operations.PostConfigFirewallParams, principal interfac
 	Rules.SrcIP = params.Attr.RuleArguments.SourceIP
 	Rules.SrcPortMax = uint16(params.Attr.RuleArguments.MaxSourcePort)
 	Rules.SrcPortMin = uint16(params.Attr.RuleArguments.MinSourcePort)
+	Rules.SrcIPSet = params.Attr.RuleArguments.SourceIPSet
+	Rules.DstIPSet = params.Attr.RuleArguments.DestinationIPSet
 
 	if Rules.DstIP == "" {
 		if Rules.SrcIP == "" {
@@ -76,6 +78,11 @@ func ConfigPostFW(params operations.PostConfigFirewallParams, principal interfac
 	Opts.ToIP = params.Attr.Opts.ToIP
 	Opts.ToPort = uint16(params.Attr.Opts.ToPort)
 	Opts.OnDefault = params.Attr.Opts.OnDefault
+	Opts.ValidFrom = params.Attr.Opts.ValidFrom
+	Opts.ExpiresAt = params.Attr.Opts.ExpiresAt
+	if params.Attr.Opts.TTL > 0 {
+		Opts.TTL = uint32(params.Attr.Opts.TTL)
+	}
 
 	FW.Rule = Rules
 	FW.Opts = Opts
@@ -127,6 +134,12 @@ func ConfigDeleteFW(params operations.DeleteConfigFirewallParams, principal inte
 	if params.SourceIP != nil {
 		Rules.SrcIP = *params.SourceIP
 	}
+	if params.SourceIPSet != nil {
+		Rules.SrcIPSet = *params.SourceIPSet
+	}
+	if params.DestinationIPSet != nil {
+		Rules.DstIPSet = *params.DestinationIPSet
+	}
 
 	if Rules.DstIP == "" {
 		if Rules.SrcIP == "" {
@@ -198,6 +211,8 @@ func ConfigGetFW(params operations.GetConfigFirewallAllParams, principal interfa
 		tmpRule.Preference = int64(FW.Rule.Pref)
 		tmpRule.Protocol = int64(FW.Rule.Proto)
 		tmpRule.SourceIP = FW.Rule.SrcIP
+		tmpRule.SourceIPSet = FW.Rule.SrcIPSet
+		tmpRule.DestinationIPSet = FW.Rule.DstIPSet
 		tmpRule.MaxSourcePort = int64(FW.Rule.SrcPortMax)
 		tmpRule.MinSourcePort = int64(FW.Rule.SrcPortMin)
 
@@ -214,6 +229,13 @@ func ConfigGetFW(params operations.GetConfigFirewallAllParams, principal interfa
 		tmpOpts.ToPort = int64(FW.Opts.ToPort)
 		tmpOpts.OnDefault = FW.Opts.OnDefault
 		tmpOpts.Counter = FW.Opts.Counter
+		tmpOpts.ValidFrom = FW.Opts.ValidFrom
+		tmpOpts.ExpiresAt = FW.Opts.ExpiresAt
+		tmpOpts.TTL = int64(FW.Opts.TTL)
+		tmpOpts.TTLExpiresAt = FW.Opts.TTLExpiresAt
+		tmpOpts.Packets = FW.Stats.Packets
+		tmpOpts.Bytes = FW.Stats.Bytes
+		tmpOpts.LastHit = FW.Stats.LastHit
 		tmpResult.RuleArguments = &tmpRule
 		tmpResult.Opts = &tmpOpts
 
@@ -221,3 +243,52 @@ func ConfigGetFW(params operations.GetConfigFirewallAllParams, principal interfa
 	}
 	return operations.NewGetConfigFirewallAllOK().WithPayload(&operations.GetConfigFirewallAllOKBody{FwAttr: result})
 }
+
+// ConfigGetFWStats - Get packet and byte counters of firewall rules along with
+// the time each was last hit. With idle given, only the rules without a hit
+// for idle seconds are returned
+func ConfigGetFWStats(params operations.GetConfigFirewallStatsParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Firewall %s API called by IP: %s. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.RemoteAddr, params.HTTPRequest.URL)
+	var idle uint32
+	if params.Idle != nil {
+		if *params.Idle < 0 {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("firewall-stats idle error")}
+		}
+		idle = uint32(*params.Idle)
+	}
+	res, err := ApiHooks.NetFwRuleStatsGet(idle)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	result := make([]*models.FirewallStatsEntry, 0)
+	for _, FW := range res {
+		var tmpResult models.FirewallStatsEntry
+		var tmpRule models.FirewallRuleEntry
+
+		if FW.Opts.Mark&0x40000000 != 0 {
+			continue
+		}
+
+		tmpRule.DestinationIP = FW.Rule.DstIP
+		tmpRule.MaxDestinationPort = int64(FW.Rule.DstPortMax)
+		tmpRule.MinDestinationPort = int64(FW.Rule.DstPortMin)
+		tmpRule.PortName = FW.Rule.InPort
+		tmpRule.Preference = int64(FW.Rule.Pref)
+		tmpRule.Protocol = int64(FW.Rule.Proto)
+		tmpRule.SourceIP = FW.Rule.SrcIP
+		tmpRule.SourceIPSet = FW.Rule.SrcIPSet
+		tmpRule.DestinationIPSet = FW.Rule.DstIPSet
+		tmpRule.MaxSourcePort = int64(FW.Rule.SrcPortMax)
+		tmpRule.MinSourcePort = int64(FW.Rule.SrcPortMin)
+
+		tmpResult.RuleArguments = &tmpRule
+		tmpResult.Packets = FW.Stats.Packets
+		tmpResult.Bytes = FW.Stats.Bytes
+		tmpResult.LastHit = FW.Stats.LastHit
+		tmpResult.Since = FW.Stats.Since
+
+		result = append(result, &tmpResult)
+	}
+	return operations.NewGetConfigFirewallStatsOK().WithPayload(&operations.GetConfigFirewallStatsOKBody{FwStatsAttr: result})
+}
@@ -0,0 +1,1090 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file translates iptables-save and nft JSON rulesets to firewall and
+// LB rules. Filter rules become firewall rules which allow, drop or
+// redirect, optionally marking packets, SNAT rules become firewall SNAT rules
+// and DNAT rules become LB rules with one end-point per DNAT rule for the
+// same service. Rules are evaluated in order in iptables and nftables, so
+// earlier rules get a higher preference. Anything which can't be expressed
+// exactly is reported back instead of being translated approximately. This
+// includes rules which only set a mark, as firewall rules always end
+// evaluation, and rejects, as the DP can only drop without a reply
+
+// Firewall import formats
+const (
+	FwImportIptables  = "iptables"
+	FwImportIp6tables = "ip6tables"
+	FwImportNft       = "nft"
+)
+
+// FwImportRule - a source rule and the keys of the rules it was translated to
+type FwImportRule struct {
+	Rule string   `json:"rule"`
+	Kind string   `json:"kind"`
+	Keys []string `json:"keys"`
+}
+
+// FwImportSkip - a source rule which could not be translated
+type FwImportSkip struct {
+	Rule   string `json:"rule"`
+	Reason string `json:"reason"`
+}
+
+// FwImportResult - result of translating a ruleset
+type FwImportResult struct {
+	Doc          ApplyDoc
+	Translated   []FwImportRule
+	Untranslated []FwImportSkip
+}
+
+// fwImpPorts - a port range, 0 for any port
+type fwImpPorts struct {
+	min uint16
+	max uint16
+}
+
+// fwImpMatch - match part of a source rule
+type fwImpMatch struct {
+	family int
+	src    string
+	dst    string
+	proto  uint8
+	sports []fwImpPorts
+	dports []fwImpPorts
+	inPort string
+}
+
+// fwImpAct - action part of a source rule
+type fwImpAct struct {
+	verdict  string
+	mark     uint32
+	record   bool
+	rdrPort  string
+	snatIP   string
+	snatPort uint16
+	dnatIP   string
+	dnatPort uint16
+}
+
+// fwImpHook - where a source rule is evaluated
+type fwImpHook struct {
+	nat  bool
+	hook string
+}
+
+// fwImporter - translation state
+type fwImporter struct {
+	res     FwImportResult
+	fwSrc   []string
+	fwKey   map[string]int
+	lbIdx   map[string]int
+	pending []FwImportRule
+}
+
+// FwImportTranslate - translate a ruleset of the given format
+func FwImportTranslate(format string, data string) (*FwImportResult, error) {
+	imp := &fwImporter{fwKey: make(map[string]int), lbIdx: make(map[string]int)}
+
+	var err error
+	switch format {
+	case FwImportIptables:
+		err = imp.iptables(data, 4)
+	case FwImportIp6tables:
+		err = imp.iptables(data, 6)
+	case FwImportNft:
+		err = imp.nft(data)
+	default:
+		return nil, errors.New("unknown import format " + format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := imp.finish(); err != nil {
+		return nil, err
+	}
+	return &imp.res, nil
+}
+
+func (imp *fwImporter) skip(rule string, reason string) {
+	imp.res.Untranslated = append(imp.res.Untranslated, FwImportSkip{Rule: rule, Reason: reason})
+}
+
+// fwImpProto - protocol number of a protocol name or number
+func fwImpProto(p string) (uint8, error) {
+	switch strings.ToLower(p) {
+	case "all":
+		return 0, nil
+	case "icmp":
+		return 1, nil
+	case "tcp":
+		return 6, nil
+	case "udp":
+		return 17, nil
+	case "icmpv6", "ipv6-icmp":
+		return 58, nil
+	case "sctp":
+		return 132, nil
+	}
+	n, err := strconv.ParseUint(p, 10, 8)
+	if err != nil {
+		return 0, errors.New("unsupported protocol " + p)
+	}
+	return uint8(n), nil
+}
+
+// fwImpPrefix - canonical prefix of an address or prefix along with its family
+func fwImpPrefix(a string) (string, int, error) {
+	if ip := net.ParseIP(a); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32", 4, nil
+		}
+		return ip.String() + "/128", 6, nil
+	}
+	_, pref, err := net.ParseCIDR(a)
+	if err != nil {
+		return "", 0, errors.New("unsupported address " + a)
+	}
+	if pref.IP.To4() != nil {
+		return pref.String(), 4, nil
+	}
+	return pref.String(), 6, nil
+}
+
+// fwImpPortRange - parse a port or a range of ports given as a:b
+func fwImpPortRange(p string, sep string) (fwImpPorts, error) {
+	lo, hi, isRange := strings.Cut(p, sep)
+	pMin, err := strconv.ParseUint(lo, 10, 16)
+	if err != nil {
+		return fwImpPorts{}, errors.New("unsupported port " + p)
+	}
+	pMax := pMin
+	if isRange {
+		if pMax, err = strconv.ParseUint(hi, 10, 16); err != nil || pMax < pMin {
+			return fwImpPorts{}, errors.New("unsupported port " + p)
+		}
+	}
+	return fwImpPorts{min: uint16(pMin), max: uint16(pMax)}, nil
+}
+
+// fwImpHostPort - parse ip[:port] of a NAT target. IPv6 addresses with a port
+// are given as [ip]:port
+func fwImpHostPort(a string) (string, uint16, error) {
+	host, port := a, ""
+	if h, p, err := net.SplitHostPort(a); err == nil {
+		host, port = h, p
+	} else if strings.HasPrefix(a, "[") && strings.HasSuffix(a, "]") {
+		host = a[1 : len(a)-1]
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return "", 0, errors.New("unsupported nat address " + a)
+	}
+	if port == "" {
+		return ip.String(), 0, nil
+	}
+	pr, err := fwImpPortRange(port, "-")
+	if err != nil || pr.min != pr.max {
+		return "", 0, errors.New("unsupported nat port " + port)
+	}
+	return ip.String(), pr.min, nil
+}
+
+// setFamily - check and set the address family of a match
+func (m *fwImpMatch) setFamily(family int) error {
+	if m.family != 0 && m.family != family {
+		return errors.New("mixed address families")
+	}
+	m.family = family
+	return nil
+}
+
+// emit - translate a parsed source rule
+func (imp *fwImporter) emit(rule string, h fwImpHook, m fwImpMatch, a fwImpAct) error {
+	if (len(m.sports) > 0 || len(m.dports) > 0) && m.proto != 6 && m.proto != 17 && m.proto != 132 {
+		return errors.New("port match without tcp, udp or sctp protocol")
+	}
+
+	switch {
+	case a.dnatIP != "":
+		if !h.nat || h.hook != "prerouting" {
+			return errors.New("dnat outside of prerouting")
+		}
+		return imp.emitLb(rule, m, a)
+	case a.snatIP != "":
+		if !h.nat || (h.hook != "postrouting" && h.hook != "input") {
+			return errors.New("snat outside of postrouting")
+		}
+	case h.nat:
+		return errors.New("non-nat action in nat chain")
+	case h.hook != "prerouting" && h.hook != "input" && h.hook != "forward" && h.hook != "ingress":
+		return errors.New("rule in " + h.hook + " hook")
+	case a.rdrPort != "":
+	case a.verdict == "" && a.mark != 0:
+		return errors.New("mark without a verdict")
+	case a.verdict == "":
+		return errors.New("rule without a verdict")
+	}
+
+	var opts cmn.FwOptArg
+	switch {
+	case a.snatIP != "":
+		opts.DoSnat = true
+		opts.ToIP = a.snatIP
+		opts.ToPort = a.snatPort
+	case a.rdrPort != "":
+		opts.Rdr = true
+		opts.RdrPort = a.rdrPort
+	case a.verdict == "accept":
+		opts.Allow = true
+	case a.verdict == "drop":
+		opts.Drop = true
+	default:
+		return errors.New("unsupported verdict " + a.verdict)
+	}
+	if !opts.DoSnat {
+		opts.Mark = a.mark
+	}
+	opts.Record = a.record
+
+	families := []int{m.family}
+	if m.family == 0 {
+		families = []int{4, 6}
+	}
+	sports, dports := m.sports, m.dports
+	if len(sports) == 0 {
+		sports = []fwImpPorts{{}}
+	}
+	if len(dports) == 0 {
+		dports = []fwImpPorts{{}}
+	}
+
+	var fws []cmn.FwRuleMod
+	for _, family := range families {
+		anyIP := "0.0.0.0/0"
+		if family == 6 {
+			anyIP = "::/0"
+		}
+		for _, sp := range sports {
+			for _, dp := range dports {
+				var fw cmn.FwRuleMod
+				fw.Rule.SrcIP, fw.Rule.DstIP = m.src, m.dst
+				if fw.Rule.SrcIP == "" {
+					fw.Rule.SrcIP = anyIP
+				}
+				if fw.Rule.DstIP == "" {
+					fw.Rule.DstIP = anyIP
+				}
+				fw.Rule.Proto = m.proto
+				fw.Rule.SrcPortMin, fw.Rule.SrcPortMax = sp.min, sp.max
+				fw.Rule.DstPortMin, fw.Rule.DstPortMax = dp.min, dp.max
+				fw.Rule.InPort = m.inPort
+				fw.Opts = opts
+				fws = append(fws, fw)
+			}
+		}
+	}
+
+	// Rules in different chains may translate to the same rule. The first
+	// one wins as it would be evaluated first
+	tr := FwImportRule{Rule: rule, Kind: "firewall"}
+	for _, fw := range fws {
+		tuple := applyCmpString(fw.Rule)
+		if idx, found := imp.fwKey[tuple]; found {
+			if applyCmpString(imp.res.Doc.Firewall[idx].Opts) != applyCmpString(fw.Opts) {
+				return errors.New("conflicts with earlier rule " + imp.fwSrc[idx])
+			}
+			continue
+		}
+		imp.fwKey[tuple] = len(imp.res.Doc.Firewall)
+		imp.res.Doc.Firewall = append(imp.res.Doc.Firewall, fw)
+		imp.fwSrc = append(imp.fwSrc, rule)
+		tr.Keys = append(tr.Keys, tuple)
+	}
+	imp.pending = append(imp.pending, tr)
+	return nil
+}
+
+// emitLb - translate a DNAT rule to an end-point of a LB rule
+func (imp *fwImporter) emitLb(rule string, m fwImpMatch, a fwImpAct) error {
+	if m.inPort != "" || len(m.sports) > 0 {
+		return errors.New("dnat with unsupported matches")
+	}
+	if m.proto != 6 && m.proto != 17 && m.proto != 132 {
+		return errors.New("dnat without tcp, udp or sctp protocol")
+	}
+	if len(m.dports) != 1 {
+		return errors.New("dnat without a single destination port range")
+	}
+	servIP, _, err := net.ParseCIDR(m.dst)
+	if err != nil {
+		return errors.New("dnat without a destination address")
+	}
+	if m.dst != servIP.String()+"/32" && m.dst != servIP.String()+"/128" {
+		return errors.New("dnat to a destination prefix")
+	}
+
+	var lb cmn.LbRuleMod
+	lb.Serv.ServIP = servIP.String()
+	lb.Serv.ServPort = m.dports[0].min
+	if m.dports[0].max != m.dports[0].min {
+		if a.dnatPort != 0 {
+			return errors.New("dnat of a port range to a single port")
+		}
+		lb.Serv.ServPortMax = m.dports[0].max
+	}
+	switch m.proto {
+	case 6:
+		lb.Serv.Proto = "tcp"
+	case 17:
+		lb.Serv.Proto = "udp"
+	case 132:
+		lb.Serv.Proto = "sctp"
+	}
+	lb.Serv.Sel = cmn.LbSelRr
+	lb.Serv.Mode = cmn.LBModeDefault
+	if m.src != "" {
+		lb.SrcIPs = append(lb.SrcIPs, cmn.LbAllowedSrcIPArg{Prefix: m.src})
+	}
+
+	ep := cmn.LbEndPointArg{EpIP: a.dnatIP, EpPort: a.dnatPort, Weight: 1}
+	if ep.EpPort == 0 {
+		ep.EpPort = lb.Serv.ServPort
+	}
+
+	key := applyLbKey(lb)
+	tr := FwImportRule{Rule: rule, Kind: "loadbalancer", Keys: []string{key}}
+	if idx, found := imp.lbIdx[key]; found {
+		eLb := &imp.res.Doc.Lbrule[idx]
+		if applyCmpString(eLb.SrcIPs) != applyCmpString(lb.SrcIPs) {
+			return errors.New("dnat with allowed sources different from earlier rules of the service")
+		}
+		for _, eEp := range eLb.Eps {
+			if eEp.EpIP == ep.EpIP && eEp.EpPort == ep.EpPort {
+				imp.pending = append(imp.pending, tr)
+				return nil
+			}
+		}
+		eLb.Eps = append(eLb.Eps, ep)
+		imp.pending = append(imp.pending, tr)
+		return nil
+	}
+	lb.Eps = append(lb.Eps, ep)
+	imp.lbIdx[key] = len(imp.res.Doc.Lbrule)
+	imp.res.Doc.Lbrule = append(imp.res.Doc.Lbrule, lb)
+	imp.pending = append(imp.pending, tr)
+	return nil
+}
+
+// finish - assign preferences to the firewall rules in their order and fill
+// the final keys of translated rules
+func (imp *fwImporter) finish() error {
+	fws := imp.res.Doc.Firewall
+	if len(fws) > math.MaxUint16 {
+		return errors.New("too many firewall rules")
+	}
+	keyMap := make(map[string]string)
+	for i := range fws {
+		tuple := applyCmpString(fws[i].Rule)
+		fws[i].Rule.Pref = uint32(len(fws) - i)
+		keyMap[tuple] = applyCmpString(fws[i].Rule)
+	}
+	for _, tr := range imp.pending {
+		if tr.Kind == "firewall" {
+			for i, k := range tr.Keys {
+				tr.Keys[i] = keyMap[k]
+			}
+		}
+		imp.res.Translated = append(imp.res.Translated, tr)
+	}
+	return nil
+}
+
+// iptablesTokens - split a line of iptables-save output into arguments
+func iptablesTokens(line string) ([]string, error) {
+	var toks []string
+	var cur strings.Builder
+	inTok, quoted := false, false
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quoted && c == '\\' && i+1 < len(line):
+			i++
+			cur.WriteByte(line[i])
+		case c == '"':
+			quoted = !quoted
+			inTok = true
+		case !quoted && (c == ' ' || c == '\t'):
+			if inTok {
+				toks = append(toks, cur.String())
+				cur.Reset()
+				inTok = false
+			}
+		default:
+			cur.WriteByte(c)
+			inTok = true
+		}
+	}
+	if quoted {
+		return nil, errors.New("unterminated quote")
+	}
+	if inTok {
+		toks = append(toks, cur.String())
+	}
+	return toks, nil
+}
+
+// iptablesChains - built-in chains of iptables tables
+var iptablesChains = map[string][]string{
+	"filter": {"INPUT", "FORWARD", "OUTPUT"},
+	"nat":    {"PREROUTING", "INPUT", "OUTPUT", "POSTROUTING"},
+	"mangle": {"PREROUTING", "INPUT", "FORWARD", "OUTPUT", "POSTROUTING"},
+	"raw":    {"PREROUTING", "OUTPUT"},
+}
+
+// iptables - translate iptables-save output
+func (imp *fwImporter) iptables(data string, family int) error {
+	table := ""
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "*"):
+			table = line[1:]
+			continue
+		case line == "COMMIT":
+			table = ""
+			continue
+		case strings.HasPrefix(line, ":"):
+			// Chain policies other than ACCEPT can't be expressed
+			f := strings.Fields(line[1:])
+			if len(f) >= 2 && f[1] != "ACCEPT" && f[1] != "-" && table != "nat" {
+				imp.skip(line, "chain policy "+f[1])
+			}
+			continue
+		}
+
+		if table == "" {
+			return errors.New("rule outside of a table: " + line)
+		}
+		if err := imp.iptablesRule(line, table, family); err != nil {
+			imp.skip(line, err.Error())
+		}
+	}
+	return nil
+}
+
+// iptablesRule - translate a rule of iptables-save output
+func (imp *fwImporter) iptablesRule(line string, table string, family int) error {
+	toks, err := iptablesTokens(line)
+	if err != nil {
+		return err
+	}
+	// Counters given by iptables-save -c
+	if len(toks) > 0 && strings.HasPrefix(toks[0], "[") {
+		toks = toks[1:]
+	}
+	if len(toks) < 2 || toks[0] != "-A" {
+		return errors.New("not an append rule")
+	}
+	chain := toks[1]
+	builtin := false
+	for _, c := range iptablesChains[table] {
+		if c == chain {
+			builtin = true
+		}
+	}
+	if !builtin {
+		return errors.New("rule in user-defined chain " + chain)
+	}
+	h := fwImpHook{nat: table == "nat", hook: strings.ToLower(chain)}
+
+	m := fwImpMatch{family: family}
+	var a fwImpAct
+	target := ""
+	statistic := false
+
+	arg := func(i *int) (string, error) {
+		if *i+1 >= len(toks) {
+			return "", errors.New("missing value of " + toks[*i])
+		}
+		*i++
+		return toks[*i], nil
+	}
+
+	for i := 2; i < len(toks); i++ {
+		opt := toks[i]
+		if opt == "!" {
+			return errors.New("negated match")
+		}
+		var val string
+		switch opt {
+		case "-s", "--source", "-d", "--destination", "-p", "--protocol", "-i", "--in-interface",
+			"-m", "--match", "--sport", "--source-port", "--dport", "--destination-port", "--sports",
+			"--source-ports", "--dports", "--destination-ports", "--comment", "-j", "--jump",
+			"--set-mark", "--set-xmark", "--to-source", "--to-destination", "--reject-with", "--mode",
+			"--probability", "--every", "--packet":
+			if val, err = arg(&i); err != nil {
+				return err
+			}
+		}
+		switch opt {
+		case "-s", "--source", "-d", "--destination":
+			pref, fam, err := fwImpPrefix(val)
+			if err != nil {
+				return err
+			}
+			if fam != family {
+				return errors.New("address of other family " + val)
+			}
+			if opt == "-s" || opt == "--source" {
+				m.src = pref
+			} else {
+				m.dst = pref
+			}
+		case "-p", "--protocol":
+			if m.proto, err = fwImpProto(val); err != nil {
+				return err
+			}
+		case "-i", "--in-interface":
+			if strings.HasSuffix(val, "+") {
+				return errors.New("wildcard interface " + val)
+			}
+			m.inPort = val
+		case "-m", "--match":
+			switch val {
+			case "tcp", "udp", "sctp", "multiport", "comment":
+			case "statistic":
+				statistic = true
+			default:
+				return errors.New("unsupported match " + val)
+			}
+		case "--sport", "--source-port", "--dport", "--destination-port":
+			pr, err := fwImpPortRange(val, ":")
+			if err != nil {
+				return err
+			}
+			if opt == "--sport" || opt == "--source-port" {
+				m.sports = []fwImpPorts{pr}
+			} else {
+				m.dports = []fwImpPorts{pr}
+			}
+		case "--sports", "--source-ports", "--dports", "--destination-ports":
+			var prs []fwImpPorts
+			for _, p := range strings.Split(val, ",") {
+				pr, err := fwImpPortRange(p, ":")
+				if err != nil {
+					return err
+				}
+				prs = append(prs, pr)
+			}
+			if opt == "--sports" || opt == "--source-ports" {
+				m.sports = prs
+			} else {
+				m.dports = prs
+			}
+		case "--comment", "--reject-with", "--mode", "--probability", "--every", "--packet":
+		case "-j", "--jump":
+			target = val
+		case "--set-mark", "--set-xmark":
+			v, mask, masked := strings.Cut(val, "/")
+			mark, err := strconv.ParseUint(v, 0, 32)
+			if err != nil {
+				return errors.New("unsupported mark " + val)
+			}
+			if masked {
+				mv, err := strconv.ParseUint(mask, 0, 32)
+				if err != nil || mv != math.MaxUint32 {
+					return errors.New("unsupported mark mask " + val)
+				}
+			}
+			a.mark = uint32(mark)
+		case "--to-source":
+			if a.snatIP, a.snatPort, err = fwImpHostPort(val); err != nil {
+				return err
+			}
+		case "--to-destination":
+			if a.dnatIP, a.dnatPort, err = fwImpHostPort(val); err != nil {
+				return err
+			}
+		case "-g", "--goto":
+			return errors.New("goto")
+		default:
+			return errors.New("unsupported option " + opt)
+		}
+	}
+
+	switch target {
+	case "ACCEPT":
+		a.verdict = "accept"
+	case "DROP":
+		a.verdict = "drop"
+	case "REJECT":
+		return errors.New("reject without a reply")
+	case "MARK":
+		if a.mark == 0 {
+			return errors.New("mark without a value")
+		}
+	case "SNAT":
+		if a.snatIP == "" {
+			return errors.New("snat without a source")
+		}
+	case "DNAT":
+		if a.dnatIP == "" {
+			return errors.New("dnat without a destination")
+		}
+	case "":
+		return errors.New("rule without a target")
+	default:
+		return errors.New("unsupported target " + target)
+	}
+	if statistic && a.dnatIP == "" {
+		return errors.New("unsupported match statistic")
+	}
+
+	return imp.emit(line, h, m, a)
+}
+
+// nftChain - a chain of a nft ruleset
+type nftChain struct {
+	Family string `json:"family"`
+	Table  string `json:"table"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Hook   string `json:"hook"`
+	Policy string `json:"policy"`
+}
+
+// nftRule - a rule of a nft ruleset
+type nftRule struct {
+	Family  string                       `json:"family"`
+	Table   string                       `json:"table"`
+	Chain   string                       `json:"chain"`
+	Handle  int                          `json:"handle"`
+	Comment string                       `json:"comment"`
+	Expr    []map[string]json.RawMessage `json:"expr"`
+}
+
+// nftMatch - a match expression of a nft rule
+type nftMatch struct {
+	Op    string          `json:"op"`
+	Left  json.RawMessage `json:"left"`
+	Right json.RawMessage `json:"right"`
+}
+
+// nftFamily - address family of a nft table family, 0 for both
+func nftFamily(family string) (int, error) {
+	switch family {
+	case "ip":
+		return 4, nil
+	case "ip6":
+		return 6, nil
+	case "inet", "netdev":
+		return 0, nil
+	}
+	return 0, errors.New("unsupported family " + family)
+}
+
+// nft - translate nft -j list ruleset output
+func (imp *fwImporter) nft(data string) error {
+	var doc struct {
+		Nftables []map[string]json.RawMessage `json:"nftables"`
+	}
+	if err := json.Unmarshal([]byte(data), &doc); err != nil {
+		return errors.New("invalid nft json: " + err.Error())
+	}
+
+	chains := make(map[string]nftChain)
+	for _, obj := range doc.Nftables {
+		if raw, ok := obj["chain"]; ok {
+			var c nftChain
+			if err := json.Unmarshal(raw, &c); err != nil {
+				return errors.New("invalid nft chain: " + err.Error())
+			}
+			chains[c.Family+" "+c.Table+" "+c.Name] = c
+			if c.Hook != "" && c.Policy == "drop" && c.Type != "nat" {
+				imp.skip(fmt.Sprintf("%s %s %s policy %s", c.Family, c.Table, c.Name, c.Policy), "chain policy drop")
+			}
+		}
+	}
+
+	for _, obj := range doc.Nftables {
+		raw, ok := obj["rule"]
+		if !ok {
+			continue
+		}
+		var r nftRule
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return errors.New("invalid nft rule: " + err.Error())
+		}
+		desc := fmt.Sprintf("%s %s %s handle %d", r.Family, r.Table, r.Chain, r.Handle)
+		if r.Comment != "" {
+			desc += " comment \"" + r.Comment + "\""
+		}
+		if err := imp.nftRule(&r, desc, chains); err != nil {
+			imp.skip(desc, err.Error())
+		}
+	}
+	return nil
+}
+
+// nftRule - translate a rule of a nft ruleset
+func (imp *fwImporter) nftRule(r *nftRule, desc string, chains map[string]nftChain) error {
+	c, found := chains[r.Family+" "+r.Table+" "+r.Chain]
+	if !found || c.Hook == "" {
+		return errors.New("rule in regular chain " + r.Chain)
+	}
+	family, err := nftFamily(r.Family)
+	if err != nil {
+		return err
+	}
+	h := fwImpHook{nat: c.Type == "nat", hook: c.Hook}
+
+	m := fwImpMatch{family: family}
+	var a fwImpAct
+	for _, e := range r.Expr {
+		for stmt, raw := range e {
+			if err := imp.nftStmt(stmt, raw, &m, &a); err != nil {
+				return err
+			}
+		}
+	}
+	if a.verdict == "" && a.snatIP == "" && a.dnatIP == "" && a.rdrPort == "" && a.mark == 0 {
+		return errors.New("rule without a verdict")
+	}
+
+	return imp.emit(desc, h, m, a)
+}
+
+// nftStmt - translate a statement of a nft rule
+func (imp *fwImporter) nftStmt(stmt string, raw json.RawMessage, m *fwImpMatch, a *fwImpAct) error {
+	switch stmt {
+	case "match":
+		var nm nftMatch
+		if err := json.Unmarshal(raw, &nm); err != nil {
+			return err
+		}
+		if nm.Op != "==" && nm.Op != "in" {
+			return errors.New("unsupported match operator " + nm.Op)
+		}
+		return nftMatchStmt(&nm, m)
+	case "counter", "comment":
+	case "accept", "drop":
+		a.verdict = stmt
+	case "reject":
+		return errors.New("reject without a reply")
+	case "log":
+		a.record = true
+	case "mangle":
+		var mg struct {
+			Key   map[string]struct{ Key string } `json:"key"`
+			Value json.RawMessage                 `json:"value"`
+		}
+		if err := json.Unmarshal(raw, &mg); err != nil {
+			return err
+		}
+		if mg.Key["meta"].Key != "mark" {
+			return errors.New("unsupported mangle statement")
+		}
+		var mark uint32
+		if err := json.Unmarshal(mg.Value, &mark); err != nil || mark == 0 {
+			return errors.New("unsupported mark value")
+		}
+		a.mark = mark
+	case "snat", "dnat":
+		var nat struct {
+			Addr string          `json:"addr"`
+			Port json.RawMessage `json:"port"`
+		}
+		if err := json.Unmarshal(raw, &nat); err != nil {
+			return err
+		}
+		ip := net.ParseIP(nat.Addr)
+		if ip == nil {
+			return errors.New("unsupported " + stmt + " address")
+		}
+		var port uint16
+		if len(nat.Port) > 0 {
+			if err := json.Unmarshal(nat.Port, &port); err != nil {
+				return errors.New("unsupported " + stmt + " port")
+			}
+		}
+		if stmt == "snat" {
+			a.snatIP, a.snatPort = ip.String(), port
+		} else {
+			a.dnatIP, a.dnatPort = ip.String(), port
+		}
+	case "fwd":
+		var fwd struct {
+			Dev string `json:"dev"`
+		}
+		if err := json.Unmarshal(raw, &fwd); err != nil || fwd.Dev == "" {
+			return errors.New("unsupported fwd statement")
+		}
+		a.rdrPort = fwd.Dev
+	default:
+		return errors.New("unsupported statement " + stmt)
+	}
+	return nil
+}
+
+// nftMatchStmt - translate a match expression of a nft rule
+func nftMatchStmt(nm *nftMatch, m *fwImpMatch) error {
+	var left struct {
+		Payload *struct {
+			Protocol string `json:"protocol"`
+			Field    string `json:"field"`
+		} `json:"payload"`
+		Meta *struct {
+			Key string `json:"key"`
+		} `json:"meta"`
+	}
+	if err := json.Unmarshal(nm.Left, &left); err != nil {
+		return errors.New("unsupported match")
+	}
+
+	switch {
+	case left.Meta != nil:
+		switch left.Meta.Key {
+		case "iifname", "iif":
+			var dev string
+			if err := json.Unmarshal(nm.Right, &dev); err != nil || strings.ContainsAny(dev, "*@") {
+				return errors.New("unsupported interface match")
+			}
+			m.inPort = dev
+		case "l4proto":
+			return nftProtoMatch(nm.Right, m)
+		case "nfproto":
+			var fam string
+			if err := json.Unmarshal(nm.Right, &fam); err != nil {
+				return errors.New("unsupported nfproto match")
+			}
+			switch fam {
+			case "ipv4":
+				return m.setFamily(4)
+			case "ipv6":
+				return m.setFamily(6)
+			}
+			return errors.New("unsupported nfproto match")
+		default:
+			return errors.New("unsupported meta match " + left.Meta.Key)
+		}
+	case left.Payload != nil:
+		p := left.Payload
+		switch {
+		case (p.Protocol == "ip" || p.Protocol == "ip6") && (p.Field == "saddr" || p.Field == "daddr"):
+			fam := 4
+			if p.Protocol == "ip6" {
+				fam = 6
+			}
+			if err := m.setFamily(fam); err != nil {
+				return err
+			}
+			pref, err := nftAddr(nm.Right, fam)
+			if err != nil {
+				return err
+			}
+			if p.Field == "saddr" {
+				m.src = pref
+			} else {
+				m.dst = pref
+			}
+		case p.Protocol == "ip" && p.Field == "protocol" || p.Protocol == "ip6" && p.Field == "nexthdr":
+			return nftProtoMatch(nm.Right, m)
+		case (p.Protocol == "tcp" || p.Protocol == "udp" || p.Protocol == "sctp") && (p.Field == "sport" || p.Field == "dport"):
+			proto, _ := fwImpProto(p.Protocol)
+			if m.proto != 0 && m.proto != proto {
+				return errors.New("conflicting protocol matches")
+			}
+			m.proto = proto
+			prs, err := nftPorts(nm.Right)
+			if err != nil {
+				return err
+			}
+			if p.Field == "sport" {
+				m.sports = prs
+			} else {
+				m.dports = prs
+			}
+		default:
+			return errors.New("unsupported match " + p.Protocol + " " + p.Field)
+		}
+	default:
+		return errors.New("unsupported match")
+	}
+	return nil
+}
+
+// nftProtoMatch - translate a protocol match of a nft rule
+func nftProtoMatch(right json.RawMessage, m *fwImpMatch) error {
+	var proto string
+	if err := json.Unmarshal(right, &proto); err != nil {
+		var n uint8
+		if err := json.Unmarshal(right, &n); err != nil {
+			return errors.New("unsupported protocol match")
+		}
+		proto = strconv.Itoa(int(n))
+	}
+	p, err := fwImpProto(proto)
+	if err != nil {
+		return err
+	}
+	if m.proto != 0 && m.proto != p {
+		return errors.New("conflicting protocol matches")
+	}
+	m.proto = p
+	return nil
+}
+
+// nftAddr - translate an address match of a nft rule to a prefix. Matches
+// of named sets are refused as the datapath can't match IP sets yet
+func nftAddr(right json.RawMessage, family int) (string, error) {
+	var s string
+	if err := json.Unmarshal(right, &s); err == nil {
+		if strings.HasPrefix(s, "@") {
+			return "", errors.New("unsupported ip set match " + s[1:])
+		}
+		pref, fam, err := fwImpPrefix(s)
+		if err != nil {
+			return "", err
+		}
+		if fam != family {
+			return "", errors.New("address of other family " + s)
+		}
+		return pref, nil
+	}
+	var p struct {
+		Prefix *struct {
+			Addr string `json:"addr"`
+			Len  int    `json:"len"`
+		} `json:"prefix"`
+	}
+	if err := json.Unmarshal(right, &p); err != nil || p.Prefix == nil {
+		return "", errors.New("unsupported address match")
+	}
+	pref, fam, err := fwImpPrefix(fmt.Sprintf("%s/%d", p.Prefix.Addr, p.Prefix.Len))
+	if err != nil {
+		return "", err
+	}
+	if fam != family {
+		return "", errors.New("address of other family " + p.Prefix.Addr)
+	}
+	return pref, nil
+}
+
+// nftPorts - translate a port match of a nft rule
+func nftPorts(right json.RawMessage) ([]fwImpPorts, error) {
+	var port uint16
+	if err := json.Unmarshal(right, &port); err == nil {
+		return []fwImpPorts{{min: port, max: port}}, nil
+	}
+
+	var obj struct {
+		Range []uint16          `json:"range"`
+		Set   []json.RawMessage `json:"set"`
+	}
+	if err := json.Unmarshal(right, &obj); err != nil {
+		return nil, errors.New("unsupported port match")
+	}
+	if len(obj.Range) == 2 && obj.Range[0] <= obj.Range[1] {
+		return []fwImpPorts{{min: obj.Range[0], max: obj.Range[1]}}, nil
+	}
+	if len(obj.Set) > 0 {
+		var prs []fwImpPorts
+		for _, e := range obj.Set {
+			pr, err := nftPorts(e)
+			if err != nil {
+				return nil, err
+			}
+			prs = append(prs, pr...)
+		}
+		return prs, nil
+	}
+	return nil, errors.New("unsupported port match")
+}
+
+func ConfigPostFWImport(params operations.PostConfigFirewallImportParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Firewall import %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	res, err := FwImportTranslate(*params.Attr.Format, *params.Attr.Ruleset)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	applyMtx.Lock()
+	defer applyMtx.Unlock()
+
+	// Imported rules are added to the existing ones, so nothing is deleted
+	var changes []ApplyChange
+	if res.Doc.Firewall != nil || res.Doc.Lbrule != nil {
+		diff, err := ApplyDiff(&res.Doc)
+		if err != nil {
+			tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+		}
+		for _, c := range diff {
+			if c.Action != ApplyActDelete {
+				changes = append(changes, c)
+			}
+		}
+	}
+
+	if !dryRun {
+		if err := ApplyChanges(changes); err != nil {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("firewall import rolled back: " + err.Error())}
+		}
+		tk.LogIt(tk.LogInfo, "api: firewall import - %d changes, %d rules untranslated\n", len(changes), len(res.Untranslated))
+	}
+
+	result := models.FirewallImportResult{
+		DryRun:       dryRun,
+		Changes:      make([]*models.ConfigApplyChange, 0),
+		Translated:   make([]*models.FirewallImportRule, 0),
+		Untranslated: make([]*models.FirewallImportSkip, 0),
+	}
+	for _, c := range changes {
+		result.Changes = append(result.Changes, &models.ConfigApplyChange{Kind: c.Kind, Action: c.Action, Key: c.Key})
+	}
+	for _, t := range res.Translated {
+		result.Translated = append(result.Translated, &models.FirewallImportRule{Rule: t.Rule, Kind: t.Kind, Keys: t.Keys})
+	}
+	for _, u := range res.Untranslated {
+		result.Untranslated = append(result.Untranslated, &models.FirewallImportSkip{Rule: u.Rule, Reason: u.Reason})
+	}
+
+	return operations.NewPostConfigFirewallImportOK().WithPayload(&result)
+}
@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"strings"
+	"testing"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+const iptablesSave = `# Generated by iptables-save v1.8.7
+*filter
+:INPUT ACCEPT [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT ACCEPT [0:0]
+:CUSTOM - [0:0]
+-A INPUT -s 10.0.0.0/8 -p tcp -m tcp --dport 22 -m comment --comment "ssh from lan" -j ACCEPT
+-A INPUT -i eth1 -p udp -m multiport --dports 53,5000:5010 -j DROP
+-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT
+-A FORWARD -s 10.0.0.0/8 -p tcp -m tcp --dport 22 -j ACCEPT
+-A FORWARD -m set --match-set blocked src -j DROP
+-A FORWARD -s 5.6.7.8/32 -j REJECT --reject-with icmp-port-unreachable
+-A FORWARD -j CUSTOM
+-A CUSTOM -j DROP
+-A OUTPUT -d 1.1.1.1/32 -j DROP
+COMMIT
+*mangle
+-A PREROUTING -s 192.168.1.0/24 -j MARK --set-xmark 0x10/0xffffffff
+COMMIT
+*nat
+-A PREROUTING -d 20.0.0.1/32 -p tcp -m tcp --dport 80 -m statistic --mode random --probability 0.5 -j DNAT --to-destination 10.1.1.1:8080
+-A PREROUTING -d 20.0.0.1/32 -p tcp -m tcp --dport 80 -j DNAT --to-destination 10.1.1.2:8080
+-A POSTROUTING -s 10.1.0.0/16 -j SNAT --to-source 20.0.0.2
+-A POSTROUTING -s 10.2.0.0/16 -o eth0 -j MASQUERADE
+COMMIT
+`
+
+func TestFwImportIptables(t *testing.T) {
+	res, err := FwImportTranslate(FwImportIptables, iptablesSave)
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+
+	fws := res.Doc.Firewall
+	if len(fws) != 4 {
+		t.Fatalf("got %d firewall rules, want 4: %+v", len(fws), fws)
+	}
+	ssh := fws[0]
+	if ssh.Rule.SrcIP != "10.0.0.0/8" || ssh.Rule.DstIP != "0.0.0.0/0" || ssh.Rule.Proto != 6 ||
+		ssh.Rule.DstPortMin != 22 || ssh.Rule.DstPortMax != 22 || !ssh.Opts.Allow {
+		t.Errorf("ssh rule = %+v", ssh)
+	}
+	if ssh.Rule.Pref != uint32(len(fws)) || fws[len(fws)-1].Rule.Pref != 1 {
+		t.Errorf("preferences not in rule order: first %d last %d", ssh.Rule.Pref, fws[len(fws)-1].Rule.Pref)
+	}
+	if fws[1].Rule.InPort != "eth1" || fws[1].Rule.DstPortMin != 53 || !fws[1].Opts.Drop ||
+		fws[2].Rule.DstPortMin != 5000 || fws[2].Rule.DstPortMax != 5010 {
+		t.Errorf("multiport rules = %+v %+v", fws[1], fws[2])
+	}
+	if !fws[3].Opts.DoSnat || fws[3].Opts.ToIP != "20.0.0.2" || fws[3].Rule.SrcIP != "10.1.0.0/16" {
+		t.Errorf("snat rule = %+v", fws[3])
+	}
+
+	if len(res.Doc.Lbrule) != 1 {
+		t.Fatalf("got %d lb rules, want 1", len(res.Doc.Lbrule))
+	}
+	lb := res.Doc.Lbrule[0]
+	if lb.Serv.ServIP != "20.0.0.1" || lb.Serv.ServPort != 80 || lb.Serv.Proto != "tcp" || len(lb.Eps) != 2 ||
+		lb.Eps[0] != (cmn.LbEndPointArg{EpIP: "10.1.1.1", EpPort: 8080, Weight: 1}) {
+		t.Errorf("lb rule = %+v", lb)
+	}
+
+	reasons := make(map[string]string)
+	for _, u := range res.Untranslated {
+		reasons[u.Rule] = u.Reason
+	}
+	for rule, want := range map[string]string{
+		":FORWARD DROP [0:0]": "chain policy DROP",
+		"-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT": "unsupported match conntrack",
+		"-A FORWARD -j CUSTOM":                                "unsupported target CUSTOM",
+		"-A CUSTOM -j DROP":                                   "rule in user-defined chain CUSTOM",
+		"-A OUTPUT -d 1.1.1.1/32 -j DROP":                     "rule in output hook",
+		"-A POSTROUTING -s 10.2.0.0/16 -o eth0 -j MASQUERADE": "unsupported option -o",
+		// IP sets can't be matched by the datapath
+		"-A FORWARD -m set --match-set blocked src -j DROP": "unsupported match set",
+		// A reject would turn into a silent drop, a mark into an allow
+		"-A FORWARD -s 5.6.7.8/32 -j REJECT --reject-with icmp-port-unreachable": "reject without a reply",
+		"-A PREROUTING -s 192.168.1.0/24 -j MARK --set-xmark 0x10/0xffffffff":    "mark without a verdict",
+	} {
+		if reasons[rule] != want {
+			t.Errorf("untranslated %q: reason %q, want %q", rule, reasons[rule], want)
+		}
+	}
+	if len(res.Untranslated) != 9 {
+		t.Errorf("got %d untranslated rules, want 9: %+v", len(res.Untranslated), res.Untranslated)
+	}
+
+	// The FORWARD ssh rule is the same as the INPUT one
+	for _, tr := range res.Translated {
+		if strings.HasPrefix(tr.Rule, "-A FORWARD -s 10.0.0.0/8") && len(tr.Keys) != 0 {
+			t.Errorf("duplicate rule translated to %v", tr.Keys)
+		}
+	}
+}
+
+const nftRuleset = `{"nftables": [
+{"metainfo": {"version": "1.0.2", "json_schema_version": 1}},
+{"table": {"family": "inet", "name": "filter", "handle": 1}},
+{"chain": {"family": "inet", "table": "filter", "name": "input", "handle": 1, "type": "filter", "hook": "input", "prio": 0, "policy": "accept"}},
+{"chain": {"family": "inet", "table": "filter", "name": "allowed", "handle": 2}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 3, "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": {"prefix": {"addr": "10.0.0.0", "len": 8}}}},
+  {"match": {"op": "==", "left": {"payload": {"protocol": "tcp", "field": "dport"}}, "right": {"set": [80, {"range": [8000, 8080]}]}}},
+  {"counter": {"packets": 0, "bytes": 0}},
+  {"accept": null}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 4, "comment": "drop bad", "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip6", "field": "saddr"}}, "right": "@bad6"}},
+  {"log": {"prefix": "bad "}},
+  {"drop": null}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 5, "expr": [
+  {"match": {"op": "==", "left": {"meta": {"key": "iifname"}}, "right": "eth0"}},
+  {"drop": null}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 6, "expr": [
+  {"match": {"op": "!=", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": "1.2.3.4"}},
+  {"drop": null}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 7, "expr": [
+  {"jump": {"target": "allowed"}}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 8, "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": "5.6.7.8"}},
+  {"reject": null}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 9, "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": "192.168.1.0/24"}},
+  {"mangle": {"key": {"meta": {"key": "mark"}}, "value": 16}}]}},
+{"rule": {"family": "inet", "table": "filter", "chain": "input", "handle": 10, "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "saddr"}}, "right": "192.168.2.0/24"}},
+  {"mangle": {"key": {"meta": {"key": "mark"}}, "value": 32}},
+  {"accept": null}]}},
+{"table": {"family": "ip", "name": "nat", "handle": 2}},
+{"chain": {"family": "ip", "table": "nat", "name": "prerouting", "handle": 1, "type": "nat", "hook": "prerouting", "prio": -100, "policy": "accept"}},
+{"rule": {"family": "ip", "table": "nat", "chain": "prerouting", "handle": 2, "expr": [
+  {"match": {"op": "==", "left": {"payload": {"protocol": "ip", "field": "daddr"}}, "right": "20.0.0.1"}},
+  {"match": {"op": "==", "left": {"payload": {"protocol": "udp", "field": "dport"}}, "right": 53}},
+  {"dnat": {"addr": "10.1.1.1", "port": 5353}}]}}
+]}`
+
+func TestFwImportNft(t *testing.T) {
+	res, err := FwImportTranslate(FwImportNft, nftRuleset)
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+
+	fws := res.Doc.Firewall
+	if len(fws) != 5 {
+		t.Fatalf("got %d firewall rules, want 5: %+v", len(fws), fws)
+	}
+	if fws[0].Rule.SrcIP != "10.0.0.0/8" || fws[0].Rule.DstPortMin != 80 || fws[1].Rule.DstPortMin != 8000 ||
+		fws[1].Rule.DstPortMax != 8080 || fws[1].Rule.Proto != 6 || !fws[1].Opts.Allow {
+		t.Errorf("port set rules = %+v %+v", fws[0], fws[1])
+	}
+	// Interface only rules of the inet family match both families
+	if fws[2].Rule.InPort != "eth0" || fws[2].Rule.SrcIP != "0.0.0.0/0" || fws[3].Rule.SrcIP != "::/0" {
+		t.Errorf("inet rules = %+v %+v", fws[2], fws[3])
+	}
+	// A mark is kept along with the verdict of a rule
+	if fws[4].Rule.SrcIP != "192.168.2.0/24" || !fws[4].Opts.Allow || fws[4].Opts.Mark != 32 {
+		t.Errorf("mark rule = %+v", fws[4])
+	}
+
+	if len(res.Doc.Lbrule) != 1 || res.Doc.Lbrule[0].Serv.Proto != "udp" || res.Doc.Lbrule[0].Eps[0].EpPort != 5353 {
+		t.Errorf("lb rules = %+v", res.Doc.Lbrule)
+	}
+
+	if len(res.Untranslated) != 5 ||
+		res.Untranslated[0].Reason != "unsupported ip set match bad6" ||
+		res.Untranslated[1].Reason != "unsupported match operator !=" ||
+		res.Untranslated[2].Reason != "unsupported statement jump" ||
+		res.Untranslated[3].Reason != "reject without a reply" ||
+		res.Untranslated[4].Reason != "mark without a verdict" {
+		t.Errorf("untranslated = %+v", res.Untranslated)
+	}
+}
+
+func TestFwImportErrors(t *testing.T) {
+	if _, err := FwImportTranslate("pf", ""); err == nil {
+		t.Errorf("unknown format accepted")
+	}
+	if _, err := FwImportTranslate(FwImportNft, "{"); err == nil {
+		t.Errorf("invalid json accepted")
+	}
+	res, err := FwImportTranslate(FwImportIp6tables, "*filter\n-A INPUT -s 10.0.0.1 -j DROP\n-A INPUT -s 2001:db8::/32 -j DROP\nCOMMIT\n")
+	if err != nil {
+		t.Fatalf("translate: %v", err)
+	}
+	if len(res.Doc.Firewall) != 1 || res.Doc.Firewall[0].Rule.SrcIP != "2001:db8::/32" || len(res.Untranslated) != 1 {
+		t.Errorf("ip6tables = %+v, untranslated %+v", res.Doc.Firewall, res.Untranslated)
+	}
+}
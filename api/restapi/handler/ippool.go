@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func ConfigPostIPPool(params operations.PostConfigIppoolParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var poolMod cmn.IPPoolMod
+	if params.Attr.Name != nil {
+		poolMod.Name = *params.Attr.Name
+	}
+	if params.Attr.Cidr != nil {
+		poolMod.CIDR = *params.Attr.Cidr
+	}
+
+	tk.LogIt(tk.LogDebug, "api: IPPoolMod : %v\n", poolMod)
+	_, err := ApiHooks.NetIPPoolAdd(&poolMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigDeleteIPPool(params operations.DeleteConfigIppoolNameNameParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var poolMod cmn.IPPoolMod
+	poolMod.Name = params.Name
+
+	tk.LogIt(tk.LogDebug, "api: IPPoolMod : %v\n", poolMod)
+	_, err := ApiHooks.NetIPPoolDel(&poolMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigGetIPPool(params operations.GetConfigIppoolAllParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPPool %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+	res, err := ApiHooks.NetIPPoolGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	result := make([]*models.IPPoolGetEntry, 0)
+	for _, pool := range res {
+		var tmpPool models.IPPoolGetEntry
+		tmpPool.Name = pool.Name
+		tmpPool.Cidr = pool.CIDR
+		for _, alloc := range pool.Allocs {
+			tmpPool.Allocations = append(tmpPool.Allocations, &models.IPPoolAllocEntry{
+				IP:    alloc.IP,
+				Owner: alloc.Owner,
+				Rules: int64(alloc.Rules),
+			})
+		}
+		result = append(result, &tmpPool)
+	}
+
+	return operations.NewGetConfigIppoolAllOK().WithPayload(&operations.GetConfigIppoolAllOKBody{IPPoolAttr: result})
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+func ConfigPostIPSet(params operations.PostConfigIpsetParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var ipSetMod cmn.IPSetMod
+	if params.Attr.Name != nil {
+		ipSetMod.Name = *params.Attr.Name
+	}
+	ipSetMod.Members = params.Attr.Members
+
+	tk.LogIt(tk.LogDebug, "api: IPSetMod : %v\n", ipSetMod)
+	_, err := ApiHooks.NetIPSetAdd(&ipSetMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigDeleteIPSet(params operations.DeleteConfigIpsetNameNameParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var ipSetMod cmn.IPSetMod
+	ipSetMod.Name = params.Name
+
+	tk.LogIt(tk.LogDebug, "api: IPSetMod : %v\n", ipSetMod)
+	_, err := ApiHooks.NetIPSetDel(&ipSetMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigPutIPSetMembers(params operations.PutConfigIpsetNameNameMembersParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var memberMod cmn.IPSetMemberMod
+	memberMod.Name = params.Name
+	memberMod.Add = params.Attr.Add
+	memberMod.Remove = params.Attr.Remove
+
+	tk.LogIt(tk.LogDebug, "api: IPSetMemberMod : %v\n", memberMod)
+	_, err := ApiHooks.NetIPSetMemberMod(&memberMod)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	return &ResultResponse{Result: "Success"}
+}
+
+func ConfigGetIPSet(params operations.GetConfigIpsetAllParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: IPSet %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+	res, err := ApiHooks.NetIPSetGet()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+	result := make([]*models.IPSetEntry, 0)
+	for _, set := range res {
+		var tmpSet models.IPSetEntry
+		name := set.Name
+		tmpSet.Name = &name
+		tmpSet.Members = set.Members
+		result = append(result, &tmpSet)
+	}
+
+	return operations.NewGetConfigIpsetAllOK().WithPayload(&operations.GetConfigIpsetAllOKBody{IPSetAttr: result})
+}
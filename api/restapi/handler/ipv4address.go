@@ -31,6 +31,8 @@ func ConfigPostIPv4Address(params operations.PostConfigIpv4addressParams, princi
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindAddr, true,
+		loxinlp.NetConfigRec{Addr: *params.Attr.IPAddress, Dev: *params.Attr.Dev})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -41,6 +43,7 @@ func ConfigDeleteIPv4Address(params operations.DeleteConfigIpv4addressIPAddressM
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindAddr, false, loxinlp.NetConfigRec{Addr: ipNet, Dev: params.IfName})
 	return &ResultResponse{Result: "Success"}
 }
 
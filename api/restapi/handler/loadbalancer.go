@@ -16,6 +16,8 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/loxilb-io/loxilb/api/models"
 	"github.com/loxilb-io/loxilb/api/restapi/operations"
@@ -23,55 +25,66 @@ import (
 	tk "github.com/loxilb-io/loxilib"
 )
 
-func ConfigPostLoadbalancer(params operations.PostConfigLoadbalancerParams, principal interface{}) middleware.Responder {
-	tk.LogIt(tk.LogTrace, "api: Load balancer %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
-
+// lbEntry2Mod - convert a load balancer entry of the API to a cmn.LbRuleMod
+func lbEntry2Mod(attr *models.LoadbalanceEntry) cmn.LbRuleMod {
 	var lbRules cmn.LbRuleMod
 
-	if params.Attr.ServiceArguments.ExternalIP != nil {
-		lbRules.Serv.ServIP = *params.Attr.ServiceArguments.ExternalIP
+	if attr.ServiceArguments.ExternalIP != nil {
+		lbRules.Serv.ServIP = *attr.ServiceArguments.ExternalIP
 	}
-	lbRules.Serv.PrivateIP = params.Attr.ServiceArguments.PrivateIP
-	if params.Attr.ServiceArguments.Port != nil {
-		lbRules.Serv.ServPort = uint16(*params.Attr.ServiceArguments.Port)
+	lbRules.Serv.PrivateIP = attr.ServiceArguments.PrivateIP
+	if attr.ServiceArguments.Port != nil {
+		lbRules.Serv.ServPort = uint16(*attr.ServiceArguments.Port)
 	}
-	lbRules.Serv.ServPortMax = uint16(params.Attr.ServiceArguments.PortMax)
-	lbRules.Serv.Proto = params.Attr.ServiceArguments.Protocol
-	lbRules.Serv.BlockNum = params.Attr.ServiceArguments.Block
-	lbRules.Serv.Sel = cmn.EpSelect(params.Attr.ServiceArguments.Sel)
-	lbRules.Serv.Bgp = params.Attr.ServiceArguments.Bgp
-	lbRules.Serv.Monitor = params.Attr.ServiceArguments.Monitor
-	lbRules.Serv.Mode = cmn.LBMode(params.Attr.ServiceArguments.Mode)
-	lbRules.Serv.Security = cmn.LBSec(params.Attr.ServiceArguments.Security)
-	lbRules.Serv.InactiveTimeout = uint32(params.Attr.ServiceArguments.InactiveTimeOut)
-	lbRules.Serv.Managed = params.Attr.ServiceArguments.Managed
-	lbRules.Serv.ProbeType = params.Attr.ServiceArguments.Probetype
-	lbRules.Serv.ProbePort = params.Attr.ServiceArguments.Probeport
-	lbRules.Serv.ProbeReq = params.Attr.ServiceArguments.Probereq
-	lbRules.Serv.ProbeResp = params.Attr.ServiceArguments.Proberesp
-	lbRules.Serv.ProbeTimeout = params.Attr.ServiceArguments.ProbeTimeout
-	lbRules.Serv.ProbeRetries = int(params.Attr.ServiceArguments.ProbeRetries)
-	lbRules.Serv.Name = params.Attr.ServiceArguments.Name
-	lbRules.Serv.Oper = cmn.LBOp(params.Attr.ServiceArguments.Oper)
-	lbRules.Serv.HostUrl = params.Attr.ServiceArguments.Host
-	lbRules.Serv.ProxyProtocolV2 = params.Attr.ServiceArguments.Proxyprotocolv2
-	lbRules.Serv.Egress = params.Attr.ServiceArguments.Egress
+	lbRules.Serv.ServPortMax = uint16(attr.ServiceArguments.PortMax)
+	lbRules.Serv.Proto = attr.ServiceArguments.Protocol
+	lbRules.Serv.BlockNum = attr.ServiceArguments.Block
+	lbRules.Serv.Sel = cmn.EpSelect(attr.ServiceArguments.Sel)
+	lbRules.Serv.Bgp = attr.ServiceArguments.Bgp
+	lbRules.Serv.Monitor = attr.ServiceArguments.Monitor
+	lbRules.Serv.Mode = cmn.LBMode(attr.ServiceArguments.Mode)
+	lbRules.Serv.Security = cmn.LBSec(attr.ServiceArguments.Security)
+	lbRules.Serv.InactiveTimeout = uint32(attr.ServiceArguments.InactiveTimeOut)
+	lbRules.Serv.Managed = attr.ServiceArguments.Managed
+	lbRules.Serv.ProbeType = attr.ServiceArguments.Probetype
+	lbRules.Serv.ProbePort = attr.ServiceArguments.Probeport
+	lbRules.Serv.ProbeReq = attr.ServiceArguments.Probereq
+	lbRules.Serv.ProbeResp = attr.ServiceArguments.Proberesp
+	lbRules.Serv.ProbeTimeout = attr.ServiceArguments.ProbeTimeout
+	lbRules.Serv.ProbeRetries = int(attr.ServiceArguments.ProbeRetries)
+	lbRules.Serv.ProbeRise = int(attr.ServiceArguments.ProbeRise)
+	lbRules.Serv.SlowStart = attr.ServiceArguments.SlowStart
+	lbRules.Serv.OutlierConsecErrors = int(attr.ServiceArguments.OutlierConsecutiveErrors)
+	lbRules.Serv.OutlierErrorRate = attr.ServiceArguments.OutlierErrorRate
+	lbRules.Serv.OutlierEjectTime = attr.ServiceArguments.OutlierEjectTime
+	lbRules.Serv.OutlierMaxEjectPercent = attr.ServiceArguments.OutlierMaxEjectPercent
+	lbRules.Serv.Name = attr.ServiceArguments.Name
+	lbRules.Serv.Oper = cmn.LBOp(attr.ServiceArguments.Oper)
+	lbRules.Serv.HostUrl = attr.ServiceArguments.Host
+	lbRules.Serv.ProxyProtocolV2 = attr.ServiceArguments.Proxyprotocolv2
+	lbRules.Serv.Egress = attr.ServiceArguments.Egress
 
 	if lbRules.Serv.Proto == "sctp" {
-		for _, data := range params.Attr.SecondaryIPs {
+		for _, data := range attr.SecondaryIPs {
 			lbRules.SecIPs = append(lbRules.SecIPs, cmn.LbSecIPArg{
 				SecIP: data.SecondaryIP,
 			})
 		}
 	}
 
-	for _, data := range params.Attr.AllowedSources {
-		lbRules.SrcIPs = append(lbRules.SrcIPs, cmn.LbAllowedSrcIPArg{
-			Prefix: data.Prefix,
-		})
+	for _, data := range attr.AllowedSources {
+		src := cmn.LbAllowedSrcIPArg{
+			Prefix:    data.Prefix,
+			ValidFrom: data.ValidFrom,
+			ExpiresAt: data.ExpiresAt,
+		}
+		if data.TTL > 0 {
+			src.TTL = uint32(data.TTL)
+		}
+		lbRules.SrcIPs = append(lbRules.SrcIPs, src)
 	}
 
-	for _, data := range params.Attr.Endpoints {
+	for _, data := range attr.Endpoints {
 
 		var epIP string
 		var epTargetPort uint16
@@ -93,8 +106,16 @@ func ConfigPostLoadbalancer(params operations.PostConfigLoadbalancerParams, prin
 		})
 	}
 
-	if lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash {
-		return &ResultResponse{Result: "Error: Only Hash Selection criteria allowed for DSR mode"}
+	return lbRules
+}
+
+func ConfigPostLoadbalancer(params operations.PostConfigLoadbalancerParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Load balancer %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	lbRules := lbEntry2Mod(params.Attr)
+
+	if lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash && lbRules.Serv.Sel != cmn.LbSelMaglev {
+		return &ResultResponse{Result: "Error: Only Hash or Maglev Selection criteria allowed for DSR mode"}
 	}
 
 	tk.LogIt(tk.LogDebug, "api: lbRules : %v\n", lbRules)
@@ -269,6 +290,10 @@ func ConfigGetLoadbalancer(params operations.GetConfigLoadbalancerAllParams, pri
 		for _, src := range lb.SrcIPs {
 			tmpSIP := new(models.LoadbalanceEntryAllowedSourcesItems0)
 			tmpSIP.Prefix = src.Prefix
+			tmpSIP.ValidFrom = src.ValidFrom
+			tmpSIP.ExpiresAt = src.ExpiresAt
+			tmpSIP.TTL = int64(src.TTL)
+			tmpSIP.TTLExpiresAt = src.TTLExpiresAt
 			tmpLB.AllowedSources = append(tmpLB.AllowedSources, tmpSIP)
 		}
 
@@ -333,3 +358,48 @@ func ConfigDeleteLoadbalancerByName(params operations.DeleteConfigLoadbalancerNa
 
 	return &ResultResponse{Result: "Success"}
 }
+
+func ConfigPostLoadbalancerBatch(params operations.PostConfigLoadbalancerBatchParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Load balancer batch %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var ops []cmn.LbRuleBatchOp
+
+	for i, op := range params.Attr.Ops {
+		if op.Oper == nil || op.Rule == nil || op.Rule.ServiceArguments == nil {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(fmt.Sprintf("malformed batch op %d", i))}
+		}
+		lbRules := lbEntry2Mod(op.Rule)
+		if *op.Oper == cmn.LBBatchOpAdd && lbRules.Serv.Mode == cmn.LBModeDSR && lbRules.Serv.Sel != cmn.LbSelHash && lbRules.Serv.Sel != cmn.LbSelMaglev {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(fmt.Sprintf("batch op %d: only hash or maglev selection criteria allowed for DSR mode", i))}
+		}
+		ops = append(ops, cmn.LbRuleBatchOp{Oper: *op.Oper, Rule: lbRules})
+	}
+
+	tk.LogIt(tk.LogDebug, "api: lbRules batch of %d\n", len(ops))
+	res, err := ApiHooks.NetLbRuleBatch(ops)
+	if err != nil && res == nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+
+	var result models.LoadbalanceBatchResult
+	result.Committed = err == nil
+	result.Items = make([]*models.LoadbalanceBatchItem, 0)
+	for _, r := range res {
+		index := int64(r.Index)
+		status := r.Status
+		result.Items = append(result.Items, &models.LoadbalanceBatchItem{
+			Index:  &index,
+			Oper:   r.Oper,
+			Status: &status,
+			Code:   int64(r.Code),
+			Error:  r.Error,
+		})
+	}
+
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		return operations.NewPostConfigLoadbalancerBatchConflict().WithPayload(&result)
+	}
+	return operations.NewPostConfigLoadbalancerBatchOK().WithPayload(&result)
+}
@@ -33,6 +33,8 @@ func ConfigPostNeighbor(params operations.PostConfigNeighborParams, principal in
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindNeigh, true, loxinlp.NetConfigRec{Addr: *params.Attr.IPAddress,
+		Dev: *params.Attr.Dev, Mac: *params.Attr.MacAddress})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -42,6 +44,7 @@ func ConfigDeleteNeighbor(params operations.DeleteConfigNeighborIPAddressDevIfNa
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindNeigh, false, loxinlp.NetConfigRec{Addr: params.IPAddress, Dev: params.IfName})
 	return &ResultResponse{Result: "Success"}
 }
 
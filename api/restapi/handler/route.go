@@ -34,6 +34,8 @@ func ConfigPostRoute(params operations.PostConfigRouteParams, principal interfac
 		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindRoute, true, loxinlp.NetConfigRec{Addr: *params.Attr.DestinationIPNet,
+		Gw: *params.Attr.Gateway, Proto: params.Attr.Protocol})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -45,6 +47,7 @@ func ConfigDeleteRoute(params operations.DeleteConfigRouteDestinationIPNetIPAddr
 		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindRoute, false, loxinlp.NetConfigRec{Addr: DstIP})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -33,6 +33,7 @@ func ConfigPostVLAN(params operations.PostConfigVlanParams, principal interface{
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVlan, true, loxinlp.NetConfigRec{ID: vid})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -42,6 +43,7 @@ func ConfigDeleteVLAN(params operations.DeleteConfigVlanVlanIDParams, principal
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVlan, false, loxinlp.NetConfigRec{ID: int(params.VlanID)})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -51,6 +53,8 @@ func ConfigPostVLANMember(params operations.PostConfigVlanVlanIDMemberParams, pr
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVlanMember, true,
+		loxinlp.NetConfigRec{ID: int(params.VlanID), Dev: params.Attr.Dev, Tagged: params.Attr.Tagged})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -60,6 +64,8 @@ func ConfigDeleteVLANMember(params operations.DeleteConfigVlanVlanIDMemberIfName
 	if err != nil {
 		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVlanMember, false,
+		loxinlp.NetConfigRec{ID: int(params.VlanID), Dev: params.IfName, Tagged: params.Tagged})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -29,6 +29,8 @@ func ConfigPostVxLAN(params operations.PostConfigTunnelVxlanParams, principal in
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVxlan, true,
+		loxinlp.NetConfigRec{ID: int(*params.Attr.VxlanID), Dev: *params.Attr.EpIntf})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -38,6 +40,7 @@ func ConfigDeleteVxLAN(params operations.DeleteConfigTunnelVxlanVxlanIDParams, p
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVxlan, false, loxinlp.NetConfigRec{ID: int(params.VxlanID)})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -47,6 +50,8 @@ func ConfigPostVxLANPeer(params operations.PostConfigTunnelVxlanVxlanIDPeerParam
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVxlanPeer, true,
+		loxinlp.NetConfigRec{ID: int(params.VxlanID), Addr: *params.Attr.PeerIP})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -56,6 +61,8 @@ func ConfigDeleteVxLANPeer(params operations.DeleteConfigTunnelVxlanVxlanIDPeerP
 	if ret != 0 {
 		return &ResultResponse{Result: "fail"}
 	}
+	loxinlp.NetConfigPersist(loxinlp.StoreKindVxlanPeer, false,
+		loxinlp.NetConfigRec{ID: int(params.VxlanID), Addr: params.PeerIP})
 	return &ResultResponse{Result: "Success"}
 }
 
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/loxilb-io/loxilb/api/models"
+	"github.com/loxilb-io/loxilb/api/restapi/operations"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+	"golang.org/x/net/websocket"
+)
+
+// WatchHeartbeat - interval of keep-alives sent on idle watch streams
+const WatchHeartbeat = 15 * time.Second
+
+func ConfigGetWatch(params operations.GetConfigWatchParams, principal interface{}) middleware.Responder {
+	tk.LogIt(tk.LogTrace, "api: Watch %s API called. url : %s\n", params.HTTPRequest.Method, params.HTTPRequest.URL)
+
+	var kinds []string
+	var since uint64
+
+	if params.Kinds != nil {
+		for _, k := range strings.Split(*params.Kinds, ",") {
+			kinds = append(kinds, strings.TrimSpace(k))
+		}
+	}
+
+	if params.ResourceVersion != nil {
+		if *params.ResourceVersion < 0 {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("malformed watch resourceVersion")}
+		}
+		since = uint64(*params.ResourceVersion)
+	} else if lastID := params.HTTPRequest.Header.Get("Last-Event-ID"); lastID != "" {
+		v, err := strconv.ParseUint(lastID, 10, 64)
+		if err != nil {
+			return &ErrorResponse{Payload: ResultErrorResponseErrorMessage("malformed watch Last-Event-ID")}
+		}
+		since = v
+	}
+
+	evCh, cancel, err := ApiHooks.NetWatch(kinds, since)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "api: Error occur : %v\n", err)
+		if strings.Contains(err.Error(), "expired") {
+			return &ErrorResponse{Payload: &models.Error{Code: http.StatusGone, Message: "Resource version expired", Result: err.Error()}}
+		}
+		return &ErrorResponse{Payload: ResultErrorResponseErrorMessage(err.Error())}
+	}
+
+	req := params.HTTPRequest
+	if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		return CustomResponder(func(w http.ResponseWriter, _ runtime.Producer) {
+			defer cancel()
+			ws := websocket.Server{Handler: func(conn *websocket.Conn) {
+				watchWebsocket(conn, evCh)
+			}}
+			ws.ServeHTTP(w, req)
+		})
+	}
+
+	return CustomResponder(func(w http.ResponseWriter, _ runtime.Producer) {
+		defer cancel()
+		watchSSE(w, req, evCh)
+	})
+}
+
+// watchSSE - stream watch events as server-sent events
+func watchSSE(w http.ResponseWriter, req *http.Request, evCh <-chan cmn.WatchEvent) {
+	rc := http.NewResponseController(w)
+	// The stream outlives the server write timeout
+	rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		tk.LogIt(tk.LogError, "api: watch stream can't be flushed : %v\n", err)
+		return
+	}
+
+	hb := time.NewTicker(WatchHeartbeat)
+	defer hb.Stop()
+
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case <-hb.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case ev, ok := <-evCh:
+			if !ok {
+				// The watcher fell behind. The client resumes from its last version
+				return
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", ev.Version, ev.Kind, data); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// watchWebsocket - stream watch events as WebSocket text messages
+func watchWebsocket(conn *websocket.Conn, evCh <-chan cmn.WatchEvent) {
+	defer conn.Close()
+
+	// Detect the client going away
+	done := make(chan struct{})
+	go func() {
+		var msg string
+		for websocket.Message.Receive(conn, &msg) == nil {
+		}
+		close(done)
+	}()
+
+	hb := time.NewTicker(WatchHeartbeat)
+	defer hb.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-hb.C:
+			conn.SetWriteDeadline(time.Now().Add(WatchHeartbeat))
+			conn.PayloadType = websocket.PingFrame
+			if _, err := conn.Write(nil); err != nil {
+				return
+			}
+		case ev, ok := <-evCh:
+			if !ok {
+				return
+			}
+			conn.SetWriteDeadline(time.Now().Add(WatchHeartbeat))
+			if err := websocket.JSON.Send(conn, ev); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -36,6 +36,10 @@ type DeleteConfigFirewallParams struct {
 	  In: query
 	*/
 	DestinationIP *string
+	/*Name of an IP set of destination prefixes. Not supported by the datapath yet, rules using it are refused
+	  In: query
+	*/
+	DestinationIPSet *string
 	/*Maximum destination port range
 	  In: query
 	*/
@@ -68,6 +72,10 @@ type DeleteConfigFirewallParams struct {
 	  In: query
 	*/
 	SourceIP *string
+	/*Name of an IP set of source prefixes. Not supported by the datapath yet, rules using it are refused
+	  In: query
+	*/
+	SourceIPSet *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -86,6 +94,11 @@ func (o *DeleteConfigFirewallParams) BindRequest(r *http.Request, route *middlew
 		res = append(res, err)
 	}
 
+	qDestinationIPSet, qhkDestinationIPSet, _ := qs.GetOK("destinationIPSet")
+	if err := o.bindDestinationIPSet(qDestinationIPSet, qhkDestinationIPSet, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qMaxDestinationPort, qhkMaxDestinationPort, _ := qs.GetOK("maxDestinationPort")
 	if err := o.bindMaxDestinationPort(qMaxDestinationPort, qhkMaxDestinationPort, route.Formats); err != nil {
 		res = append(res, err)
@@ -125,6 +138,11 @@ func (o *DeleteConfigFirewallParams) BindRequest(r *http.Request, route *middlew
 	if err := o.bindSourceIP(qSourceIP, qhkSourceIP, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qSourceIPSet, qhkSourceIPSet, _ := qs.GetOK("sourceIPSet")
+	if err := o.bindSourceIPSet(qSourceIPSet, qhkSourceIPSet, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -149,6 +167,24 @@ func (o *DeleteConfigFirewallParams) bindDestinationIP(rawData []string, hasKey
 	return nil
 }
 
+// bindDestinationIPSet binds and validates parameter DestinationIPSet from query.
+func (o *DeleteConfigFirewallParams) bindDestinationIPSet(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.DestinationIPSet = &raw
+
+	return nil
+}
+
 // bindMaxDestinationPort binds and validates parameter MaxDestinationPort from query.
 func (o *DeleteConfigFirewallParams) bindMaxDestinationPort(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -322,3 +358,21 @@ func (o *DeleteConfigFirewallParams) bindSourceIP(rawData []string, hasKey bool,
 
 	return nil
 }
+
+// bindSourceIPSet binds and validates parameter SourceIPSet from query.
+func (o *DeleteConfigFirewallParams) bindSourceIPSet(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SourceIPSet = &raw
+
+	return nil
+}
@@ -16,6 +16,7 @@ import (
 // DeleteConfigFirewallURL generates an URL for the delete config firewall operation
 type DeleteConfigFirewallURL struct {
 	DestinationIP      *string
+	DestinationIPSet   *string
 	MaxDestinationPort *int64
 	MaxSourcePort      *int64
 	MinDestinationPort *int64
@@ -24,6 +25,7 @@ type DeleteConfigFirewallURL struct {
 	Preference         *int64
 	Protocol           *int64
 	SourceIP           *string
+	SourceIPSet        *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -67,6 +69,14 @@ func (o *DeleteConfigFirewallURL) Build() (*url.URL, error) {
 		qs.Set("destinationIP", destinationIPQ)
 	}
 
+	var destinationIPSetQ string
+	if o.DestinationIPSet != nil {
+		destinationIPSetQ = *o.DestinationIPSet
+	}
+	if destinationIPSetQ != "" {
+		qs.Set("destinationIPSet", destinationIPSetQ)
+	}
+
 	var maxDestinationPortQ string
 	if o.MaxDestinationPort != nil {
 		maxDestinationPortQ = swag.FormatInt64(*o.MaxDestinationPort)
@@ -131,6 +141,14 @@ func (o *DeleteConfigFirewallURL) Build() (*url.URL, error) {
 		qs.Set("sourceIP", sourceIPQ)
 	}
 
+	var sourceIPSetQ string
+	if o.SourceIPSet != nil {
+		sourceIPSetQ = *o.SourceIPSet
+	}
+	if sourceIPSetQ != "" {
+		qs.Set("sourceIPSet", sourceIPSetQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
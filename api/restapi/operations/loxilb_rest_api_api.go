@@ -77,6 +77,12 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		DeleteConfigFirewallHandler: DeleteConfigFirewallHandlerFunc(func(params DeleteConfigFirewallParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteConfigFirewall has not yet been implemented")
 		}),
+		DeleteConfigIppoolNameNameHandler: DeleteConfigIppoolNameNameHandlerFunc(func(params DeleteConfigIppoolNameNameParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteConfigIppoolNameName has not yet been implemented")
+		}),
+		DeleteConfigIpsetNameNameHandler: DeleteConfigIpsetNameNameHandlerFunc(func(params DeleteConfigIpsetNameNameParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteConfigIpsetNameName has not yet been implemented")
+		}),
 		DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler: DeleteConfigIpv4addressIPAddressMaskDevIfNameHandlerFunc(func(params DeleteConfigIpv4addressIPAddressMaskDevIfNameParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteConfigIpv4addressIPAddressMaskDevIfName has not yet been implemented")
 		}),
@@ -131,6 +137,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler: DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandlerFunc(func(params DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteConfigVlanVlanIDMemberIfNameTaggedTagged has not yet been implemented")
 		}),
+		GetAuditHandler: GetAuditHandlerFunc(func(params GetAuditParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetAudit has not yet been implemented")
+		}),
 		UsersGetAuthUsersHandler: users.GetAuthUsersHandlerFunc(func(params users.GetAuthUsersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation users.GetAuthUsers has not yet been implemented")
 		}),
@@ -167,6 +176,15 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		GetConfigFirewallAllHandler: GetConfigFirewallAllHandlerFunc(func(params GetConfigFirewallAllParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigFirewallAll has not yet been implemented")
 		}),
+		GetConfigFirewallStatsHandler: GetConfigFirewallStatsHandlerFunc(func(params GetConfigFirewallStatsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigFirewallStats has not yet been implemented")
+		}),
+		GetConfigIppoolAllHandler: GetConfigIppoolAllHandlerFunc(func(params GetConfigIppoolAllParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigIppoolAll has not yet been implemented")
+		}),
+		GetConfigIpsetAllHandler: GetConfigIpsetAllHandlerFunc(func(params GetConfigIpsetAllParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigIpsetAll has not yet been implemented")
+		}),
 		GetConfigIpv4addressAllHandler: GetConfigIpv4addressAllHandlerFunc(func(params GetConfigIpv4addressAllParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigIpv4addressAll has not yet been implemented")
 		}),
@@ -206,6 +224,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		GetConfigVlanAllHandler: GetConfigVlanAllHandlerFunc(func(params GetConfigVlanAllParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation GetConfigVlanAll has not yet been implemented")
 		}),
+		GetConfigWatchHandler: GetConfigWatchHandlerFunc(func(params GetConfigWatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetConfigWatch has not yet been implemented")
+		}),
 		GetLogArchivesHandler: GetLogArchivesHandlerFunc(func(params GetLogArchivesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation GetLogArchives has not yet been implemented")
 		}),
@@ -293,6 +314,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		UsersPostAuthUsersHandler: users.PostAuthUsersHandlerFunc(func(params users.PostAuthUsersParams) middleware.Responder {
 			return middleware.NotImplemented("operation users.PostAuthUsers has not yet been implemented")
 		}),
+		PostConfigApplyHandler: PostConfigApplyHandlerFunc(func(params PostConfigApplyParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigApply has not yet been implemented")
+		}),
 		PostConfigBfdHandler: PostConfigBfdHandlerFunc(func(params PostConfigBfdParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigBfd has not yet been implemented")
 		}),
@@ -329,15 +353,27 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		PostConfigFirewallHandler: PostConfigFirewallHandlerFunc(func(params PostConfigFirewallParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigFirewall has not yet been implemented")
 		}),
+		PostConfigFirewallImportHandler: PostConfigFirewallImportHandlerFunc(func(params PostConfigFirewallImportParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigFirewallImport has not yet been implemented")
+		}),
 		PostConfigImportHandler: PostConfigImportHandlerFunc(func(params PostConfigImportParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigImport has not yet been implemented")
 		}),
+		PostConfigIppoolHandler: PostConfigIppoolHandlerFunc(func(params PostConfigIppoolParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigIppool has not yet been implemented")
+		}),
+		PostConfigIpsetHandler: PostConfigIpsetHandlerFunc(func(params PostConfigIpsetParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigIpset has not yet been implemented")
+		}),
 		PostConfigIpv4addressHandler: PostConfigIpv4addressHandlerFunc(func(params PostConfigIpv4addressParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigIpv4address has not yet been implemented")
 		}),
 		PostConfigLoadbalancerHandler: PostConfigLoadbalancerHandlerFunc(func(params PostConfigLoadbalancerParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigLoadbalancer has not yet been implemented")
 		}),
+		PostConfigLoadbalancerBatchHandler: PostConfigLoadbalancerBatchHandlerFunc(func(params PostConfigLoadbalancerBatchParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostConfigLoadbalancerBatch has not yet been implemented")
+		}),
 		PostConfigMetricsHandler: PostConfigMetricsHandlerFunc(func(params PostConfigMetricsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostConfigMetrics has not yet been implemented")
 		}),
@@ -380,6 +416,9 @@ func NewLoxilbRestAPIAPI(spec *loads.Document) *LoxilbRestAPIAPI {
 		MetadataGetMetaHandler: metadata.GetMetaHandlerFunc(func(params metadata.GetMetaParams) middleware.Responder {
 			return middleware.NotImplemented("operation metadata.GetMeta has not yet been implemented")
 		}),
+		PutConfigIpsetNameNameMembersHandler: PutConfigIpsetNameNameMembersHandlerFunc(func(params PutConfigIpsetNameNameMembersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PutConfigIpsetNameNameMembers has not yet been implemented")
+		}),
 
 		// Applies when the "Authorization" header is set
 		BearerAuthAuth: func(token string) (interface{}, error) {
@@ -453,6 +492,10 @@ type LoxilbRestAPIAPI struct {
 	DeleteConfigFdbMacAddressDevIfNameHandler DeleteConfigFdbMacAddressDevIfNameHandler
 	// DeleteConfigFirewallHandler sets the operation handler for the delete config firewall operation
 	DeleteConfigFirewallHandler DeleteConfigFirewallHandler
+	// DeleteConfigIppoolNameNameHandler sets the operation handler for the delete config ippool name name operation
+	DeleteConfigIppoolNameNameHandler DeleteConfigIppoolNameNameHandler
+	// DeleteConfigIpsetNameNameHandler sets the operation handler for the delete config ipset name name operation
+	DeleteConfigIpsetNameNameHandler DeleteConfigIpsetNameNameHandler
 	// DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler sets the operation handler for the delete config ipv4address IP address mask dev if name operation
 	DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler
 	// DeleteConfigLoadbalancerAllHandler sets the operation handler for the delete config loadbalancer all operation
@@ -489,6 +532,8 @@ type LoxilbRestAPIAPI struct {
 	DeleteConfigVlanVlanIDHandler DeleteConfigVlanVlanIDHandler
 	// DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler sets the operation handler for the delete config vlan vlan ID member if name tagged tagged operation
 	DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler
+	// GetAuditHandler sets the operation handler for the get audit operation
+	GetAuditHandler GetAuditHandler
 	// UsersGetAuthUsersHandler sets the operation handler for the get auth users operation
 	UsersGetAuthUsersHandler users.GetAuthUsersHandler
 	// GetConfigBfdAllHandler sets the operation handler for the get config bfd all operation
@@ -513,6 +558,12 @@ type LoxilbRestAPIAPI struct {
 	GetConfigFdbAllHandler GetConfigFdbAllHandler
 	// GetConfigFirewallAllHandler sets the operation handler for the get config firewall all operation
 	GetConfigFirewallAllHandler GetConfigFirewallAllHandler
+	// GetConfigFirewallStatsHandler sets the operation handler for the get config firewall stats operation
+	GetConfigFirewallStatsHandler GetConfigFirewallStatsHandler
+	// GetConfigIppoolAllHandler sets the operation handler for the get config ippool all operation
+	GetConfigIppoolAllHandler GetConfigIppoolAllHandler
+	// GetConfigIpsetAllHandler sets the operation handler for the get config ipset all operation
+	GetConfigIpsetAllHandler GetConfigIpsetAllHandler
 	// GetConfigIpv4addressAllHandler sets the operation handler for the get config ipv4address all operation
 	GetConfigIpv4addressAllHandler GetConfigIpv4addressAllHandler
 	// GetConfigLoadbalancerAllHandler sets the operation handler for the get config loadbalancer all operation
@@ -539,6 +590,8 @@ type LoxilbRestAPIAPI struct {
 	GetConfigTunnelVxlanAllHandler GetConfigTunnelVxlanAllHandler
 	// GetConfigVlanAllHandler sets the operation handler for the get config vlan all operation
 	GetConfigVlanAllHandler GetConfigVlanAllHandler
+	// GetConfigWatchHandler sets the operation handler for the get config watch operation
+	GetConfigWatchHandler GetConfigWatchHandler
 	// GetLogArchivesHandler sets the operation handler for the get log archives operation
 	GetLogArchivesHandler GetLogArchivesHandler
 	// GetLogArchivesFilenameHandler sets the operation handler for the get log archives filename operation
@@ -597,6 +650,8 @@ type LoxilbRestAPIAPI struct {
 	AuthPostAuthTokenUpgradeHandler auth.PostAuthTokenUpgradeHandler
 	// UsersPostAuthUsersHandler sets the operation handler for the post auth users operation
 	UsersPostAuthUsersHandler users.PostAuthUsersHandler
+	// PostConfigApplyHandler sets the operation handler for the post config apply operation
+	PostConfigApplyHandler PostConfigApplyHandler
 	// PostConfigBfdHandler sets the operation handler for the post config bfd operation
 	PostConfigBfdHandler PostConfigBfdHandler
 	// PostConfigBgpGlobalHandler sets the operation handler for the post config bgp global operation
@@ -621,12 +676,20 @@ type LoxilbRestAPIAPI struct {
 	PostConfigFdbHandler PostConfigFdbHandler
 	// PostConfigFirewallHandler sets the operation handler for the post config firewall operation
 	PostConfigFirewallHandler PostConfigFirewallHandler
+	// PostConfigFirewallImportHandler sets the operation handler for the post config firewall import operation
+	PostConfigFirewallImportHandler PostConfigFirewallImportHandler
 	// PostConfigImportHandler sets the operation handler for the post config import operation
 	PostConfigImportHandler PostConfigImportHandler
+	// PostConfigIppoolHandler sets the operation handler for the post config ippool operation
+	PostConfigIppoolHandler PostConfigIppoolHandler
+	// PostConfigIpsetHandler sets the operation handler for the post config ipset operation
+	PostConfigIpsetHandler PostConfigIpsetHandler
 	// PostConfigIpv4addressHandler sets the operation handler for the post config ipv4address operation
 	PostConfigIpv4addressHandler PostConfigIpv4addressHandler
 	// PostConfigLoadbalancerHandler sets the operation handler for the post config loadbalancer operation
 	PostConfigLoadbalancerHandler PostConfigLoadbalancerHandler
+	// PostConfigLoadbalancerBatchHandler sets the operation handler for the post config loadbalancer batch operation
+	PostConfigLoadbalancerBatchHandler PostConfigLoadbalancerBatchHandler
 	// PostConfigMetricsHandler sets the operation handler for the post config metrics operation
 	PostConfigMetricsHandler PostConfigMetricsHandler
 	// PostConfigMirrorHandler sets the operation handler for the post config mirror operation
@@ -655,6 +718,8 @@ type LoxilbRestAPIAPI struct {
 	UsersPutAuthUsersIDHandler users.PutAuthUsersIDHandler
 	// MetadataGetMetaHandler sets the operation handler for the get meta operation
 	MetadataGetMetaHandler metadata.GetMetaHandler
+	// PutConfigIpsetNameNameMembersHandler sets the operation handler for the put config ipset name name members operation
+	PutConfigIpsetNameNameMembersHandler PutConfigIpsetNameNameMembersHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -769,6 +834,12 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.DeleteConfigFirewallHandler == nil {
 		unregistered = append(unregistered, "DeleteConfigFirewallHandler")
 	}
+	if o.DeleteConfigIppoolNameNameHandler == nil {
+		unregistered = append(unregistered, "DeleteConfigIppoolNameNameHandler")
+	}
+	if o.DeleteConfigIpsetNameNameHandler == nil {
+		unregistered = append(unregistered, "DeleteConfigIpsetNameNameHandler")
+	}
 	if o.DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler == nil {
 		unregistered = append(unregistered, "DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler")
 	}
@@ -823,6 +894,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler == nil {
 		unregistered = append(unregistered, "DeleteConfigVlanVlanIDMemberIfNameTaggedTaggedHandler")
 	}
+	if o.GetAuditHandler == nil {
+		unregistered = append(unregistered, "GetAuditHandler")
+	}
 	if o.UsersGetAuthUsersHandler == nil {
 		unregistered = append(unregistered, "users.GetAuthUsersHandler")
 	}
@@ -859,6 +933,15 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.GetConfigFirewallAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigFirewallAllHandler")
 	}
+	if o.GetConfigFirewallStatsHandler == nil {
+		unregistered = append(unregistered, "GetConfigFirewallStatsHandler")
+	}
+	if o.GetConfigIppoolAllHandler == nil {
+		unregistered = append(unregistered, "GetConfigIppoolAllHandler")
+	}
+	if o.GetConfigIpsetAllHandler == nil {
+		unregistered = append(unregistered, "GetConfigIpsetAllHandler")
+	}
 	if o.GetConfigIpv4addressAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigIpv4addressAllHandler")
 	}
@@ -898,6 +981,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.GetConfigVlanAllHandler == nil {
 		unregistered = append(unregistered, "GetConfigVlanAllHandler")
 	}
+	if o.GetConfigWatchHandler == nil {
+		unregistered = append(unregistered, "GetConfigWatchHandler")
+	}
 	if o.GetLogArchivesHandler == nil {
 		unregistered = append(unregistered, "GetLogArchivesHandler")
 	}
@@ -985,6 +1071,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.UsersPostAuthUsersHandler == nil {
 		unregistered = append(unregistered, "users.PostAuthUsersHandler")
 	}
+	if o.PostConfigApplyHandler == nil {
+		unregistered = append(unregistered, "PostConfigApplyHandler")
+	}
 	if o.PostConfigBfdHandler == nil {
 		unregistered = append(unregistered, "PostConfigBfdHandler")
 	}
@@ -1021,15 +1110,27 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.PostConfigFirewallHandler == nil {
 		unregistered = append(unregistered, "PostConfigFirewallHandler")
 	}
+	if o.PostConfigFirewallImportHandler == nil {
+		unregistered = append(unregistered, "PostConfigFirewallImportHandler")
+	}
 	if o.PostConfigImportHandler == nil {
 		unregistered = append(unregistered, "PostConfigImportHandler")
 	}
+	if o.PostConfigIppoolHandler == nil {
+		unregistered = append(unregistered, "PostConfigIppoolHandler")
+	}
+	if o.PostConfigIpsetHandler == nil {
+		unregistered = append(unregistered, "PostConfigIpsetHandler")
+	}
 	if o.PostConfigIpv4addressHandler == nil {
 		unregistered = append(unregistered, "PostConfigIpv4addressHandler")
 	}
 	if o.PostConfigLoadbalancerHandler == nil {
 		unregistered = append(unregistered, "PostConfigLoadbalancerHandler")
 	}
+	if o.PostConfigLoadbalancerBatchHandler == nil {
+		unregistered = append(unregistered, "PostConfigLoadbalancerBatchHandler")
+	}
 	if o.PostConfigMetricsHandler == nil {
 		unregistered = append(unregistered, "PostConfigMetricsHandler")
 	}
@@ -1072,6 +1173,9 @@ func (o *LoxilbRestAPIAPI) Validate() error {
 	if o.MetadataGetMetaHandler == nil {
 		unregistered = append(unregistered, "metadata.GetMetaHandler")
 	}
+	if o.PutConfigIpsetNameNameMembersHandler == nil {
+		unregistered = append(unregistered, "PutConfigIpsetNameNameMembersHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1214,6 +1318,14 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/config/ippool/name/{name}"] = NewDeleteConfigIppoolNameName(o.context, o.DeleteConfigIppoolNameNameHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/config/ipset/name/{name}"] = NewDeleteConfigIpsetNameName(o.context, o.DeleteConfigIpsetNameNameHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/config/ipv4address/{ip_address}/{mask}/dev/{if_name}"] = NewDeleteConfigIpv4addressIPAddressMaskDevIfName(o.context, o.DeleteConfigIpv4addressIPAddressMaskDevIfNameHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1286,6 +1398,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audit"] = NewGetAudit(o.context, o.GetAuditHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/auth/users"] = users.NewGetAuthUsers(o.context, o.UsersGetAuthUsersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1334,6 +1450,18 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config/firewall/stats"] = NewGetConfigFirewallStats(o.context, o.GetConfigFirewallStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/ippool/all"] = NewGetConfigIppoolAll(o.context, o.GetConfigIppoolAllHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/config/ipset/all"] = NewGetConfigIpsetAll(o.context, o.GetConfigIpsetAllHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/config/ipv4address/all"] = NewGetConfigIpv4addressAll(o.context, o.GetConfigIpv4addressAllHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1386,6 +1514,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/config/watch"] = NewGetConfigWatch(o.context, o.GetConfigWatchHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/log-archives"] = NewGetLogArchives(o.context, o.GetLogArchivesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1502,6 +1634,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/apply"] = NewPostConfigApply(o.context, o.PostConfigApplyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/bfd"] = NewPostConfigBfd(o.context, o.PostConfigBfdHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1550,10 +1686,22 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/firewall/import"] = NewPostConfigFirewallImport(o.context, o.PostConfigFirewallImportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/import"] = NewPostConfigImport(o.context, o.PostConfigImportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/ippool"] = NewPostConfigIppool(o.context, o.PostConfigIppoolHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/config/ipset"] = NewPostConfigIpset(o.context, o.PostConfigIpsetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/ipv4address"] = NewPostConfigIpv4address(o.context, o.PostConfigIpv4addressHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1562,6 +1710,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/config/loadbalancer/batch"] = NewPostConfigLoadbalancerBatch(o.context, o.PostConfigLoadbalancerBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/config/metrics"] = NewPostConfigMetrics(o.context, o.PostConfigMetricsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1615,6 +1767,10 @@ func (o *LoxilbRestAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/meta"] = metadata.NewGetMeta(o.context, o.MetadataGetMetaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/config/ipset/name/{name}/members"] = NewPutConfigIpsetNameNameMembers(o.context, o.PutConfigIpsetNameNameMembersHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -17,6 +17,7 @@
 package common
 
 import (
+	"fmt"
 	"net"
 	"time"
 )
@@ -94,6 +95,9 @@ const (
 
 	// PrivateKeyName - loxilb private key name
 	PrivateKeyName = "server.key"
+
+	// ProbeScriptPath - path of the check commands of exec probes
+	ProbeScriptPath = "/opt/loxilb/probe/"
 )
 
 const (
@@ -443,6 +447,15 @@ type FwOptArg struct {
 	OnDefault bool `json:"onDefault"`
 	// Counter - Traffic counter
 	Counter string `json:"counter"`
+	// ValidFrom - Rule is installed from this time (unix seconds, 0 - now)
+	ValidFrom int64 `json:"validFrom"`
+	// ExpiresAt - Rule is removed at this time (unix seconds, 0 - never)
+	ExpiresAt int64 `json:"expiresAt"`
+	// TTL - Rule is removed these many seconds after it is installed
+	TTL uint32 `json:"ttl"`
+	// TTLExpiresAt - Time the TTL runs out (unix seconds). It is reported
+	// in get and lets a rule keep its expiry when it is added back
+	TTLExpiresAt int64 `json:"ttlExpiresAt,omitempty"`
 }
 
 // FwRuleArg - Information related to firewall rule
@@ -465,6 +478,22 @@ type FwRuleArg struct {
 	InPort string `json:"portName"`
 	// Pref - User preference for ordering
 	Pref uint32 `json:"preference"`
+	// SrcIPSet - Name of an IP set of source prefixes (unsupported by datapath)
+	SrcIPSet string `json:"sourceIPSet"`
+	// DstIPSet - Name of an IP set of destination prefixes (unsupported by datapath)
+	DstIPSet string `json:"destinationIPSet"`
+}
+
+// FwRuleStats - Traffic statistics of a firewall entry
+type FwRuleStats struct {
+	// Packets - Packets which matched the rule
+	Packets uint64 `json:"packets"`
+	// Bytes - Bytes which matched the rule
+	Bytes uint64 `json:"bytes"`
+	// LastHit - Time the rule was last seen matching (unix seconds, 0 - never)
+	LastHit int64 `json:"lastHit"`
+	// Since - Time the rule started matching traffic (unix seconds)
+	Since int64 `json:"since"`
 }
 
 // FwRuleMod - Info related to a firewall entry
@@ -473,6 +502,8 @@ type FwRuleMod struct {
 	Rule FwRuleArg `json:"ruleArguments"`
 	// Opts - firewall options
 	Opts FwOptArg `json:"opts"`
+	// Stats - firewall statistics
+	Stats FwRuleStats `json:"stats"`
 }
 
 // EndPointMod - Info related to a end-point entry
@@ -484,7 +515,10 @@ type EndPointMod struct {
 	// InActTries - No. of inactive probes to mark
 	// an end-point inactive
 	InActTries int `json:"inactiveReTries"`
-	// ProbeType - Type of probe : "icmp","connect-tcp", "connect-udp", "connect-sctp", "http", "https"
+	// ActTries - No. of consecutive successful probes to mark
+	// an inactive end-point active again
+	ActTries int `json:"activeReTries"`
+	// ProbeType - Type of probe : "icmp","connect-tcp", "connect-udp", "connect-sctp", "http", "https", "grpc", "exec"
 	ProbeType string `json:"probeType"`
 	// ProbeReq - Request string in case of http probe
 	ProbeReq string `json:"probeReq"`
@@ -494,6 +528,43 @@ type EndPointMod struct {
 	ProbeDuration uint32 `json:"probeDuration"`
 	// ProbePort - Port to probe for connect type
 	ProbePort uint16 `json:"probePort"`
+	// ProbeBackoffMax - Max duration (in seconds) between probes of an inactive
+	// end-point. The duration doubles on each failed probe till this max
+	ProbeBackoffMax uint32 `json:"probeBackoffMax"`
+	// ProbeJitter - Random jitter (in percent, max 50) of the probe duration
+	ProbeJitter uint8 `json:"probeJitter"`
+	// ProbeTimeout - Timeout (in seconds) of a http, grpc or exec probe
+	ProbeTimeout uint32 `json:"probeTimeout"`
+	// ProbeMethod - Method of a http probe, GET if empty
+	ProbeMethod string `json:"probeMethod"`
+	// ProbeHost - Host header of a http probe and TLS server name (SNI) of a
+	// https or grpc probe
+	ProbeHost string `json:"probeHost"`
+	// ProbeHeaders - Extra headers of a http probe as "Name: value"
+	ProbeHeaders []string `json:"probeHeaders"`
+	// ProbeStatus - Status codes accepted by a http probe e.g "200-399" or
+	// "200,204". If empty, redirects are followed and only 200 is accepted
+	ProbeStatus string `json:"probeStatus"`
+	// ProbeBodyRegex - Regex the response body of a http probe has to match
+	ProbeBodyRegex string `json:"probeBodyRegex"`
+	// ProbeJSONPath - Path of a value in the json response body of a http probe
+	// e.g "status" or "checks[0].state"
+	ProbeJSONPath string `json:"probeJsonPath"`
+	// ProbeJSONValue - Expected value at ProbeJSONPath. The path only has to
+	// exist if empty
+	ProbeJSONValue string `json:"probeJsonValue"`
+	// ProbeGRPCService - Service checked by a grpc health probe. The overall
+	// health of the server is checked if empty
+	ProbeGRPCService string `json:"probeGrpcService"`
+	// ProbeTLS - Use TLS for a grpc health probe
+	ProbeTLS bool `json:"probeTls"`
+	// ProbeExec - Check command of an exec probe and its arguments. The command
+	// has to be in ProbeScriptPath. The end-point ip and port are passed as last
+	// arguments and as LOXILB_EP_IP/LOXILB_EP_PORT environment variables
+	ProbeExec string `json:"probeExec"`
+	// ProbeExecState - Set the host state from the first line of the stdout
+	// of an exec probe (green, yellow or red)
+	ProbeExecState bool `json:"probeExecState"`
 	// MinDelay - Minimum delay in this end-point
 	MinDelay string `json:"minDelay"`
 	// AvgDelay - Average delay in this end-point
@@ -545,6 +616,16 @@ const (
 	LbSelN2
 	// LbSelN3 - select client based on N3 interface
 	LbSelN3
+	// LbSelMaglev - select the lb end-points based on maglev consistent hashing.
+	// The lookup table has only 13 slots, so at most 4 end-points are allowed,
+	// each getting 3 or 4 slots irrespective of its weight
+	LbSelMaglev
+	// LbSelWeightedLeastConnections - select the lb end-points based on active
+	// connections relative to their weights
+	LbSelWeightedLeastConnections
+	// LbSelLeastResponseTime - select the lb end-points based on probe response
+	// times relative to their weights, needs end-point monitoring
+	LbSelLeastResponseTime
 )
 
 // LBMode - Variable to define LB mode
@@ -591,7 +672,8 @@ const (
 
 // LbServiceArg - Information related to load-balancer service
 type LbServiceArg struct {
-	// ServIP - the service ip or vip  of the load-balancer rule
+	// ServIP - the service ip or vip  of the load-balancer rule. If empty or
+	// set to an IP pool name, a vip is allocated from that pool (or IPPoolDefault)
 	ServIP string `json:"externalIP"`
 	// PrivateIP - the private service ip or vip of the load-balancer rule
 	PrivateIP string `json:"privateIP"`
@@ -623,7 +705,7 @@ type LbServiceArg struct {
 	ProbeType string `json:"probetype"`
 	// ProbePort - Liveness check port number. Only valid for tcp, udp, sctp, http(s)
 	ProbePort uint16 `json:"probeport"`
-	// ProbeReq - Request string for liveness check
+	// ProbeReq - Request string for liveness check or check command of exec probes
 	ProbeReq string `json:"probereq"`
 	// ProbeResp - Response string for liveness check
 	ProbeResp string `json:"proberesp"`
@@ -631,6 +713,22 @@ type LbServiceArg struct {
 	ProbeTimeout uint32 `json:"probeTimeout"`
 	// ProbeRetries - Probe Retries
 	ProbeRetries int `json:"probeRetries"`
+	// ProbeRise - No. of consecutive successful probes before an end-point
+	// is marked active again
+	ProbeRise int `json:"probeRise"`
+	// SlowStart - Window in seconds over which the weight of a recovered
+	// end-point is ramped up to its full weight (weighted selection modes)
+	SlowStart uint32 `json:"slowStart"`
+	// OutlierConsecErrors - Consecutive connection failures after which an end-point
+	// is ejected by passive outlier detection (0 - disabled)
+	OutlierConsecErrors int `json:"outlierConsecutiveErrors"`
+	// OutlierErrorRate - Connection failure percentage after which an end-point
+	// is ejected by passive outlier detection (0 - disabled)
+	OutlierErrorRate uint8 `json:"outlierErrorRate"`
+	// OutlierEjectTime - Base ejection time in seconds, doubled on each ejection
+	OutlierEjectTime uint32 `json:"outlierEjectTime"`
+	// OutlierMaxEjectPercent - Max percentage of end-points ejected at once
+	OutlierMaxEjectPercent uint8 `json:"outlierMaxEjectPercent"`
 	// Name - Service name
 	Name string `json:"name"`
 	// PersistTimeout - Persistence timeout in seconds
@@ -670,6 +768,15 @@ type LbSecIPArg struct {
 type LbAllowedSrcIPArg struct {
 	// Prefix - Allowed Prefix
 	Prefix string `json:"prefix"`
+	// ValidFrom - Source is allowed from this time (unix seconds, 0 - now)
+	ValidFrom int64 `json:"validFrom"`
+	// ExpiresAt - Source is removed at this time (unix seconds, 0 - never)
+	ExpiresAt int64 `json:"expiresAt"`
+	// TTL - Source is removed these many seconds after it is allowed
+	TTL uint32 `json:"ttl"`
+	// TTLExpiresAt - Time the TTL runs out (unix seconds). It is reported
+	// in get and lets a source keep its expiry when it is added back
+	TTLExpiresAt int64 `json:"ttlExpiresAt,omitempty"`
 }
 
 // LbRuleMod - Info related to a load-balancer entry
@@ -684,6 +791,142 @@ type LbRuleMod struct {
 	Eps []LbEndPointArg `json:"endpoints"`
 }
 
+// LB rule batch operations
+const (
+	LBBatchOpAdd = "add"
+	LBBatchOpDel = "delete"
+)
+
+// LB rule batch item status
+const (
+	LBBatchApplied    = "applied"
+	LBBatchFailed     = "failed"
+	LBBatchRolledBack = "rolled-back"
+	LBBatchSkipped    = "skipped"
+)
+
+// LbRuleBatchOp - a single operation of a load-balancer rule batch
+type LbRuleBatchOp struct {
+	// Oper - LBBatchOpAdd or LBBatchOpDel
+	Oper string `json:"oper"`
+	// Rule - load-balancer rule to add or delete
+	Rule LbRuleMod `json:"rule"`
+}
+
+// LbRuleBatchResult - outcome of a single operation of a load-balancer rule batch
+type LbRuleBatchResult struct {
+	// Index - position of the operation in the batch
+	Index int `json:"index"`
+	// Oper - LBBatchOpAdd or LBBatchOpDel
+	Oper string `json:"oper"`
+	// Status - one of LBBatchApplied, LBBatchFailed, LBBatchRolledBack or LBBatchSkipped
+	Status string `json:"status"`
+	// Code - error code of a failed operation
+	Code int `json:"code,omitempty"`
+	// Error - error message of a failed operation
+	Error string `json:"error,omitempty"`
+}
+
+// LB outlier detection defaults
+const (
+	LbDflOutlierEjectTime   = 30 // Default base ejection time
+	LbDflOutlierMaxEjectPct = 10 // Default max percent of ejected end-points
+)
+
+// LbServOutlierNormalize - fill in the defaults of the outlier detection
+// settings of a LB service. The settings are cleared when outlier detection
+// is not enabled i.e. neither consecutive errors nor an error rate is set
+func LbServOutlierNormalize(serv *LbServiceArg) {
+	if serv.OutlierConsecErrors == 0 && serv.OutlierErrorRate == 0 {
+		serv.OutlierEjectTime = 0
+		serv.OutlierMaxEjectPercent = 0
+		return
+	}
+	if serv.OutlierEjectTime == 0 {
+		serv.OutlierEjectTime = LbDflOutlierEjectTime
+	}
+	if serv.OutlierMaxEjectPercent == 0 {
+		serv.OutlierMaxEjectPercent = LbDflOutlierMaxEjectPct
+	}
+}
+
+// LbServKey - key of a LB service in watch events, the config store and
+// declarative config apply
+func LbServKey(serv LbServiceArg) string {
+	portMax := serv.ServPortMax
+	if portMax == 0 {
+		portMax = serv.ServPort
+	}
+	key := fmt.Sprintf("%s:%d-%d/%s", serv.ServIP, serv.ServPort, portMax, serv.Proto)
+	if serv.BlockNum != 0 {
+		key += fmt.Sprintf("/block%d", serv.BlockNum)
+	}
+	if serv.HostUrl != "" {
+		key += "/" + serv.HostUrl
+	}
+	return key
+}
+
+// LbServOwnerKey - key identifying the LB service which owns an address
+// allocated from an IPAM pool. Services with the same name share an address.
+// Unnamed services are identified by their protocol, port range, block and url path
+func LbServOwnerKey(serv *LbServiceArg) string {
+	if serv.Name != "" {
+		return serv.Name
+	}
+	portMax := serv.ServPortMax
+	if portMax == 0 {
+		portMax = serv.ServPort
+	}
+	return fmt.Sprintf("%s:%d-%d:%d:%s", serv.Proto, serv.ServPort, portMax, serv.BlockNum, serv.HostUrl)
+}
+
+// IPPoolDefault - pool used for allocation when a LB rule has no ServIP
+const IPPoolDefault = "default"
+
+// IPPoolMod - Info related to an IPAM pool
+type IPPoolMod struct {
+	// Name - name of the pool
+	Name string `json:"name"`
+	// CIDR - IPv4 or IPv6 subnet the pool hands out addresses from
+	CIDR string `json:"cidr"`
+}
+
+// IPPoolAllocMod - Info related to an address allocated from an IPAM pool
+type IPPoolAllocMod struct {
+	// IP - allocated address
+	IP string `json:"ip"`
+	// Owner - service name or service tuple this address is bound to
+	Owner string `json:"owner"`
+	// Rules - number of LB rules using this address
+	Rules int `json:"rules"`
+}
+
+// IPPoolGetMod - Info related to an IPAM pool and its allocations
+type IPPoolGetMod struct {
+	IPPoolMod
+	// Allocs - addresses currently allocated from this pool
+	Allocs []IPPoolAllocMod `json:"allocations"`
+}
+
+// IPSetMod - Info related to a named IP set
+type IPSetMod struct {
+	// Name - name of the set referred to by firewall rules and LB allowed sources
+	Name string `json:"name"`
+	// Members - IPv4 or IPv6 prefixes (or addresses) of the set
+	Members []string `json:"members"`
+}
+
+// IPSetMemberMod - Info related to a change of members of an IP set
+type IPSetMemberMod struct {
+	// Name - name of the set
+	Name string `json:"name"`
+	// Add - prefixes to be added to the set
+	Add []string `json:"add"`
+	// Remove - prefixes to be removed from the set
+	Remove []string `json:"remove"`
+}
+
 // CtInfo - Conntrack Information
 type CtInfo struct {
 	// Dip - destination ip address
@@ -928,6 +1171,8 @@ type HASMod struct {
 	State string `json:"haState"`
 	// Vip - Instance virtual IP address
 	Vip net.IP `json:"Addr"`
+	// CloudErr - last failed cloud hook operation, if any
+	CloudErr string `json:"cloudError,omitempty"`
 }
 
 // BFDMod - information related to a BFD session
@@ -1087,6 +1332,49 @@ type User struct {
 	Role string `json:"role"`
 }
 
+// Watch event kinds
+const (
+	WatchKindLB       = "loadbalancer"
+	WatchKindEndPoint = "endpoint"
+	WatchKindHAState  = "hastate"
+	WatchKindBGPNeigh = "bgpneighbor"
+	WatchKindPort     = "port"
+)
+
+// Watch event types
+const (
+	WatchEvAdded    = "added"
+	WatchEvModified = "modified"
+	WatchEvDeleted  = "deleted"
+)
+
+// WatchEvent - a change event streamed to watchers
+type WatchEvent struct {
+	// Version - resource version of the event. Versions increase by one
+	// with every event and can be used to resume a watch
+	Version uint64 `json:"resourceVersion"`
+	// Kind - kind of the changed object
+	Kind string `json:"kind"`
+	// Type - type of the change
+	Type string `json:"type"`
+	// Key - key identifying the changed object
+	Key string `json:"key"`
+	// Time - time of the change
+	Time time.Time `json:"time"`
+	// Object - state of the object after the change
+	Object interface{} `json:"object,omitempty"`
+}
+
+// PortStateMod - port up/down state carried in watch events
+type PortStateMod struct {
+	// Name - name of the port
+	Name string `json:"portName"`
+	// Link - lowerlayer state
+	Link bool `json:"link"`
+	// State - administrative state
+	State bool `json:"state"`
+}
+
 // NetHookInterface - Go interface which needs to be implemented to talk to loxinet module
 type NetHookInterface interface {
 	NetMirrorGet() ([]MirrGetMod, error)
@@ -1114,6 +1402,15 @@ type NetHookInterface interface {
 	NetLbRuleAdd(*LbRuleMod) (int, error)
 	NetLbRuleDel(*LbRuleMod) (int, error)
 	NetLbRuleGet() ([]LbRuleMod, error)
+	NetLbRuleBatch([]LbRuleBatchOp) ([]LbRuleBatchResult, error)
+	NetWatch([]string, uint64) (<-chan WatchEvent, func(), error)
+	NetIPPoolAdd(*IPPoolMod) (int, error)
+	NetIPPoolDel(*IPPoolMod) (int, error)
+	NetIPPoolGet() ([]IPPoolGetMod, error)
+	NetIPSetAdd(*IPSetMod) (int, error)
+	NetIPSetDel(*IPSetMod) (int, error)
+	NetIPSetMemberMod(*IPSetMemberMod) (int, error)
+	NetIPSetGet() ([]IPSetMod, error)
 	NetCtInfoGet() ([]CtInfo, error)
 	NetSessionGet() ([]SessionMod, error)
 	NetSessionUlClGet() ([]SessionUlClMod, error)
@@ -1129,6 +1426,7 @@ type NetHookInterface interface {
 	NetFwRuleAdd(*FwRuleMod) (int, error)
 	NetFwRuleDel(*FwRuleMod) (int, error)
 	NetFwRuleGet() ([]FwRuleMod, error)
+	NetFwRuleStatsGet(idle uint32) ([]FwRuleMod, error)
 	NetEpHostAdd(fm *EndPointMod) (int, error)
 	NetEpHostDel(fm *EndPointMod) (int, error)
 	NetEpHostGet() ([]EndPointMod, error)
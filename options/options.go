@@ -20,6 +20,7 @@ var Opts struct {
 	TLSPort              int            `long:"tls-port" description:"the port to listen on for secure connections" default:"8091" env:"TLS_PORT"`
 	TLSCertificate       flags.Filename `long:"tls-certificate" description:"the certificate to use for secure connections" default:"/opt/loxilb/cert/server.crt" env:"TLS_CERTIFICATE"`
 	TLSCertificateKey    flags.Filename `long:"tls-key" description:"the private key to use for secure connections" default:"/opt/loxilb/cert/server.key" env:"TLS_PRIVATE_KEY"`
+	GRPCPort             int            `long:"grpc-port" description:"the port to listen on for the gRPC management api (0 disables it)" default:"0" env:"GRPC_PORT"`
 	ClusterNodes         string         `long:"cluster" description:"Comma-separated list of cluter-node IP Addresses" default:"none"`
 	ClusterSelf          int            `long:"self" description:"annonation of self in cluster" default:"0"`
 	LogLevel             string         `long:"loglevel" description:"One of trace,debug,info,error,warning,notice,critical,emergency,alert" default:"debug"`
@@ -27,6 +28,7 @@ var Opts struct {
 	LogMaxBackups        int            `long:"log-max-backups" description:"Rotated files to keep per log, oldest deleted first (0 keeps all until log-max-age)" default:"4" env:"LOXILB_LOG_MAX_BACKUPS"`
 	LogMaxAge            int            `long:"log-max-age" description:"Days to retain rotated log files (0 keeps forever)" default:"28" env:"LOXILB_LOG_MAX_AGE"`
 	LogNoCompress        bool           `long:"log-no-compress" description:"Do not gzip rotated log files" env:"LOXILB_LOG_NO_COMPRESS"`
+	AuditLog             string         `long:"auditlog" description:"Audit log of config changes made through the api (empty disables auditing)" default:"/var/log/loxilb/audit.log"`
 	CPUProfile           string         `long:"cpuprofile" description:"Enable cpu profiling and specify file to use" default:"none" env:"CPUPROF"`
 	Prometheus           bool           `short:"p" long:"prometheus" description:"Run prometheus thread"`
 	CRC32SumDisable      bool           `long:"disable-crc32" description:"Disable crc32 checksum update(experimental)"`
@@ -41,14 +43,20 @@ var Opts struct {
 	FallBack             bool           `long:"fallback" description:"Fallback to system default networking(experimental)"`
 	LocalSockPolicy      bool           `long:"localsockpolicy" description:"support local socket policies (experimental)"`
 	SockMapSupport       bool           `long:"sockmapsupport" description:"Support sockmap based L4 proxying (experimental)"`
-	Cloud                string         `long:"cloud" description:"cloud type if any e.g aws,ncloud" default:"on-prem"`
+	Cloud                string         `long:"cloud" description:"cloud type if any e.g aws,ncloud,webhook" default:"on-prem"`
 	CloudCIDRBlock       string         `long:"cloudcidrblock" description:"cloud implementations need VIP cidr blocks(experimental)"`
 	CloudInstance        string         `long:"cloudinstance" description:"instance-name to distinguish instance sets running in a same cloud-region"`
+	CloudWebhookURL      string         `long:"cloudwebhook-url" description:"URL of the external webhook used by cloud type webhook"`
+	CloudWebhookSecret   string         `long:"cloudwebhook-secret" description:"Secret used to sign cloud webhook callouts" env:"CLOUD_WEBHOOK_SECRET"`
+	CloudWebhookRetries  int            `long:"cloudwebhook-retries" description:"Number of retries for a failed cloud webhook callout" default:"3"`
+	CloudWebhookTimeout  int            `long:"cloudwebhook-timeout" description:"Timeout in seconds of a cloud webhook callout" default:"5"`
 	ConfigPath           string         `long:"config-path" description:"Config file path" default:"/etc/loxilb/"`
+	ConfigStore          bool           `long:"config-store" description:"Persist accepted config in a journaled store under config-path and replay it on boot, instead of the legacy config files"`
 	ProxyModeOnly        bool           `long:"proxyonlymode" description:"Run loxilb in proxy mode only, no Datapath"`
 	WhiteList            string         `long:"whitelist" description:"Regex string of whitelisted interface(experimental)" default:"none"`
 	ClusterInterface     string         `long:"clusterinterface" description:"cluster interface for egress HA" default:""`
 	UserServiceEnable    bool           `long:"userservice" description:"Enable user service for loxilb"`
+	RBACConfig           string         `long:"rbacconfig" description:"Path of a JSON file with custom roles and roles of oauth2 users"`
 	DatabaseHost         string         `long:"databasehost" description:"Database host" default:"127.0.0.1"`
 	DatabasePort         int            `long:"databaseport" description:"Database port" default:"3306"`
 	DatabaseUser         string         `long:"databaseuser" description:"Database user" default:"root"`
@@ -72,8 +80,14 @@ var Opts struct {
 	Oauth2GithubRedirectURL  string `long:"oauth2github-redirecturl" description:"Oauth2 github redirect url" env:"OAUTH2_GITHUB_REDIRECT_URL"`
 }
 
-// ValidateOpts checks if the required environment variables are set when Oauth2Enable is true
+// ValidateOpts checks if the required options and environment variables are set
+// for the enabled features
 func ValidateOpts() error {
+	// Webhook cloud type needs somewhere to call out to
+	if Opts.Cloud == "webhook" && Opts.CloudWebhookURL == "" {
+		return fmt.Errorf("cloud webhook url is required but not set")
+	}
+
 	// Check if Oauth2Enable is true
 	if Opts.Oauth2Enable {
 		// Split the Oauth2Provider string into a slice of providers
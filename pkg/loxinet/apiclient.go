@@ -18,9 +18,11 @@ package loxinet
 
 import (
 	"errors"
+	"net"
 	"time"
 
 	cmn "github.com/loxilb-io/loxilb/common"
+	utils "github.com/loxilb-io/loxilb/pkg/utils"
 	tk "github.com/loxilb-io/loxilib"
 )
 
@@ -52,6 +54,9 @@ func (*NetAPIStruct) NetMirrorAdd(mm *cmn.MirrMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Mirrs.MirrAdd(mm.Ident, mm.Info, mm.Target)
+	if err == nil {
+		storePut(storeKindMirr, mm.Ident, mm)
+	}
 	return ret, err
 }
 
@@ -61,6 +66,9 @@ func (*NetAPIStruct) NetMirrorDel(mm *cmn.MirrMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Mirrs.MirrDelete(mm.Ident)
+	if err == nil {
+		storeDel(storeKindMirr, mm.Ident)
+	}
 	return ret, err
 }
 
@@ -281,6 +289,7 @@ func (na *NetAPIStruct) NetRouteAdd(rm *cmn.RouteMod) (int, error) {
 	var ret int
 	var err error
 	var cloudPrivateInterfaceID int
+	var cloudPrivateGw net.IP
 
 	if len(rm.GWs) <= 0 {
 		return RtNhErr, errors.New("invalid gws")
@@ -290,6 +299,7 @@ func (na *NetAPIStruct) NetRouteAdd(rm *cmn.RouteMod) (int, error) {
 	}
 	if mh.cloudHook != nil {
 		cloudPrivateInterfaceID, _ = mh.cloudHook.CloudGetPrivateInterfaceID()
+		cloudPrivateGw, _ = mh.cloudHook.CloudGetPrivateGateway()
 	}
 	intfRt := false
 	mlen, _ := rm.Dst.Mask.Size()
@@ -309,10 +319,9 @@ func (na *NetAPIStruct) NetRouteAdd(rm *cmn.RouteMod) (int, error) {
 		for _, gw := range rm.GWs {
 			linkIndex := gw.LinkIndex
 			cloudGwIp := gw.Gw
-			if rm.Dst.String() == "0.0.0.0/0" && cloudPrivateInterfaceID > 0 {
+			if rm.Dst.String() == "0.0.0.0/0" && cloudPrivateInterfaceID > 0 && cloudPrivateGw != nil {
 				linkIndex = cloudPrivateInterfaceID
-				cloudGwIp = awsCIDRnet.IP.Mask(awsCIDRnet.Mask)
-				cloudGwIp[3]++
+				cloudGwIp = cloudPrivateGw
 			}
 			na = append(na, RtNhAttr{cloudGwIp, linkIndex})
 		}
@@ -345,9 +354,13 @@ func (na *NetAPIStruct) NetLbRuleAdd(lm *cmn.LbRuleMod) (int, error) {
 	defer mh.mtx.Unlock()
 	var ips []string
 	ret, err := mh.zr.Rules.AddLbRule(lm.Serv, lm.SecIPs[:], lm.SrcIPs[:], lm.Eps[:])
+	if err == nil {
+		storeLbRule(lm.Serv)
+	}
 	if err == nil && lm.Serv.Bgp {
 		if mh.bgp != nil {
-			ips = append(ips, lm.Serv.ServIP)
+			vip, _ := mh.zr.Ipam.IpamServIPResolve(&lm.Serv, false)
+			ips = append(ips, vip)
 			for _, ip := range lm.SecIPs {
 				ips = append(ips, ip.SecIP)
 			}
@@ -367,11 +380,18 @@ func (na *NetAPIStruct) NetLbRuleDel(lm *cmn.LbRuleMod) (int, error) {
 	mh.mtx.Lock()
 	defer mh.mtx.Unlock()
 
-	ips := mh.zr.Rules.GetLBRuleSecIPs(lm.Serv)
-	ret, err := mh.zr.Rules.DeleteLbRule(lm.Serv)
+	serv := lm.Serv
+	if vip, err := mh.zr.Ipam.IpamServIPResolve(&serv, false); err == nil {
+		serv.ServIP = vip
+	}
+	ips := mh.zr.Rules.GetLBRuleSecIPs(serv)
+	ret, err := mh.zr.Rules.DeleteLbRule(serv)
+	if err == nil {
+		storeLbRule(serv)
+	}
 	if lm.Serv.Bgp {
 		if mh.bgp != nil {
-			ips = append(ips, lm.Serv.ServIP)
+			ips = append(ips, serv.ServIP)
 			mh.bgp.DelBGPRule(cmn.CIDefault, ips)
 		} else {
 			tk.LogIt(tk.LogDebug, "loxilb BGP mode is disabled \n")
@@ -389,6 +409,161 @@ func (na *NetAPIStruct) NetLbRuleGet() ([]cmn.LbRuleMod, error) {
 	return ret, err
 }
 
+// NetLbRuleBatch - Apply a batch of load-balancer rule operations in loxinet
+func (na *NetAPIStruct) NetLbRuleBatch(ops []cmn.LbRuleBatchOp) ([]cmn.LbRuleBatchResult, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	// VIPs and BGP advertised IPs of rules to be deleted need to be known beforehand
+	servs := make([]cmn.LbServiceArg, len(ops))
+	delIPs := make(map[int][]string)
+	for i, op := range ops {
+		servs[i] = mh.zr.Rules.lbBatchServ(op.Rule.Serv)
+		if op.Oper == cmn.LBBatchOpDel && op.Rule.Serv.Bgp {
+			delIPs[i] = append(mh.zr.Rules.GetLBRuleSecIPs(servs[i]), servs[i].ServIP)
+		}
+	}
+
+	res, _, err := mh.zr.Rules.LbRuleBatch(ops)
+	if err != nil {
+		return res, err
+	}
+	for i := range servs {
+		storeLbRule(servs[i])
+	}
+	if mh.bgp == nil {
+		return res, nil
+	}
+
+	for i, op := range ops {
+		if !op.Rule.Serv.Bgp {
+			continue
+		}
+		if op.Oper == cmn.LBBatchOpAdd {
+			ips := []string{mh.zr.Rules.lbBatchServ(op.Rule.Serv).ServIP}
+			for _, ip := range op.Rule.SecIPs {
+				ips = append(ips, ip.SecIP)
+			}
+			mh.bgp.AddBGPRule(cmn.CIDefault, ips)
+		} else {
+			mh.bgp.DelBGPRule(cmn.CIDefault, delIPs[i])
+		}
+	}
+	return res, nil
+}
+
+// NetWatch - Watch change events of loxinet
+func (na *NetAPIStruct) NetWatch(kinds []string, since uint64) (<-chan cmn.WatchEvent, func(), error) {
+	if mh.watch == nil {
+		return nil, nil, errors.New("watch not initialized")
+	}
+	return mh.watch.WatchSubscribe(kinds, since)
+}
+
+// NetIPPoolAdd - Add an IPAM pool in loxinet
+func (na *NetAPIStruct) NetIPPoolAdd(pm *cmn.IPPoolMod) (int, error) {
+	if na.BgpPeerMode {
+		return IpamErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.IPPoolAdd(*pm)
+	if err == nil {
+		storePut(storeKindIPPool, pm.Name, pm)
+	}
+	return ret, err
+}
+
+// NetIPPoolDel - Delete an IPAM pool in loxinet
+func (na *NetAPIStruct) NetIPPoolDel(pm *cmn.IPPoolMod) (int, error) {
+	if na.BgpPeerMode {
+		return IpamErrBase, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.IPPoolDelete(*pm)
+	if err == nil {
+		storeDel(storeKindIPPool, pm.Name)
+	}
+	return ret, err
+}
+
+// NetIPPoolGet - Get IPAM pools from loxinet
+func (na *NetAPIStruct) NetIPPoolGet() ([]cmn.IPPoolGetMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Ipam.IPPoolGet()
+	return ret, err
+}
+
+// NetIPSetAdd - Add or replace an IP set in loxinet
+func (na *NetAPIStruct) NetIPSetAdd(im *cmn.IPSetMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetArgsErr, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetAdd(im.Name, im.Members)
+	if err == nil {
+		set, _ := mh.zr.IPSets.IPSetGet(im.Name)
+		storePut(storeKindIPSet, im.Name, set)
+	}
+	return ret, err
+}
+
+// NetIPSetDel - Delete an IP set in loxinet
+func (na *NetAPIStruct) NetIPSetDel(im *cmn.IPSetMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetArgsErr, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetDelete(im.Name)
+	if err == nil {
+		storeDel(storeKindIPSet, im.Name)
+	}
+	return ret, err
+}
+
+// NetIPSetMemberMod - Add and remove members of an IP set in loxinet
+func (na *NetAPIStruct) NetIPSetMemberMod(im *cmn.IPSetMemberMod) (int, error) {
+	if na.BgpPeerMode {
+		return IPSetArgsErr, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetMemberMod(im.Name, im.Add, im.Remove)
+	if err == nil {
+		set, _ := mh.zr.IPSets.IPSetGet(im.Name)
+		storePut(storeKindIPSet, im.Name, set)
+	}
+	return ret, err
+}
+
+// NetIPSetGet - Get all IP sets in loxinet
+func (na *NetAPIStruct) NetIPSetGet() ([]cmn.IPSetMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.IPSets.IPSetGetAll()
+	return ret, err
+}
+
 // NetCtInfoGet - Get connection track info from loxinet
 func (na *NetAPIStruct) NetCtInfoGet() ([]cmn.CtInfo, error) {
 	if na.BgpPeerMode {
@@ -408,6 +583,9 @@ func (na *NetAPIStruct) NetSessionAdd(sm *cmn.SessionMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.SessAdd(sm.Ident, sm.IP, sm.AnTun, sm.CnTun)
+	if err == nil {
+		storePut(storeKindSess, sm.Ident, sm)
+	}
 	return ret, err
 }
 
@@ -420,6 +598,9 @@ func (na *NetAPIStruct) NetSessionDel(sm *cmn.SessionMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.SessDelete(sm.Ident)
+	if err == nil {
+		storeDel(storeKindSess, sm.Ident)
+	}
 	return ret, err
 }
 
@@ -432,6 +613,9 @@ func (na *NetAPIStruct) NetSessionUlClAdd(sr *cmn.SessionUlClMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.UlClAddCls(sr.Ident, sr.Args)
+	if err == nil {
+		storePut(storeKindUlCl, storeUlClKey(sr), sr)
+	}
 	return ret, err
 }
 
@@ -444,6 +628,9 @@ func (na *NetAPIStruct) NetSessionUlClDel(sr *cmn.SessionUlClMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Sess.UlClDeleteCls(sr.Ident, sr.Args)
+	if err == nil {
+		storeDel(storeKindUlCl, storeUlClKey(sr))
+	}
 	return ret, err
 }
 
@@ -486,6 +673,9 @@ func (na *NetAPIStruct) NetPolicerAdd(pm *cmn.PolMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Pols.PolAdd(pm.Ident, pm.Info, pm.Target)
+	if err == nil {
+		storePut(storeKindPol, pm.Ident, pm)
+	}
 	return ret, err
 }
 
@@ -498,6 +688,9 @@ func (na *NetAPIStruct) NetPolicerDel(pm *cmn.PolMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Pols.PolDelete(pm.Ident)
+	if err == nil {
+		storeDel(storeKindPol, pm.Ident)
+	}
 	return ret, err
 }
 
@@ -555,6 +748,7 @@ func (na *NetAPIStruct) NetBFDAdd(bm *cmn.BFDMod) (int, error) {
 	if err != nil {
 		return -1, err
 	}
+	storePut(storeKindBFD, storeBFDKey(bm), bm)
 
 	return 0, nil
 }
@@ -571,6 +765,7 @@ func (na *NetAPIStruct) NetBFDDel(bm *cmn.BFDMod) (int, error) {
 	if err != nil {
 		return -1, err
 	}
+	storeDel(storeKindBFD, storeBFDKey(bm))
 
 	return 0, nil
 }
@@ -583,7 +778,20 @@ func (na *NetAPIStruct) NetFwRuleAdd(fm *cmn.FwRuleMod) (int, error) {
 	mh.mtx.Lock()
 	defer mh.mtx.Unlock()
 
-	ret, err := mh.zr.Rules.AddFwRule(fm.Rule, fm.Opts)
+	// The time a TTL runs out is stored so that it is not restarted on replay
+	opts := fm.Opts
+	if opts.TTL > 0 && opts.TTLExpiresAt == 0 {
+		opts.TTLExpiresAt = schedTTLExpiry(opts.ValidFrom, opts.TTL)
+	}
+
+	ret, err := mh.zr.Rules.AddFwRule(fm.Rule, opts)
+	if err == nil {
+		sfm := *fm
+		sfm.Opts = opts
+		sfm.Opts.Counter = ""
+		sfm.Stats = cmn.FwRuleStats{}
+		storePut(storeKindFw, storeFwKey(&fm.Rule), &sfm)
+	}
 	return ret, err
 }
 
@@ -596,6 +804,9 @@ func (na *NetAPIStruct) NetFwRuleDel(fm *cmn.FwRuleMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Rules.DeleteFwRule(fm.Rule)
+	if err == nil {
+		storeDel(storeKindFw, storeFwKey(&fm.Rule))
+	}
 	return ret, err
 }
 
@@ -608,6 +819,19 @@ func (na *NetAPIStruct) NetFwRuleGet() ([]cmn.FwRuleMod, error) {
 	return ret, err
 }
 
+// NetFwRuleStatsGet - Get statistics of firewall rules in loxinet. If idle is
+// given, only the rules which had no hits for idle seconds are returned
+func (na *NetAPIStruct) NetFwRuleStatsGet(idle uint32) ([]cmn.FwRuleMod, error) {
+	if na.BgpPeerMode {
+		return nil, errors.New("running in bgp only mode")
+	}
+	mh.mtx.Lock()
+	defer mh.mtx.Unlock()
+
+	ret, err := mh.zr.Rules.GetFwRuleStats(idle)
+	return ret, err
+}
+
 // NetEpHostAdd - Add a LB end-point in loxinet
 func (na *NetAPIStruct) NetEpHostAdd(em *cmn.EndPointMod) (int, error) {
 	if na.BgpPeerMode {
@@ -616,11 +840,20 @@ func (na *NetAPIStruct) NetEpHostAdd(em *cmn.EndPointMod) (int, error) {
 	mh.mtx.Lock()
 	defer mh.mtx.Unlock()
 
-	epArgs := epHostOpts{inActTryThr: em.InActTries, probeType: em.ProbeType,
+	epArgs := epHostOpts{inActTryThr: em.InActTries, actTryThr: em.ActTries, probeType: em.ProbeType,
 		probeReq: em.ProbeReq, probeResp: em.ProbeResp,
 		probeDuration: em.ProbeDuration, probePort: em.ProbePort,
+		probeTimeout: em.ProbeTimeout, probeBackoffMax: em.ProbeBackoffMax, probeJitter: em.ProbeJitter,
+		probeHTTP: utils.HTTPProbeSpec{Method: em.ProbeMethod, Host: em.ProbeHost,
+			Headers: em.ProbeHeaders, Status: em.ProbeStatus, BodyRegex: em.ProbeBodyRegex,
+			JSONPath: em.ProbeJSONPath, JSONValue: em.ProbeJSONValue},
+		probeGRPCService: em.ProbeGRPCService, probeTLS: em.ProbeTLS,
+		probeExec: em.ProbeExec, probeExecState: em.ProbeExecState,
 	}
 	ret, err := mh.zr.Rules.AddEPHost(true, em.HostName, em.Name, epArgs)
+	if err == nil {
+		storePut(storeKindEP, storeEPKey(em), em)
+	}
 	return ret, err
 }
 
@@ -633,6 +866,9 @@ func (na *NetAPIStruct) NetEpHostDel(em *cmn.EndPointMod) (int, error) {
 	defer mh.mtx.Unlock()
 
 	ret, err := mh.zr.Rules.DeleteEPHost(true, em.Name, em.HostName, em.ProbeType, em.ProbePort)
+	if err == nil {
+		storeDel(storeKindEP, storeEPKey(em))
+	}
 	return ret, err
 }
 
@@ -664,6 +900,9 @@ func (na *NetAPIStruct) NetParamSet(param cmn.ParamMod) (int, error) {
 		return 0, errors.New("running in bgp only mode")
 	}
 	ret, err := mh.ParamSet(param)
+	if err == nil {
+		storePut(storeKindParams, storeParamsKey, param)
+	}
 	return ret, err
 }
 
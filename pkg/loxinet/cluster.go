@@ -90,6 +90,8 @@ type CIStateH struct {
 	OSrc6       string
 	initRules   bool
 	initRules6  bool
+	CloudErr    string
+	cloudErrOp  string
 }
 
 func (ch *CIStateH) BFDSessionNotify(instance string, _ string, ciState string) {
@@ -137,7 +139,12 @@ func (ch *CIStateH) CITicker() {
 func (ch *CIStateH) CISpawn() {
 	bs := bfd.StructNew(3784)
 	ch.Bs = bs
-	if _, err := os.Stat("/etc/loxilb/BFDconfig.txt"); !errors.Is(err, os.ErrNotExist) {
+	if mh.store != nil {
+		// Persisted BFD sessions get replayed from the config store
+		if len(mh.store.List(storeKindBFD)) > 0 {
+			return
+		}
+	} else if _, err := os.Stat("/etc/loxilb/BFDconfig.txt"); !errors.Is(err, os.ErrNotExist) {
 		nlp.ApplyBFDConfig()
 		return
 	}
@@ -482,6 +489,19 @@ func (ch *CIStateH) CIAddClusterRoute(dest string, add bool) {
 	}
 }
 
+// CICloudStatusUpdate - routine to record the result of a cloud hook operation.
+// Cloud operations are done only for the default instance, so the last failure
+// is reported as part of its state till the same operation succeeds again
+func (ch *CIStateH) CICloudStatusUpdate(op string, err error) {
+	if err != nil {
+		ch.CloudErr = fmt.Sprintf("%s %s: %s", time.Now().Format(time.RFC3339), op, err.Error())
+		ch.cloudErrOp = op
+	} else if ch.cloudErrOp == op {
+		ch.CloudErr = ""
+		ch.cloudErrOp = ""
+	}
+}
+
 // CIStateGet - routine to get HA state
 func (ch *CIStateH) CIStateGet() ([]cmn.HASMod, error) {
 	var res []cmn.HASMod
@@ -491,6 +511,9 @@ func (ch *CIStateH) CIStateGet() ([]cmn.HASMod, error) {
 		temp.Instance = i
 		temp.State = s.StateStr
 		temp.Vip = s.Vip
+		if i == cmn.CIDefault {
+			temp.CloudErr = ch.CloudErr
+		}
 		res = append(res, temp)
 	}
 	return res, nil
@@ -537,6 +560,8 @@ func (ch *CIStateH) CIStateUpdate(cm cmn.HASMod) (int, error) {
 		ci.StateStr = cm.State
 		ci.State = ch.StateMap[cm.State]
 		ci.Vip = cm.Vip
+		mh.watch.WatchPublish(cmn.WatchKindHAState, cmn.WatchEvModified, cm.Instance,
+			cmn.HASMod{Instance: cm.Instance, State: ci.StateStr, Vip: ci.Vip})
 
 		if mh.bgp != nil {
 			mh.bgp.UpdateCIState(cm.Instance, ci.State, ci.Vip)
@@ -277,6 +277,7 @@ const (
 	EpLeastConn
 	EpN2
 	EpN3
+	EpMaglev
 )
 
 // NatEP - a nat end-point
@@ -375,6 +376,10 @@ type DpCtInfo struct {
 	L4ServPortMax uint16 `json:"l4servprotomax"`
 	BlockNum      uint32 `json:"blocknum"`
 	RuleID        uint32 `json:"ruleid"`
+
+	// LB end-point of a DNAT entry
+	XIP   net.IP `json:"xip"`
+	XPort uint16 `json:"xport"`
 }
 
 const (
@@ -1030,6 +1030,10 @@ func DpLBRuleMod(w *LBDpWorkQ) int {
 		dat.sel_type = C.NAT_LB_SEL_N2
 	case w.EpSel == EpN3:
 		dat.sel_type = C.NAT_LB_SEL_N3
+	case w.EpSel == EpMaglev:
+		// End-points are already laid out as a maglev lookup table,
+		// so hashing a flow to a slot picks its maglev end-point
+		dat.sel_type = C.NAT_LB_SEL_HASH
 	/* Currently not implemented in DP */
 	/*case w.EpSel == EP_PRIO:
 	  dat.sel_type = C.NAT_LB_SEL_PRIO*/
@@ -1576,6 +1580,8 @@ func (ct *DpCtInfo) convDPCt2GoObjFixup(ctKey *C.struct_dp_ct_key, ctDat *C.stru
 					}
 				}
 				ct.CAct = fmt.Sprintf("%s-%s:%d:w%d", nmode, xip.String(), port, ctDat.xi.wprio)
+				ct.XIP = xip
+				ct.XPort = port
 			} else {
 				var rip net.IP
 
@@ -1588,6 +1594,8 @@ func (ct *DpCtInfo) convDPCt2GoObjFixup(ctKey *C.struct_dp_ct_key, ctDat *C.stru
 					rip = convDPv6Addr2NetIP(unsafe.Pointer(&ctDat.xi.nat_rip[0]))
 				}
 				ct.CAct = fmt.Sprintf("fdnat-%s,%s:%d:w%d", rip.String(), xip.String(), port, ctDat.xi.wprio)
+				ct.XIP = xip
+				ct.XPort = port
 			}
 		} else if ctDat.xi.nat_flags == C.LLB_NAT_SRC || ctDat.xi.nat_flags == C.LLB_NAT_HSRC {
 			if ctDat.xi.nat_rip[0] == 0 && ctDat.xi.nat_rip[1] == 0 &&
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+// This file implements hit counters of firewall rules. The DP only keeps
+// packet and byte counters of a rule, so the time a rule was last hit is
+// the time its packet counter was last seen growing. Counters are refreshed
+// periodically and whenever they are fetched through the API
+
+// firewall stats constants
+const (
+	FwStatsSyncDuration = 10 // Duration of periodic firewall counter refresh
+)
+
+// fwHitSync - update the last hit time of a firewall rule from its counters
+func (r *ruleEnt) fwHitSync(now time.Time) {
+	if r.stat.packets != r.stat.hitPkts {
+		if r.stat.packets > r.stat.hitPkts {
+			r.stat.lastHit = now
+		}
+		r.stat.hitPkts = r.stat.packets
+	}
+}
+
+// fwRuleStats - statistics of a firewall rule
+func (r *ruleEnt) fwRuleStats() cmn.FwRuleStats {
+	var stats cmn.FwRuleStats
+
+	stats.Packets = r.stat.packets
+	stats.Bytes = r.stat.bytes
+	if !r.stat.lastHit.IsZero() {
+		stats.LastHit = r.stat.lastHit.Unix()
+	}
+	if !r.sched.waiting {
+		stats.Since = r.sT.Unix()
+	}
+	return stats
+}
+
+// fwStatSync - periodically refresh counters of installed firewall rules.
+// Counters fetched in a run are checked for hits in the next one
+func (R *RuleH) fwStatSync() {
+	if time.Since(R.fwST) < FwStatsSyncDuration*time.Second {
+		return
+	}
+	now := time.Now()
+	R.fwST = now
+
+	for _, rule := range R.tables[RtFw].eMap {
+		if rule.sched.waiting {
+			continue
+		}
+		rule.fwHitSync(now)
+		rule.Fw2DP(DpStatsGet)
+	}
+}
+
+// fwIdle - check if a firewall rule had no hits for the given duration.
+// Rules which are not installed or were installed later are not idle
+func (r *ruleEnt) fwIdle(now time.Time, idle time.Duration) bool {
+	if r.sched.waiting || now.Sub(r.sT) < idle {
+		return false
+	}
+	return r.stat.lastHit.IsZero() || now.Sub(r.stat.lastHit) >= idle
+}
+
+// GetFwRuleStats - get statistics of all firewall rules. If idle is given,
+// only the rules which had no hits for idle seconds are returned
+func (R *RuleH) GetFwRuleStats(idle uint32) ([]cmn.FwRuleMod, error) {
+	var res []cmn.FwRuleMod
+
+	now := time.Now()
+	for _, data := range R.tables[RtFw].eMap {
+		if !data.sched.waiting {
+			data.Fw2DP(DpStatsGetImm)
+			data.fwHitSync(now)
+		}
+		if idle != 0 && !data.fwIdle(now, time.Duration(idle)*time.Second) {
+			continue
+		}
+
+		var ret cmn.FwRuleMod
+		ret.Rule = data.fwRuleArg()
+		if fwOpts := data.act.action.(*ruleFwOpts); fwOpts.op != RtActSnat {
+			ret.Opts.Mark = fwOpts.opt.fwMark
+		}
+		ret.Stats = data.fwRuleStats()
+		res = append(res, ret)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"testing"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+func TestFwHitSync(t *testing.T) {
+	// Steps are synced in order on the same rule
+	tests := []struct {
+		name    string
+		at      time.Duration
+		packets uint64
+		lastHit time.Duration
+	}{
+		{"no packets", 0, 0, -1},
+		{"growing counter", 0, 10, 0},
+		{"unchanged counter", time.Minute, 10, 0},
+		{"counter reset", 2 * time.Minute, 2, 0},
+		{"hit after reset", 3 * time.Minute, 3, 3 * time.Minute},
+	}
+
+	r := &ruleEnt{}
+	now := time.Now()
+	for _, tc := range tests {
+		r.stat.packets = tc.packets
+		r.fwHitSync(now.Add(tc.at))
+		want := time.Time{}
+		if tc.lastHit >= 0 {
+			want = now.Add(tc.lastHit)
+		}
+		if !r.stat.lastHit.Equal(want) {
+			t.Errorf("%s: last hit %v, want %v", tc.name, r.stat.lastHit, want)
+		}
+	}
+}
+
+func TestFwIdle(t *testing.T) {
+	now := time.Now()
+	idle := time.Minute
+	tests := []struct {
+		name    string
+		sT      time.Duration
+		lastHit time.Duration
+		waiting bool
+		idle    bool
+	}{
+		{"never hit", 2 * time.Minute, 0, false, true},
+		{"installed recently", 30 * time.Second, 0, false, false},
+		{"not installed yet", 2 * time.Minute, 0, true, false},
+		{"hit recently", 2 * time.Minute, 30 * time.Second, false, false},
+		{"hit long ago", time.Hour, 2 * time.Minute, false, true},
+		{"hit at idle time", time.Hour, time.Minute, false, true},
+	}
+
+	for _, tc := range tests {
+		r := &ruleEnt{sT: now.Add(-tc.sT)}
+		r.sched.waiting = tc.waiting
+		if tc.lastHit != 0 {
+			r.stat.lastHit = now.Add(-tc.lastHit)
+		}
+		if got := r.fwIdle(now, idle); got != tc.idle {
+			t.Errorf("%s: idle %v, want %v", tc.name, got, tc.idle)
+		}
+	}
+}
+
+func TestGetFwRuleStats(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	tests := []struct {
+		src     string
+		sT      time.Time
+		waiting bool
+		packets uint64
+		hit     bool
+		idle    bool
+	}{
+		{"10.0.0.0/8", old, false, 5, true, false},
+		{"20.0.0.0/8", old, false, 0, false, true},
+		{"30.0.0.0/8", time.Now(), false, 0, false, false},
+		{"40.0.0.0/8", old, true, 0, false, false},
+	}
+
+	zone, hooks := testZone(t)
+	R := zone.Rules
+	for i, tc := range tests {
+		r := testFwRule(zone, uint64(i+1), tc.src, tc.sT)
+		r.sched.waiting = tc.waiting
+		hooks.packets[uint32(i+1)] = tc.packets
+	}
+
+	res, err := R.GetFwRuleStats(0)
+	if err != nil || len(res) != len(tests) {
+		t.Fatalf("got %d rule stats: %v", len(res), err)
+	}
+	idle, _ := R.GetFwRuleStats(60)
+	for _, tc := range tests {
+		var stats *cmn.FwRuleStats
+		for i := range res {
+			if res[i].Rule.SrcIP == tc.src {
+				stats = &res[i].Stats
+			}
+		}
+		if stats == nil {
+			t.Errorf("%s: no rule stats", tc.src)
+			continue
+		}
+		if stats.Packets != tc.packets || stats.Bytes != tc.packets*100 || (stats.LastHit != 0) != tc.hit {
+			t.Errorf("%s: rule stats %+v", tc.src, stats)
+		}
+		if since := stats.Since != 0; since == tc.waiting || (since && stats.Since != tc.sT.Unix()) {
+			t.Errorf("%s: installed since %d", tc.src, stats.Since)
+		}
+
+		// Only rules installed for long enough without hits are idle
+		found := false
+		for _, fw := range idle {
+			found = found || fw.Rule.SrcIP == tc.src
+		}
+		if found != tc.idle {
+			t.Errorf("%s: idle %v, want %v", tc.src, found, tc.idle)
+		}
+	}
+}
+
+func TestNetFwRuleGetStats(t *testing.T) {
+	zone, hooks := testZone(t)
+	r := testFwRule(zone, 1, "10.0.0.0/8", time.Now().Add(-time.Hour))
+	hooks.packets[1] = 7
+
+	na := &NetAPIStruct{}
+	res, err := na.NetFwRuleGet()
+	if err != nil || len(res) != 1 {
+		t.Fatalf("got %d rules: %v", len(res), err)
+	}
+	fw := res[0]
+	if !fw.Opts.Drop || fw.Stats.Packets != 7 || fw.Stats.Bytes != 700 || fw.Stats.LastHit == 0 {
+		t.Errorf("rule %+v with stats %+v", fw.Opts, fw.Stats)
+	}
+	if fw.Opts.Counter != "7:700" {
+		t.Errorf("counter %q disagrees with stats", fw.Opts.Counter)
+	}
+
+	// Counters seen again without growing don't move the last hit time
+	lastHit := time.Now().Add(-time.Minute)
+	r.stat.lastHit = lastHit
+	if res, _ = na.NetFwRuleGet(); res[0].Stats.LastHit != lastHit.Unix() {
+		t.Errorf("last hit moved to %d without traffic", res[0].Stats.LastHit)
+	}
+}
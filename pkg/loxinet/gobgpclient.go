@@ -308,12 +308,18 @@ func (gbh *GoBgpH) GetgoBGPRoutesEvents(client api.GobgpApiClient) int {
 			if t := r.GetTable(); t != nil {
 				gbh.processRoute(t.Paths)
 			}
+			if p := r.GetPeer(); p != nil && p.Type == api.WatchEventResponse_PeerEvent_STATE && p.Peer.GetState() != nil {
+				pState := p.Peer.State
+				mh.watch.WatchPublish(cmn.WatchKindBGPNeigh, cmn.WatchEvModified, pState.NeighborAddress,
+					cmn.GoBGPNeighGetMod{Addr: pState.NeighborAddress, RemoteAS: pState.PeerAsn, State: pState.SessionState.String()})
+			}
 		}
 	}
 
 	// the change of the peer state and path
 	routes, err := client.WatchEvent(context.Background(),
 		&api.WatchEventRequest{
+			Peer: &api.WatchEventRequest_Peer{},
 			Table: &api.WatchEventRequest_Table{
 				Filters: []*api.WatchEventRequest_Table_Filter{
 					{
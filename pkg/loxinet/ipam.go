@@ -0,0 +1,282 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"net"
+	"sort"
+
+	"github.com/loxilb-io/loxilb/api/ippool"
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements IPAM pools used to hand out service VIPs to LB rules
+// which are created without an explicit service IP
+
+// error codes for IPAM
+const (
+	IpamErrBase = iota - 104000
+	IpamArgsErr
+	IpamExistsErr
+	IpamNotExistErr
+	IpamInUseErr
+	IpamExhaustedErr
+)
+
+// ipamAlloc - a single address handed out from a pool
+type ipamAlloc struct {
+	ip    string
+	owner string
+	rules map[string]struct{}
+}
+
+// ipamPool - an IPAM pool
+type ipamPool struct {
+	name   string
+	cidr   *net.IPNet
+	pool   *ippool.IPPool
+	allocs map[string]*ipamAlloc
+	owners map[string]*ipamAlloc
+}
+
+// IpamH - IPAM context handler
+type IpamH struct {
+	zone  *Zone
+	pools map[string]*ipamPool
+}
+
+// IpamInit - initialize the IPAM subsystem
+func IpamInit(zone *Zone) *IpamH {
+	var nIpam = new(IpamH)
+	nIpam.zone = zone
+	nIpam.pools = make(map[string]*ipamPool)
+	return nIpam
+}
+
+// poolOf - find the pool which contains the given address
+func (I *IpamH) poolOf(ip net.IP) *ipamPool {
+	for _, p := range I.pools {
+		if p.cidr.Contains(ip) {
+			return p
+		}
+	}
+	return nil
+}
+
+// IPPoolAdd - add a new IPAM pool. Addresses used by existing LB rules which
+// fall within the pool range are marked as allocated
+func (I *IpamH) IPPoolAdd(pm cmn.IPPoolMod) (int, error) {
+	if pm.Name == "" || net.ParseIP(pm.Name) != nil {
+		return IpamArgsErr, errors.New("ippool name error")
+	}
+
+	_, cidr, err := net.ParseCIDR(pm.CIDR)
+	if err != nil {
+		return IpamArgsErr, errors.New("ippool cidr error")
+	}
+
+	if I.pools[pm.Name] != nil {
+		return IpamExistsErr, errors.New("ippool exists error")
+	}
+
+	for _, p := range I.pools {
+		if p.cidr.Contains(cidr.IP) || cidr.Contains(p.cidr.IP) {
+			return IpamExistsErr, errors.New("ippool overlap error")
+		}
+	}
+
+	pool, err := ippool.NewIPPool(cidr.String())
+	if err != nil {
+		return IpamArgsErr, errors.New("ippool cidr error")
+	}
+
+	p := new(ipamPool)
+	p.name = pm.Name
+	p.cidr = cidr
+	p.pool = pool
+	p.allocs = make(map[string]*ipamAlloc)
+	p.owners = make(map[string]*ipamAlloc)
+	I.pools[pm.Name] = p
+
+	for _, r := range I.zone.Rules.tables[RtLB].eMap {
+		I.IpamBindRule(r)
+	}
+
+	tk.LogIt(tk.LogInfo, "ippool %s:%s added (%d allocated)\n", p.name, p.cidr.String(), len(p.allocs))
+
+	return 0, nil
+}
+
+// IPPoolDelete - delete an IPAM pool. A pool can't be deleted while
+// any of its addresses are in use
+func (I *IpamH) IPPoolDelete(pm cmn.IPPoolMod) (int, error) {
+	p := I.pools[pm.Name]
+	if p == nil {
+		return IpamNotExistErr, errors.New("no such ippool error")
+	}
+
+	if len(p.allocs) != 0 {
+		return IpamInUseErr, errors.New("ippool in-use error")
+	}
+
+	delete(I.pools, pm.Name)
+
+	tk.LogIt(tk.LogInfo, "ippool %s:%s deleted\n", p.name, p.cidr.String())
+
+	return 0, nil
+}
+
+// IPPoolGet - get all IPAM pools along with their allocations
+func (I *IpamH) IPPoolGet() ([]cmn.IPPoolGetMod, error) {
+	var res []cmn.IPPoolGetMod
+
+	for _, p := range I.pools {
+		var pg cmn.IPPoolGetMod
+		pg.Name = p.name
+		pg.CIDR = p.cidr.String()
+		for _, a := range p.allocs {
+			pg.Allocs = append(pg.Allocs, cmn.IPPoolAllocMod{IP: a.ip, Owner: a.owner, Rules: len(a.rules)})
+		}
+		sort.Slice(pg.Allocs, func(i, j int) bool {
+			return pg.Allocs[i].IP < pg.Allocs[j].IP
+		})
+		res = append(res, pg)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+
+	return res, nil
+}
+
+// IpamServIPResolve - resolve the service IP of a LB service. If the service IP
+// is an address, it is returned as is. Otherwise it names the pool (empty means
+// cmn.IPPoolDefault) to allocate from. An address already handed out to the
+// same service owner is reused. If alloc is false, only an existing allocation
+// is looked up
+func (I *IpamH) IpamServIPResolve(serv *cmn.LbServiceArg, alloc bool) (string, error) {
+	if net.ParseIP(serv.ServIP) != nil {
+		return serv.ServIP, nil
+	}
+
+	pName := serv.ServIP
+	if pName == "" {
+		pName = cmn.IPPoolDefault
+	}
+
+	p := I.pools[pName]
+	if p == nil {
+		return "", errors.New("no such ippool error")
+	}
+
+	owner := cmn.LbServOwnerKey(serv)
+	if a := p.owners[owner]; a != nil {
+		return a.ip, nil
+	}
+
+	if !alloc {
+		return "", errors.New("no ippool allocation error")
+	}
+
+	ip := p.pool.AssignNewIP()
+	if ip == nil {
+		return "", errors.New("ippool exhausted error")
+	}
+
+	a := &ipamAlloc{ip: ip.String(), owner: owner, rules: make(map[string]struct{})}
+	p.allocs[a.ip] = a
+	p.owners[owner] = a
+
+	tk.LogIt(tk.LogDebug, "ippool %s: allocated %s for %s\n", p.name, a.ip, owner)
+
+	return a.ip, nil
+}
+
+// IpamServIPRelease - release an address if no LB rule references it
+func (I *IpamH) IpamServIPRelease(ipStr string) {
+	ip := net.ParseIP(ipStr)
+	if ip == nil {
+		return
+	}
+
+	p := I.poolOf(ip)
+	if p == nil {
+		return
+	}
+
+	a := p.allocs[ip.String()]
+	if a == nil || len(a.rules) != 0 {
+		return
+	}
+
+	delete(p.allocs, a.ip)
+	for owner, oa := range p.owners {
+		if oa == a {
+			delete(p.owners, owner)
+		}
+	}
+	p.pool.RetrieveIP(a.ip)
+
+	tk.LogIt(tk.LogDebug, "ippool %s: released %s\n", p.name, a.ip)
+}
+
+// IpamBindRule - mark the VIP of a LB rule as in use if it belongs to a pool
+func (I *IpamH) IpamBindRule(r *ruleEnt) {
+	if r.egress || r.act.actType == RtActSnat {
+		return
+	}
+
+	ip := r.tuples.l3Dst.addr.IP
+	p := I.poolOf(ip)
+	if p == nil {
+		return
+	}
+
+	a := p.allocs[ip.String()]
+	if a == nil {
+		if !p.pool.ReserveIP(ip.String()) {
+			return
+		}
+		a = &ipamAlloc{ip: ip.String(), owner: r.ipamKey, rules: make(map[string]struct{})}
+		p.allocs[a.ip] = a
+	}
+	if p.owners[r.ipamKey] == nil {
+		p.owners[r.ipamKey] = a
+	}
+	a.rules[r.tuples.ruleKey()] = struct{}{}
+}
+
+// IpamUnbindRule - drop the reference of a LB rule to its VIP. The address
+// goes back to its pool once it is no longer in use
+func (I *IpamH) IpamUnbindRule(r *ruleEnt) {
+	ip := r.tuples.l3Dst.addr.IP
+	p := I.poolOf(ip)
+	if p == nil {
+		return
+	}
+
+	a := p.allocs[ip.String()]
+	if a == nil {
+		return
+	}
+
+	delete(a.rules, r.tuples.ruleKey())
+	I.IpamServIPRelease(a.ip)
+}
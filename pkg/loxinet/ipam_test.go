@@ -0,0 +1,224 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"net"
+	"testing"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+func TestIpamPoolAdd(t *testing.T) {
+	zone, _ := testZone(t)
+
+	tests := []struct {
+		name string
+		cidr string
+		ok   bool
+	}{
+		{cmn.IPPoolDefault, "20.20.20.0/29", true},
+		{"pool2", "20.20.20.4/30", false},
+		{"30.0.0.1", "30.0.0.0/24", false},
+		{"pool3", "30.0.0.0/24", true},
+	}
+	for _, tc := range tests {
+		if _, err := zone.Ipam.IPPoolAdd(cmn.IPPoolMod{Name: tc.name, CIDR: tc.cidr}); (err == nil) != tc.ok {
+			t.Errorf("pool %s %s added: %v", tc.name, tc.cidr, err)
+		}
+	}
+}
+
+func TestIpamServIPResolve(t *testing.T) {
+	zone, _ := testZone(t)
+	ipam := zone.Ipam
+	if _, err := ipam.IPPoolAdd(cmn.IPPoolMod{Name: cmn.IPPoolDefault, CIDR: "20.20.20.0/29"}); err != nil {
+		t.Fatalf("pool add: %v", err)
+	}
+
+	s1 := cmn.LbServiceArg{Proto: "tcp", ServPort: 80}
+	s2 := cmn.LbServiceArg{Proto: "tcp", ServPort: 443}
+	n1 := cmn.LbServiceArg{Name: "web", Proto: "tcp", ServPort: 80}
+	n2 := cmn.LbServiceArg{Name: "web", Proto: "tcp", ServPort: 443}
+
+	// want is an address, the name of an earlier case with the same address
+	// or empty for a new address of the pool
+	tests := []struct {
+		name  string
+		serv  cmn.LbServiceArg
+		alloc bool
+		want  string
+		err   bool
+	}{
+		{"explicit address", cmn.LbServiceArg{ServIP: "10.0.0.1", ServPort: 80}, true, "10.0.0.1", false},
+		{"lookup without allocation", s1, false, "", true},
+		{"allocation", s1, true, "", false},
+		{"same owner", s1, true, "allocation", false},
+		{"lookup", s1, false, "allocation", false},
+		{"second owner", s2, true, "", false},
+		{"named service", n1, true, "", false},
+		{"named service on other port", n2, true, "named service", false},
+		{"unknown pool", cmn.LbServiceArg{ServIP: "nopool", ServPort: 80}, true, "", true},
+	}
+
+	got := make(map[string]string)
+	used := make(map[string]bool)
+	for _, tc := range tests {
+		serv := tc.serv
+		vip, err := ipam.IpamServIPResolve(&serv, tc.alloc)
+		if (err != nil) != tc.err {
+			t.Errorf("%s: got %s, %v", tc.name, vip, err)
+			continue
+		}
+		if tc.err {
+			continue
+		}
+		got[tc.name] = vip
+		switch want, earlier := tc.want, got[tc.want]; {
+		case earlier != "":
+			if vip != earlier {
+				t.Errorf("%s: got %s, want %s", tc.name, vip, earlier)
+			}
+		case want != "":
+			if vip != want {
+				t.Errorf("%s: got %s, want %s", tc.name, vip, want)
+			}
+		default:
+			if used[vip] || !ipam.pools[cmn.IPPoolDefault].cidr.Contains(net.ParseIP(vip)) {
+				t.Errorf("%s: got %s, want a new pool address", tc.name, vip)
+			}
+		}
+		used[vip] = true
+	}
+
+	// Exhaust the pool
+	var last error
+	for port := uint16(1000); port < 1010 && last == nil; port++ {
+		_, last = ipam.IpamServIPResolve(&cmn.LbServiceArg{Proto: "tcp", ServPort: port}, true)
+	}
+	if last == nil {
+		t.Errorf("pool never exhausted")
+	}
+}
+
+func TestIpamReleaseBind(t *testing.T) {
+	zone, _ := testZone(t)
+	ipam := zone.Ipam
+	if _, err := ipam.IPPoolAdd(cmn.IPPoolMod{Name: "pool1", CIDR: "20.20.20.0/29"}); err != nil {
+		t.Fatalf("pool add: %v", err)
+	}
+	p := ipam.pools["pool1"]
+
+	s1 := cmn.LbServiceArg{ServIP: "pool1", Name: "svc", Proto: "tcp", ServPort: 80}
+	vip, err := ipam.IpamServIPResolve(&s1, true)
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+
+	// A rule holds the address. Release is a no-op while it is bound
+	r1 := testLbRule(zone, s1, vip, 1)
+	ipam.IpamBindRule(r1)
+	ipam.IpamServIPRelease(vip)
+	if p.allocs[vip] == nil || len(p.allocs[vip].rules) != 1 {
+		t.Fatalf("bound address released: %+v", p.allocs[vip])
+	}
+	if _, err := ipam.IPPoolDelete(cmn.IPPoolMod{Name: "pool1"}); err == nil {
+		t.Errorf("pool in use deleted")
+	}
+
+	// A second rule of the same owner shares the address
+	s2 := s1
+	s2.ServPort = 81
+	r2 := testLbRule(zone, s2, vip, 1)
+	ipam.IpamBindRule(r2)
+	if len(p.allocs[vip].rules) != 2 {
+		t.Errorf("got %d rules bound, want 2", len(p.allocs[vip].rules))
+	}
+
+	ipam.IpamUnbindRule(r1)
+	if p.allocs[vip] == nil {
+		t.Fatalf("address released with a rule bound")
+	}
+	ipam.IpamUnbindRule(r2)
+	if p.allocs[vip] != nil || len(p.owners) != 0 {
+		t.Errorf("address not released: %+v %+v", p.allocs, p.owners)
+	}
+
+	// Released addresses go back to the pool
+	if p.pool.IPv4Pool.Contains(vip) {
+		t.Errorf("%s still allocated in pool", vip)
+	}
+
+	// Addresses outside of any pool are not tracked
+	r3 := testLbRule(zone, cmn.LbServiceArg{Proto: "tcp", ServPort: 80}, "30.0.0.1", 1)
+	ipam.IpamBindRule(r3)
+	ipam.IpamUnbindRule(r3)
+	if len(p.allocs) != 0 {
+		t.Errorf("got %d allocations, want 0", len(p.allocs))
+	}
+
+	if _, err := ipam.IPPoolDelete(cmn.IPPoolMod{Name: "pool1"}); err != nil {
+		t.Errorf("unused pool delete: %v", err)
+	}
+}
+
+func TestIpamRebuild(t *testing.T) {
+	zone, _ := testZone(t)
+	ipam := zone.Ipam
+
+	// LB rules restored at startup before their pool is added back
+	s1 := cmn.LbServiceArg{Proto: "tcp", ServPort: 80}
+	s2 := cmn.LbServiceArg{Name: "web", Proto: "tcp", ServPort: 443}
+	testLbRule(zone, s1, "20.20.20.1", 1)
+	testLbRule(zone, s2, "20.20.20.2", 1)
+	if _, err := ipam.IPPoolAdd(cmn.IPPoolMod{Name: cmn.IPPoolDefault, CIDR: "20.20.20.0/29"}); err != nil {
+		t.Fatalf("pool add: %v", err)
+	}
+
+	// Existing owners get their addresses back
+	s3 := s2
+	s3.ServPort = 8443
+	tests := []struct {
+		name string
+		serv cmn.LbServiceArg
+		want string
+	}{
+		{"restored owner", s1, "20.20.20.1"},
+		{"restored named owner", s2, "20.20.20.2"},
+		{"named owner on other port", s3, "20.20.20.2"},
+	}
+	for _, tc := range tests {
+		if vip, err := ipam.IpamServIPResolve(&tc.serv, true); err != nil || vip != tc.want {
+			t.Errorf("%s: got %s, %v, want %s", tc.name, vip, err, tc.want)
+		}
+	}
+
+	// New owners never get an address which is already in use
+	seen := map[string]bool{"20.20.20.1": true, "20.20.20.2": true}
+	for port := uint16(1000); ; port++ {
+		vip, err := ipam.IpamServIPResolve(&cmn.LbServiceArg{Proto: "tcp", ServPort: port}, true)
+		if err != nil {
+			break
+		}
+		if seen[vip] {
+			t.Fatalf("%s allocated twice", vip)
+		}
+		seen[vip] = true
+	}
+	if len(seen) < 3 {
+		t.Errorf("got %d addresses, want at least 3", len(seen))
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"net"
+	"sort"
+
+	tk "github.com/loxilb-io/loxilib"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+// This file implements named IP sets. Sets are kept in the control plane
+// only as the datapath has no IP set map yet. Firewall rules and LB allowed
+// sources can't refer to a set till it has one
+
+// error codes
+const (
+	IPSetErrBase = iota - 106000
+	IPSetArgsErr
+	IPSetExistsErr
+	IPSetNoExistErr
+)
+
+// constants
+const (
+	MaxIPSets       = 1024
+	MaxIPSetMembers = 64 * 1024
+	MaxIPSetName    = 64
+)
+
+// IPSetEntry - an IP set entry
+type IPSetEntry struct {
+	Name    string
+	Members map[string]net.IPNet
+	Zone    *Zone
+}
+
+// IPSetH - context container
+type IPSetH struct {
+	SetMap map[string]*IPSetEntry
+	Zone   *Zone
+}
+
+// IPSetInit - initialize the IP set subsystem
+func IPSetInit(zone *Zone) *IPSetH {
+	var nIh = new(IPSetH)
+	nIh.SetMap = make(map[string]*IPSetEntry)
+	nIh.Zone = zone
+	return nIh
+}
+
+// IPSetNameValid - check if name can be used for an IP set. Names can't be
+// mistaken for prefixes where both are accepted
+func IPSetNameValid(name string) bool {
+	if name == "" || len(name) > MaxIPSetName || net.ParseIP(name) != nil {
+		return false
+	}
+	if _, _, err := net.ParseCIDR(name); err == nil {
+		return false
+	}
+	return true
+}
+
+// ipSetParseMember - parse a member prefix of an IP set. A plain address is
+// taken as a host prefix
+func ipSetParseMember(member string) (net.IPNet, error) {
+	if ip := net.ParseIP(member); ip != nil {
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		return net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, pref, err := net.ParseCIDR(member)
+	if err != nil {
+		return net.IPNet{}, errors.New("ipset-member error: " + member)
+	}
+	return *pref, nil
+}
+
+// IPSetFind - find an IP set by name
+func (I *IPSetH) IPSetFind(name string) *IPSetEntry {
+	return I.SetMap[name]
+}
+
+// members - sorted member prefixes of an IP set
+func (s *IPSetEntry) members() []string {
+	var mems []string
+	for m := range s.Members {
+		mems = append(mems, m)
+	}
+	sort.Strings(mems)
+	return mems
+}
+
+// IPSetGetAll - Get all of the IP sets in loxinet
+func (I *IPSetH) IPSetGetAll() ([]cmn.IPSetMod, error) {
+	var getSets []cmn.IPSetMod
+	for _, s := range I.SetMap {
+		getSets = append(getSets, cmn.IPSetMod{Name: s.Name, Members: s.members()})
+	}
+	return getSets, nil
+}
+
+// IPSetGet - Get an IP set in loxinet
+func (I *IPSetH) IPSetGet(name string) (cmn.IPSetMod, error) {
+	s := I.SetMap[name]
+	if s == nil {
+		return cmn.IPSetMod{}, errors.New("no such ipset error")
+	}
+	return cmn.IPSetMod{Name: s.Name, Members: s.members()}, nil
+}
+
+// IPSetAdd - Add an IP set in loxinet. The members of an existing set are
+// replaced at once
+func (I *IPSetH) IPSetAdd(name string, members []string) (int, error) {
+	if !IPSetNameValid(name) {
+		tk.LogIt(tk.LogError, "ipset add - %s: name error\n", name)
+		return IPSetArgsErr, errors.New("ipset-name error")
+	}
+
+	if len(members) > MaxIPSetMembers {
+		return IPSetArgsErr, errors.New("ipset-members error: too many members")
+	}
+
+	mems := make(map[string]net.IPNet)
+	for _, m := range members {
+		pref, err := ipSetParseMember(m)
+		if err != nil {
+			tk.LogIt(tk.LogError, "ipset add - %s: %s\n", name, err)
+			return IPSetArgsErr, err
+		}
+		mems[pref.String()] = pref
+	}
+
+	s := I.SetMap[name]
+	if s != nil {
+		s.Members = mems
+		tk.LogIt(tk.LogInfo, "ipset updated - %s(%d members)\n", name, len(mems))
+		return 0, nil
+	}
+
+	if len(I.SetMap) >= MaxIPSets {
+		return IPSetArgsErr, errors.New("ipset error: too many sets")
+	}
+
+	s = new(IPSetEntry)
+	s.Name = name
+	s.Members = mems
+	s.Zone = I.Zone
+
+	I.SetMap[name] = s
+
+	tk.LogIt(tk.LogInfo, "ipset added - %s(%d members)\n", name, len(mems))
+
+	return 0, nil
+}
+
+// IPSetMemberMod - Add and remove members of an IP set at once
+func (I *IPSetH) IPSetMemberMod(name string, add []string, remove []string) (int, error) {
+	s := I.SetMap[name]
+	if s == nil {
+		tk.LogIt(tk.LogError, "ipset mod - %s: not found error\n", name)
+		return IPSetNoExistErr, errors.New("no such ipset error")
+	}
+
+	mems := make(map[string]net.IPNet, len(s.Members)+len(add))
+	for k, m := range s.Members {
+		mems[k] = m
+	}
+	for _, m := range remove {
+		pref, err := ipSetParseMember(m)
+		if err != nil {
+			return IPSetArgsErr, err
+		}
+		delete(mems, pref.String())
+	}
+	for _, m := range add {
+		pref, err := ipSetParseMember(m)
+		if err != nil {
+			return IPSetArgsErr, err
+		}
+		mems[pref.String()] = pref
+	}
+
+	if len(mems) > MaxIPSetMembers {
+		return IPSetArgsErr, errors.New("ipset-members error: too many members")
+	}
+
+	s.Members = mems
+
+	tk.LogIt(tk.LogInfo, "ipset modified - %s(%d members)\n", name, len(mems))
+
+	return 0, nil
+}
+
+// IPSetDelete - Delete an IP set from loxinet
+func (I *IPSetH) IPSetDelete(name string) (int, error) {
+	s := I.SetMap[name]
+	if s == nil {
+		tk.LogIt(tk.LogError, "ipset delete - %s: not found error\n", name)
+		return IPSetNoExistErr, errors.New("no such ipset error")
+	}
+
+	delete(I.SetMap, name)
+
+	tk.LogIt(tk.LogInfo, "ipset deleted - %s\n", name)
+
+	return 0, nil
+}
+
+// IPSetDestructAll - destroy all IP sets
+func (I *IPSetH) IPSetDestructAll() {
+	for _, s := range I.SetMap {
+		I.IPSetDelete(s.Name)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+func TestIPSetAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		set     string
+		members []string
+		ret     int
+		want    string
+	}{
+		{"empty name", "", nil, IPSetArgsErr, ""},
+		{"address name", "10.0.0.1", nil, IPSetArgsErr, ""},
+		{"prefix name", "10.0.0.0/8", nil, IPSetArgsErr, ""},
+		{"ipv6 name", "2001::1", nil, IPSetArgsErr, ""},
+		{"long name", strings.Repeat("a", MaxIPSetName+1), nil, IPSetArgsErr, ""},
+		{"bad member", "bad", []string{"10.0.0.300"}, IPSetArgsErr, ""},
+		{"members", "set1", []string{"10.0.0.1", "10.0.0.1/32", "20.0.0.0/8", "2001:db8::/32"}, 0,
+			"10.0.0.1/32,20.0.0.0/8,2001:db8::/32"},
+		{"replace", "set1", []string{"30.0.0.0/16"}, 0, "30.0.0.0/16"},
+	}
+
+	zone, _ := testZone(t)
+	for _, tc := range tests {
+		ret, err := zone.IPSets.IPSetAdd(tc.set, tc.members)
+		if ret != tc.ret || (err == nil) != (tc.ret == 0) {
+			t.Errorf("%s: ipset add %d %v, want %d", tc.name, ret, err, tc.ret)
+			continue
+		}
+		if tc.ret != 0 {
+			if zone.IPSets.IPSetFind(tc.set) != nil {
+				t.Errorf("%s: ipset %q added", tc.name, tc.set)
+			}
+			continue
+		}
+		if got, _ := zone.IPSets.IPSetGet(tc.set); strings.Join(got.Members, ",") != tc.want {
+			t.Errorf("%s: members %v, want %s", tc.name, got.Members, tc.want)
+		}
+	}
+}
+
+func TestIPSetMemberMod(t *testing.T) {
+	tests := []struct {
+		name   string
+		set    string
+		add    []string
+		remove []string
+		ret    int
+		want   string
+	}{
+		{"unknown set", "noset", []string{"30.0.0.0/16"}, nil, IPSetNoExistErr, "10.0.0.1/32,20.0.0.0/8"},
+		{"bad member", "set1", []string{"30.0.0.300"}, nil, IPSetArgsErr, "10.0.0.1/32,20.0.0.0/8"},
+		{"add and remove", "set1", []string{"30.0.0.0/16"}, []string{"10.0.0.1"}, 0, "20.0.0.0/8,30.0.0.0/16"},
+		{"remove missing", "set1", nil, []string{"40.0.0.0/8"}, 0, "20.0.0.0/8,30.0.0.0/16"},
+	}
+
+	zone, _ := testZone(t)
+	if _, err := zone.IPSets.IPSetAdd("set1", []string{"10.0.0.1", "20.0.0.0/8"}); err != nil {
+		t.Fatalf("ipset add: %v", err)
+	}
+	for _, tc := range tests {
+		if ret, _ := zone.IPSets.IPSetMemberMod(tc.set, tc.add, tc.remove); ret != tc.ret {
+			t.Errorf("%s: ipset member mod %d, want %d", tc.name, ret, tc.ret)
+		}
+		if got, _ := zone.IPSets.IPSetGet("set1"); strings.Join(got.Members, ",") != tc.want {
+			t.Errorf("%s: members %v, want %s", tc.name, got.Members, tc.want)
+		}
+	}
+}
+
+func TestIPSetDelete(t *testing.T) {
+	zone, _ := testZone(t)
+	if _, err := zone.IPSets.IPSetAdd("set1", []string{"10.0.0.1"}); err != nil {
+		t.Fatalf("ipset add: %v", err)
+	}
+
+	if _, err := zone.IPSets.IPSetDelete("set1"); err != nil {
+		t.Fatalf("ipset delete: %v", err)
+	}
+	if ret, err := zone.IPSets.IPSetDelete("set1"); err == nil || ret != IPSetNoExistErr {
+		t.Errorf("deleted ipset deleted again: %d %v", ret, err)
+	}
+}
+
+// TestIPSetRefs - rules referring to IP sets are refused as the DP can't
+// match them
+func TestIPSetRefs(t *testing.T) {
+	fwTests := []struct {
+		name string
+		rule cmn.FwRuleArg
+	}{
+		{"src set", cmn.FwRuleArg{SrcIP: "0.0.0.0/0", DstIP: "0.0.0.0/0", SrcIPSet: "set1"}},
+		{"dst set", cmn.FwRuleArg{SrcIP: "0.0.0.0/0", DstIP: "0.0.0.0/0", DstIPSet: "set1"}},
+		{"unknown set", cmn.FwRuleArg{SrcIP: "0.0.0.0/0", DstIP: "0.0.0.0/0", SrcIPSet: "noset"}},
+	}
+
+	zone, _ := testZone(t)
+	if _, err := zone.IPSets.IPSetAdd("set1", []string{"10.0.0.1"}); err != nil {
+		t.Fatalf("ipset add: %v", err)
+	}
+
+	for _, tc := range fwTests {
+		if ret, err := zone.Rules.AddFwRule(tc.rule, cmn.FwOptArg{Drop: true}); err == nil || ret != RuleTupleErr {
+			t.Errorf("%s: fw rule added: %d %v", tc.name, ret, err)
+		}
+	}
+	if len(zone.Rules.tables[RtFw].eMap) != 0 {
+		t.Errorf("fw rules left: %d", len(zone.Rules.tables[RtFw].eMap))
+	}
+
+	serv := cmn.LbServiceArg{ServIP: "10.10.10.1", ServPort: 80, Proto: "tcp", Sel: cmn.LbSelRr}
+	srcs := []cmn.LbAllowedSrcIPArg{{Prefix: "set1"}}
+	eps := []cmn.LbEndPointArg{{EpIP: "31.31.31.1", EpPort: 8080, Weight: 1}}
+	if ret, err := zone.Rules.AddLbRule(serv, nil, srcs, eps); err == nil || ret != RuleArgsErr {
+		t.Errorf("lb rule with ipset source added: %d %v", ret, err)
+	}
+}
+
+func TestIPSetParseMember(t *testing.T) {
+	tests := []struct {
+		member string
+		pref   string
+	}{
+		{"10.0.0.1", "10.0.0.1/32"},
+		{"10.0.0.1/24", "10.0.0.0/24"},
+		{"2001:db8::1", "2001:db8::1/128"},
+		{"2001:db8::1/64", "2001:db8::/64"},
+		{"::ffff:10.0.0.1", "10.0.0.1/32"},
+	}
+	for _, tc := range tests {
+		pref, err := ipSetParseMember(tc.member)
+		if err != nil || pref.String() != tc.pref {
+			t.Errorf("%s: got %s, %v, want %s", tc.member, pref.String(), err, tc.pref)
+		}
+	}
+	for _, m := range []string{"", "10.0.0", "10.0.0.1/33", "set1"} {
+		if _, err := ipSetParseMember(m); err == nil {
+			t.Errorf("%q parsed", m)
+		}
+	}
+	if pref, _ := ipSetParseMember("10.0.0.1"); len(pref.IP) != net.IPv4len {
+		t.Errorf("IPv4 member kept as %d bytes", len(pref.IP))
+	}
+}
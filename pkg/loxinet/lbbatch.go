@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"net"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements all-or-nothing batches of LB rule operations
+
+// lbBatchUndo - a way to revert an applied LB batch operation
+type lbBatchUndo struct {
+	idx     int
+	applied bool
+	undo    func() (int, error)
+}
+
+// lbBatchServ - resolve the service IP of a LB service without allocating
+func (R *RuleH) lbBatchServ(serv cmn.LbServiceArg) cmn.LbServiceArg {
+	if net.ParseIP(serv.ServIP) == nil {
+		if vip, err := R.zone.Ipam.IpamServIPResolve(&serv, false); err == nil {
+			serv.ServIP = vip
+		}
+	}
+	return serv
+}
+
+// lbBatchFind - find the LB rule of a LB service
+func (R *RuleH) lbBatchFind(serv cmn.LbServiceArg) *ruleEnt {
+	serv = R.lbBatchServ(serv)
+	if serv.ServPortMax == 0 {
+		serv.ServPortMax = serv.ServPort
+	}
+	return R.GetLBRuleByServArgs(serv)
+}
+
+// lbBatchSnapshot - get the current state of a LB rule so that it can be restored
+func (R *RuleH) lbBatchSnapshot(serv cmn.LbServiceArg) *cmn.LbRuleMod {
+	r := R.lbBatchFind(serv)
+	if r == nil {
+		return nil
+	}
+
+	lm, err := R.lbRule2Mod(r)
+	if err != nil {
+		return nil
+	}
+	lm.Serv.PersistTimeout = r.pTO
+	lm.Serv.ProbeTimeout = r.hChk.prbTimeo
+	lm.Serv.ProbeRetries = r.hChk.prbRetries
+	lm.Serv.ProbeRise = r.hChk.prbRise
+	if r.privIP != nil {
+		lm.Serv.PrivateIP = r.privIP.String()
+	}
+	for i := range lm.Eps {
+		lm.Eps[i].State = ""
+		lm.Eps[i].Counters = ""
+	}
+	return &lm
+}
+
+// lbBatchRestore - restore a LB rule to a snapshot, or remove it if it
+// did not exist before
+func (R *RuleH) lbBatchRestore(serv cmn.LbServiceArg, prev *cmn.LbRuleMod) (int, error) {
+	if R.lbBatchFind(serv) != nil {
+		if ret, err := R.DeleteLbRule(serv); err != nil {
+			return ret, err
+		}
+	}
+	if prev == nil {
+		return 0, nil
+	}
+	return R.AddLbRule(prev.Serv, prev.SecIPs, prev.SrcIPs, prev.Eps)
+}
+
+// lbBatchAdd - add a LB rule as part of a batch and make sure it made it
+// to the datapath
+func (R *RuleH) lbBatchAdd(lm *cmn.LbRuleMod) (func() (int, error), int, error) {
+	prev := R.lbBatchSnapshot(lm.Serv)
+
+	ret, err := R.AddLbRule(lm.Serv, lm.SecIPs, lm.SrcIPs, lm.Eps)
+	if err != nil {
+		return nil, ret, err
+	}
+
+	// The rule is in place now and its VIP is allocated if it came from a pool
+	serv := R.lbBatchServ(lm.Serv)
+	undo := func() (int, error) {
+		return R.lbBatchRestore(serv, prev)
+	}
+
+	// AddLbRule waits for the datapath to pick up the rule
+	r := R.lbBatchFind(serv)
+	if r != nil && r.sync != 0 {
+		return undo, RuleAllocErr, errors.New("lbrule dp-sync error")
+	}
+
+	return undo, 0, nil
+}
+
+// lbBatchDel - delete a LB rule as part of a batch
+func (R *RuleH) lbBatchDel(lm *cmn.LbRuleMod) (func() (int, error), int, error) {
+	prev := R.lbBatchSnapshot(lm.Serv)
+
+	ret, err := R.DeleteLbRule(lm.Serv)
+	if err != nil {
+		return nil, ret, err
+	}
+
+	undo := func() (int, error) {
+		if prev == nil {
+			return 0, nil
+		}
+		return R.AddLbRule(prev.Serv, prev.SecIPs, prev.SrcIPs, prev.Eps)
+	}
+	return undo, 0, nil
+}
+
+// LbRuleBatch - apply a batch of LB rule operations in order. Either all of
+// them are applied or, if any of them fails, the ones already applied are
+// rolled back. The outcome of each operation is reported
+func (R *RuleH) LbRuleBatch(ops []cmn.LbRuleBatchOp) ([]cmn.LbRuleBatchResult, int, error) {
+	var undos []lbBatchUndo
+	var fRet int
+	var fErr error
+
+	res := make([]cmn.LbRuleBatchResult, len(ops))
+	for i, op := range ops {
+		res[i] = cmn.LbRuleBatchResult{Index: i, Oper: op.Oper, Status: cmn.LBBatchSkipped}
+		if op.Oper != cmn.LBBatchOpAdd && op.Oper != cmn.LBBatchOpDel {
+			res[i].Status = cmn.LBBatchFailed
+			res[i].Code = RuleArgsErr
+			res[i].Error = "lbrule batch oper error"
+			return res, RuleArgsErr, errors.New("lbrule batch oper error")
+		}
+	}
+
+	for i := range ops {
+		var undo func() (int, error)
+
+		if ops[i].Oper == cmn.LBBatchOpAdd {
+			undo, fRet, fErr = R.lbBatchAdd(&ops[i].Rule)
+		} else {
+			undo, fRet, fErr = R.lbBatchDel(&ops[i].Rule)
+		}
+
+		if fErr != nil {
+			res[i].Status = cmn.LBBatchFailed
+			res[i].Code = fRet
+			res[i].Error = fErr.Error()
+			if undo != nil {
+				undos = append(undos, lbBatchUndo{idx: i, undo: undo})
+			}
+			break
+		}
+
+		res[i].Status = cmn.LBBatchApplied
+		undos = append(undos, lbBatchUndo{idx: i, applied: true, undo: undo})
+	}
+
+	if fErr == nil {
+		tk.LogIt(tk.LogDebug, "lb-rule batch of %d applied\n", len(ops))
+		return res, 0, nil
+	}
+
+	tk.LogIt(tk.LogError, "lb-rule batch failed: %s, rolling back\n", fErr)
+	for i := len(undos) - 1; i >= 0; i-- {
+		if _, err := undos[i].undo(); err != nil {
+			tk.LogIt(tk.LogError, "lb-rule batch rollback of op %d failed: %s\n", undos[i].idx, err)
+			continue
+		}
+		if undos[i].applied {
+			res[undos[i].idx].Status = cmn.LBBatchRolledBack
+		}
+	}
+
+	return res, fRet, fErr
+}
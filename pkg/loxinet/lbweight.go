@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements dynamic weights of LB end-points for weighted least
+// connections and least response time selection. The weights are refreshed
+// periodically from the active connections seen in conntrack sweeps or from
+// probe response times and are programmed to the DP like wRR weights.
+
+// dynamic weight constants
+const (
+	DynWeightSyncDuration = 10 // Duration of periodic dynamic weight refresh
+)
+
+// wrrSlots - check if end-points of a LB rule are expanded into DP slots as
+// per their (configured or dynamic) weights
+func (at *ruleLBActs) wrrSlots() bool {
+	return at.sel == cmn.LbSelPrio || at.sel == cmn.LbSelWeightedLeastConnections ||
+		at.sel == cmn.LbSelLeastResponseTime
+}
+
+// slotted - check if end-points of a LB rule are laid out in DP slots which
+// don't map one to one to end-points
+func (at *ruleLBActs) slotted() bool {
+	return at.wrrSlots() || at.sel == cmn.LbSelMaglev
+}
+
+// lbEpDelay - probe response time of an end-point of a LB rule, 0 if unknown
+func (R *RuleH) lbEpDelay(rule *ruleEnt, ep *ruleLBEp) time.Duration {
+	pType := HostProbePing
+	pPort := uint16(0)
+	switch rule.tuples.l4Prot.val {
+	case 6:
+		pType = HostProbeConnectTCP
+		pPort = ep.xPort
+	case 17:
+		pType = HostProbeConnectUDP
+		pPort = ep.xPort
+	case 132:
+		pType = HostProbeConnectSCTP
+		pPort = ep.xPort
+	}
+	host := R.epMap[makeEPKey(ep.xIP.String(), pType, pPort)]
+	if host == nil || host.inactive {
+		return 0
+	}
+	return host.avgDelay
+}
+
+// lbDynWeights - compute dynamic weights of end-points of a LB rule. Each
+// end-point gets a share of 100 which grows with its configured weight and
+// shrinks with its active connections or response time. End-points without
+// a response time are taken to be as fast as the average end-point
+func (R *RuleH) lbDynWeights(rule *ruleEnt, at *ruleLBActs) []uint8 {
+	weights := make([]uint8, len(at.endPoints))
+	score := make([]float64, len(at.endPoints))
+	delays := make([]time.Duration, len(at.endPoints))
+
+	var totDelay time.Duration
+	nDelay := 0
+	if at.sel == cmn.LbSelLeastResponseTime {
+		for idx := range at.endPoints {
+			delays[idx] = R.lbEpDelay(rule, &at.endPoints[idx])
+			if delays[idx] > 0 {
+				totDelay += delays[idx]
+				nDelay++
+			}
+		}
+	}
+
+	tot := 0.0
+	for idx := range at.endPoints {
+		ep := &at.endPoints[idx]
+		if ep.inActiveEP || ep.noService || ep.ol.ejected {
+			continue
+		}
+		w := float64(ep.weight)
+		if w == 0 {
+			w = 1
+		}
+		if at.sel == cmn.LbSelLeastResponseTime {
+			d := delays[idx]
+			if d == 0 && nDelay > 0 {
+				d = totDelay / time.Duration(nDelay)
+			}
+			if d < time.Microsecond {
+				d = time.Microsecond
+			}
+			score[idx] = w / float64(d.Microseconds())
+		} else {
+			score[idx] = w / float64(ep.conns+1)
+		}
+		tot += score[idx]
+	}
+
+	if tot == 0 {
+		return weights
+	}
+	for idx := range score {
+		if score[idx] == 0 {
+			continue
+		}
+		w := int(100*score[idx]/tot + 0.5)
+		if w == 0 {
+			w = 1
+		}
+		weights[idx] = uint8(w)
+	}
+	return weights
+}
+
+// dynWeightSync - refresh dynamic weights of end-points of LB rules with
+// weighted least connections or least response time selection. Rules are
+// reprogrammed only if the DP slots of an end-point change
+func (R *RuleH) dynWeightSync() {
+	if time.Since(R.dynWST) < DynWeightSyncDuration*time.Second {
+		return
+	}
+	R.dynWST = time.Now()
+
+	for _, rule := range R.tables[RtLB].eMap {
+		at, ok := rule.act.action.(*ruleLBActs)
+		if !ok || !at.wrrSlots() || at.sel == cmn.LbSelPrio {
+			continue
+		}
+		rChg := false
+		for idx, w := range R.lbDynWeights(rule, at) {
+			ep := &at.endPoints[idx]
+			if int(w)*MaxLBPrioSlots/100 != int(ep.dynWeight)*MaxLBPrioSlots/100 {
+				rChg = true
+			}
+			ep.dynWeight = w
+		}
+		if rChg {
+			tk.LogIt(tk.LogDebug, "lb-rule %s dynamic weights updated\n", rule.tuples.String())
+			rule.DP(DpCreate)
+		}
+	}
+}
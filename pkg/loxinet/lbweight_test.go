@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+// dynWeightTestRule - LB rule of a unit-test zone with the given selection
+// and end-point weights
+func dynWeightTestRule(zone *Zone, sel cmn.EpSelect, weights ...uint8) (*ruleEnt, *ruleLBActs) {
+	r := testLbRule(zone, cmn.LbServiceArg{ServPort: 80, Proto: "tcp", Sel: sel}, "20.20.20.1", len(weights))
+	at := r.act.action.(*ruleLBActs)
+	for i, w := range weights {
+		at.endPoints[i].weight = w
+	}
+	return r, at
+}
+
+// dynWeightTestDelay - record the probe response time of an end-point
+func dynWeightTestDelay(R *RuleH, ep *ruleLBEp, delay time.Duration, inactive bool) {
+	key := makeEPKey(ep.xIP.String(), HostProbeConnectTCP, ep.xPort)
+	R.epMap[key] = &epHost{epKey: key, hostName: ep.xIP.String(), avgDelay: delay, inactive: inactive}
+}
+
+func TestLbDynWeightsLeastConn(t *testing.T) {
+	tests := []struct {
+		name     string
+		weights  []uint8
+		conns    []int
+		inactive int
+		want     []uint8
+	}{
+		{"idle", []uint8{1, 1}, []int{0, 0}, -1, []uint8{50, 50}},
+		{"busy end-point", []uint8{1, 1}, []int{0, 9}, -1, []uint8{91, 9}},
+		{"weighted", []uint8{3, 1}, []int{4, 4}, -1, []uint8{75, 25}},
+		{"weight 0 counts as 1", []uint8{0, 1}, []int{0, 0}, -1, []uint8{50, 50}},
+		{"inactive end-point", []uint8{1, 1, 1}, []int{0, 0, 0}, 1, []uint8{50, 0, 50}},
+		{"never below 1", []uint8{1, 1}, []int{0, 999}, -1, []uint8{100, 1}},
+	}
+
+	for _, tc := range tests {
+		zone, _ := testZone(t)
+		r, at := dynWeightTestRule(zone, cmn.LbSelWeightedLeastConnections, tc.weights...)
+		for i := range at.endPoints {
+			at.endPoints[i].conns = tc.conns[i]
+			at.endPoints[i].inActiveEP = i == tc.inactive
+		}
+		got := zone.Rules.lbDynWeights(r, at)
+		if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("%s: weights %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLbDynWeightsResponseTime(t *testing.T) {
+	// dynWeightTestProbe - probe response time of an end-point
+	type dynWeightTestProbe struct {
+		delay    time.Duration
+		inactive bool
+	}
+	tests := []struct {
+		name    string
+		weights []uint8
+		probes  map[int]dynWeightTestProbe
+		down    bool
+		want    []uint8
+	}{
+		// An end-point without a response time is taken as the average one
+		{"average response time", []uint8{1, 1, 1},
+			map[int]dynWeightTestProbe{0: {time.Millisecond, false}, 1: {3 * time.Millisecond, false}}, false, []uint8{55, 18, 27}},
+		{"inactive host", []uint8{1, 1, 1},
+			map[int]dynWeightTestProbe{0: {time.Millisecond, false}, 1: {3 * time.Millisecond, false}, 2: {time.Microsecond, true}},
+			false, []uint8{55, 18, 27}},
+		// Without any response time all end-points are equally fast
+		{"no response times", []uint8{2, 1, 1}, nil, false, []uint8{50, 25, 25}},
+		// Nothing to select if all end-points are down
+		{"all down", []uint8{1, 1, 1}, map[int]dynWeightTestProbe{0: {time.Millisecond, false}}, true, []uint8{0, 0, 0}},
+	}
+
+	for _, tc := range tests {
+		zone, _ := testZone(t)
+		r, at := dynWeightTestRule(zone, cmn.LbSelLeastResponseTime, tc.weights...)
+		for idx, p := range tc.probes {
+			dynWeightTestDelay(zone.Rules, &at.endPoints[idx], p.delay, p.inactive)
+		}
+		for i := range at.endPoints {
+			at.endPoints[i].noService = tc.down
+		}
+		if got := zone.Rules.lbDynWeights(r, at); fmt.Sprint(got) != fmt.Sprint(tc.want) {
+			t.Errorf("%s: weights %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLbLeastResponseTimeNeedsMonitor(t *testing.T) {
+	zone, _ := testZone(t)
+	eps := []cmn.LbEndPointArg{{EpIP: "31.31.31.1", EpPort: 8080, Weight: 1}}
+
+	for _, serv := range []cmn.LbServiceArg{
+		{ServIP: "10.10.10.1", ServPort: 80, Proto: "tcp", Sel: cmn.LbSelLeastResponseTime},
+		{ServIP: "10.10.10.1", ServPort: 80, Proto: "tcp", Sel: cmn.LbSelLeastResponseTime, Monitor: true, ProbeType: HostProbeNone},
+	} {
+		if ret, err := zone.Rules.AddLbRule(serv, nil, nil, eps); err == nil || ret != RuleArgsErr {
+			t.Errorf("lrt rule %+v added without monitoring: %d %v", serv, ret, err)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime/debug"
 	"runtime/pprof"
 	"strings"
@@ -31,12 +32,14 @@ import (
 	"time"
 
 	apiserver "github.com/loxilb-io/loxilb/api"
+	"github.com/loxilb-io/loxilb/api/grpcapi"
 	k8s "github.com/loxilb-io/loxilb/api/k8s"
 	nlp "github.com/loxilb-io/loxilb/api/loxinlp"
 	prometheus "github.com/loxilb-io/loxilb/api/prometheus"
 	cmn "github.com/loxilb-io/loxilb/common"
 	opts "github.com/loxilb-io/loxilb/options"
 	"github.com/loxilb-io/loxilb/pkg/logrotate"
+	"github.com/loxilb-io/loxilb/pkg/store"
 	"github.com/loxilb-io/loxilb/pkg/user"
 	utils "github.com/loxilb-io/loxilb/pkg/utils"
 	tk "github.com/loxilb-io/loxilib"
@@ -89,6 +92,8 @@ type loxiNetH struct {
 	pFile            *os.File
 	UserService      *user.UserService
 	OauthUserService *user.OauthUserService
+	watch            *WatchH
+	store            *store.Store
 }
 
 // NodeWalker - an implementation of node walker interface
@@ -198,7 +203,13 @@ func loxiNetTicker(bgpPeerMode bool) {
 								}
 							}
 							if cleanCloudResources {
-								mh.cloudHook.CloudDestroyVIPNetWork()
+								err := mh.cloudHook.CloudDestroyVIPNetWork()
+								mh.mtx.Lock()
+								mh.has.CICloudStatusUpdate("destroy-vip-network", err)
+								mh.mtx.Unlock()
+								if err != nil {
+									tk.LogIt(tk.LogError, "%s: vip network destroy failed. err: %v\n", mh.cloudLabel, err)
+								}
 							}
 						}
 					}
@@ -268,6 +279,9 @@ func loxiNetInit() {
 	// FILE* we cannot wrap — rotate it copy-truncate style from a sweeper.
 	logrotate.StartSweeper("/var/log/loxilbdp.log", rotCfg, time.Minute)
 
+	// Initialize the watch event broker
+	mh.watch = WatchInit()
+
 	kaArgs := KAString2Mode(opts.Opts.Ka, opts.Opts.ClusterInterface)
 	clusterMode := false
 	if opts.Opts.ClusterNodes != "none" {
@@ -380,16 +394,42 @@ func loxiNetInit() {
 		mh.bgp = GoBgpInit(opts.Opts.BgpPeerMode)
 	}
 
+	// Open the config store and replay the persisted config before any
+	// config can be accepted
+	if opts.Opts.ConfigStore && !opts.Opts.BgpPeerMode {
+		mh.store = storeOpen(filepath.Join(opts.Opts.ConfigPath, "store"))
+		if mh.store != nil {
+			storeReplay(NetAPIInit(opts.Opts.BgpPeerMode))
+		}
+	}
+
+	// Load custom roles before any user can log in
+	if opts.Opts.RBACConfig != "" {
+		if err := user.LoadRBACConfig(opts.Opts.RBACConfig); err != nil {
+			tk.LogIt(tk.LogError, "failed to load rbac config %s : %v\n", opts.Opts.RBACConfig, err)
+		}
+	}
+
 	// Initialize and spawn the api server subsystem
 	if !opts.Opts.NoAPI {
 		apiserver.RegisterAPIHooks(NetAPIInit(opts.Opts.BgpPeerMode))
 		go apiserver.RunAPIServer()
 		apiserver.WaitAPIServerReady()
+		if opts.Opts.GRPCPort != 0 {
+			go func() {
+				if err := grpcapi.RunGRPCServer(); err != nil {
+					tk.LogIt(tk.LogError, "gRPC api server failed : %v\n", err)
+				}
+			}()
+		}
 	}
 
 	// Initialize the nlp subsystem
 	if !opts.Opts.NoNlp {
 		nlp.NlpRegister(NetAPIInit(opts.Opts.BgpPeerMode))
+		if mh.store != nil {
+			nlp.NlpStoreRegister(mh.store)
+		}
 		nlp.NlpInit(opts.Opts.BgpPeerMode, opts.Opts.BlackList, opts.Opts.WhiteList, opts.Opts.IPVSCompat)
 	}
 
@@ -412,6 +452,11 @@ func loxiNetInit() {
 	if opts.Opts.UserServiceEnable {
 		tk.LogIt(tk.LogInfo, "User service enabled\n")
 		mh.UserService = user.NewUserService()
+		if mh.UserService.DB != nil {
+			if users, err := mh.UserService.GetUsers(); err == nil {
+				user.WarnUnknownRoles(users)
+			}
+		}
 	}
 
 	// Initialize the Oauth user service subsystem
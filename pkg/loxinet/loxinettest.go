@@ -20,9 +20,12 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	cmn "github.com/loxilb-io/loxilb/common"
 	opts "github.com/loxilb-io/loxilb/options"
+	utils "github.com/loxilb-io/loxilb/pkg/utils"
+	tk "github.com/loxilb-io/loxilib"
 )
 
 // TestLoxinet - Go unit test entry point
@@ -299,6 +302,86 @@ func TestLoxinet(t *testing.T) {
 		t.Errorf("failed to delete nat lb rule for 10.10.10.1\n")
 	}
 
+	// IPAM pool allocation
+	_, err = mh.zr.Ipam.IPPoolAdd(cmn.IPPoolMod{Name: "pool1", CIDR: "20.20.20.0/30"})
+	if err != nil {
+		t.Errorf("failed to add ippool pool1\n")
+	}
+
+	lbServ = cmn.LbServiceArg{ServIP: "pool1", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr, Name: "ipam1"}
+	_, err = mh.zr.Rules.AddLbRule(lbServ, nil, nil, lbEps[:])
+	if err != nil {
+		t.Errorf("failed to add nat lb rule from pool1\n")
+	}
+
+	vip, err := mh.zr.Ipam.IpamServIPResolve(&lbServ, false)
+	if err != nil || vip != "20.20.20.1" {
+		t.Errorf("wrong ipam vip for ipam1 - %s\n", vip)
+	}
+
+	_, err = mh.zr.Ipam.IPPoolDelete(cmn.IPPoolMod{Name: "pool1"})
+	if err == nil {
+		t.Errorf("deleted ippool pool1 which is in use\n")
+	}
+
+	_, err = mh.zr.Rules.DeleteLbRule(lbServ)
+	if err != nil {
+		t.Errorf("failed to delete nat lb rule from pool1\n")
+	}
+
+	_, err = mh.zr.Ipam.IPPoolDelete(cmn.IPPoolMod{Name: "pool1"})
+	if err != nil {
+		t.Errorf("failed to delete ippool pool1\n")
+	}
+
+	// LB rule batch with a failing op is rolled back
+	batch := []cmn.LbRuleBatchOp{
+		{Oper: cmn.LBBatchOpAdd, Rule: cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.2", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: lbEps}},
+		{Oper: cmn.LBBatchOpAdd, Rule: cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.3", ServPort: 2020, Proto: "foo", Sel: cmn.LbSelRr}, Eps: lbEps}},
+		{Oper: cmn.LBBatchOpAdd, Rule: cmn.LbRuleMod{Serv: cmn.LbServiceArg{ServIP: "10.10.10.4", ServPort: 2020, Proto: "tcp", Sel: cmn.LbSelRr}, Eps: lbEps}},
+	}
+	bRes, _, err := mh.zr.Rules.LbRuleBatch(batch)
+	if err == nil {
+		t.Errorf("lb rule batch with bad proto succeeded\n")
+	}
+	if len(bRes) != 3 || bRes[0].Status != cmn.LBBatchRolledBack ||
+		bRes[1].Status != cmn.LBBatchFailed || bRes[2].Status != cmn.LBBatchSkipped {
+		t.Errorf("wrong lb rule batch result %v\n", bRes)
+	}
+	if mh.zr.Rules.lbBatchFind(batch[0].Rule.Serv) != nil {
+		t.Errorf("lb rule batch not rolled back for 10.10.10.2\n")
+	}
+
+	batch[1].Oper = cmn.LBBatchOpDel
+	batch[1].Rule.Serv = batch[0].Rule.Serv
+	_, _, err = mh.zr.Rules.LbRuleBatch(batch[:2])
+	if err != nil {
+		t.Errorf("failed lb rule batch add-delete for 10.10.10.2 - %s\n", err)
+	}
+	if mh.zr.Rules.lbBatchFind(batch[0].Rule.Serv) != nil {
+		t.Errorf("lb rule batch add-delete left 10.10.10.2\n")
+	}
+
+	// Watch events of the batch above can be replayed
+	evCh, wCancel, err := mh.watch.WatchSubscribe([]string{cmn.WatchKindLB}, 1)
+	if err != nil {
+		t.Errorf("failed to watch lb rules - %s\n", err)
+	} else {
+		select {
+		case ev := <-evCh:
+			if ev.Kind != cmn.WatchKindLB || ev.Version <= 1 {
+				t.Errorf("wrong lb rule watch event %v\n", ev)
+			}
+		default:
+			t.Errorf("no lb rule watch events replayed\n")
+		}
+		wCancel()
+	}
+	_, _, err = mh.watch.WatchSubscribe(nil, mh.watch.version+1)
+	if err == nil {
+		t.Errorf("watch from a future version succeeded\n")
+	}
+
 	// Session information
 	anTun := cmn.SessTun{TeID: 1, Addr: net.IP{172, 17, 1, 231}} // An TeID, gNBIP
 	cnTun := cmn.SessTun{TeID: 1, Addr: net.IP{172, 17, 1, 50}}  // Cn TeID, MyIP
@@ -412,3 +495,95 @@ func TestLoxinet(t *testing.T) {
 	mh.zr.Rt.Trie4.Trie2String(mh.zr.Rt)
 
 }
+
+// testDpHooks - DP hooks of unit-test zones. Rules are taken as installed
+// and rule counters are returned from packets, by rule number
+type testDpHooks struct {
+	DpHookInterface
+	packets map[uint32]uint64
+}
+
+func (d *testDpHooks) DpLBRuleAdd(w *LBDpWorkQ) int { return 0 }
+func (d *testDpHooks) DpLBRuleDel(w *LBDpWorkQ) int { return 0 }
+func (d *testDpHooks) DpFwRuleAdd(w *FwDpWorkQ) int { return 0 }
+func (d *testDpHooks) DpFwRuleDel(w *FwDpWorkQ) int { return 0 }
+
+func (d *testDpHooks) DpStat(w *StatDpWorkQ) int {
+	*w.Packets = d.packets[w.Mark]
+	*w.Bytes = d.packets[w.Mark] * 100
+	return 0
+}
+
+// testZone - zone with empty rule tables, IPAM pools and IP sets for
+// unit-tests. It is the zone of mh, along with a DP working with testDpHooks,
+// until the test ends
+func testZone(t *testing.T) (*Zone, *testDpHooks) {
+	zone := new(Zone)
+	zone.Rules = new(RuleH)
+	zone.Rules.zone = zone
+	zone.Rules.vipMap = make(map[string]*vipElem)
+	zone.Rules.epMap = make(map[string]*epHost)
+	zone.Rules.lbSrcMap = make(map[string]*allowedSrcElem)
+	zone.Rules.srcMark = tk.NewCounter(1, RtMaximumFw4s)
+	zone.Rules.execSem = make(chan struct{}, 1)
+	zone.Rules.tables[RtFw].eMap = make(map[string]*ruleEnt)
+	zone.Rules.tables[RtFw].Mark = utils.NewMarker(1, RtMaximumFw4s)
+	zone.Rules.tables[RtLB].eMap = make(map[string]*ruleEnt)
+	zone.Rules.tables[RtLB].Mark = utils.NewMarker(1, RtMaximumLbs)
+	zone.Ipam = IpamInit(zone)
+	zone.IPSets = IPSetInit(zone)
+
+	hooks := &testDpHooks{packets: make(map[uint32]uint64)}
+	dp := &DpH{ToDpCh: make(chan interface{}, 64), DpHooks: hooks}
+	done := make(chan struct{})
+	go func() {
+		for m := range dp.ToDpCh {
+			DpWorkSingle(dp, m)
+		}
+		close(done)
+	}()
+
+	savedDp, savedZr := mh.dp, mh.zr
+	mh.dp, mh.zr = dp, zone
+	t.Cleanup(func() {
+		close(dp.ToDpCh)
+		<-done
+		mh.dp, mh.zr = savedDp, savedZr
+	})
+	return zone, hooks
+}
+
+// testLbRule - tcp LB rule of a unit-test zone for serv with its VIP resolved
+// to vip. It selects end-points 31.31.31.1:8080 and onwards as given by serv
+func testLbRule(zone *Zone, serv cmn.LbServiceArg, vip string, nEps int) *ruleEnt {
+	at := &ruleLBActs{sel: serv.Sel, outlier: makeRuleOutlier(&serv)}
+	for i := 0; i < nEps; i++ {
+		at.endPoints = append(at.endPoints, ruleLBEp{xIP: net.ParseIP(fmt.Sprintf("31.31.31.%d", i+1)), xPort: 8080, weight: 1})
+	}
+
+	r := new(ruleEnt)
+	r.zone = zone
+	r.ruleNum = uint64(len(zone.Rules.tables[RtLB].eMap) + 1)
+	r.tuples.l3Dst.addr = net.IPNet{IP: net.ParseIP(vip), Mask: net.CIDRMask(32, 32)}
+	r.tuples.l4Prot = rule8Tuple{6, 0xff}
+	r.tuples.l4Dst = rule16RTuple{serv.ServPort, serv.ServPort, true}
+	r.act.actType = RtActDnat
+	r.act.action = at
+	r.ipamKey = cmn.LbServOwnerKey(&serv)
+	r.sT = time.Now()
+	zone.Rules.tables[RtLB].eMap[r.tuples.ruleKey()] = r
+	return r
+}
+
+// testFwRule - drop firewall rule of a unit-test zone from src, installed at
+// sT. Its counters are the ones testDpHooks has for num
+func testFwRule(zone *Zone, num uint64, src string, sT time.Time) *ruleEnt {
+	_, pref, _ := net.ParseCIDR(src)
+	r := &ruleEnt{zone: zone, ruleNum: num, sT: sT}
+	r.tuples.l3Src.addr = *pref
+	r.tuples.pref = uint32(num)
+	r.act.actType = RtActDrop
+	r.act.action = &ruleFwOpts{op: RtActDrop}
+	zone.Rules.tables[RtFw].eMap[r.tuples.ruleKey()] = r
+	return r
+}
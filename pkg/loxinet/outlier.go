@@ -0,0 +1,370 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements passive outlier detection of LB end-points. The DP
+// conntrack table is swept periodically and the outcome of new connections
+// is accounted to the end-point they were sent to. End-points which fail
+// too often are ejected from their LB rule for a while. The same sweep
+// counts active connections of end-points for weighted least connections.
+
+// outlier detection constants
+const (
+	OutlierSweepDuration   = 10                          // Duration of periodic conntrack sweeps
+	OutlierMinConns        = 10                          // Min new connections for error rate check
+	DflOutlierEjectTime    = cmn.LbDflOutlierEjectTime   // Default base ejection time
+	DflOutlierMaxEjectPct  = cmn.LbDflOutlierMaxEjectPct // Default max percent of ejected end-points
+	MaxOutlierConsecErrs   = 1000                        // Max consecutive errors
+	MaxOutlierEjectTime    = 3600                        // Max ejection time
+	outlierEjectBackoffMax = 6                           // Max doublings of the ejection time
+)
+
+// outcome of a connection
+const (
+	outlierConnUnknown = iota
+	outlierConnOk
+	outlierConnFail
+	outlierConnPending
+)
+
+// ruleOutlier - outlier detection settings of a LB rule
+type ruleOutlier struct {
+	consecErrs int
+	errRate    uint8
+	ejectTime  uint32
+	maxEject   uint8
+}
+
+// epOutlier - outlier detection state of an end-point of a LB rule
+type epOutlier struct {
+	ejected bool
+	consec  int
+	ejects  int
+	until   time.Time
+	decayT  time.Time
+}
+
+// outlierKey - identifies an end-point of a LB rule in conntrack entries
+type outlierKey struct {
+	ruleID uint32
+	xIP    string
+	xPort  uint16
+}
+
+// outlierStat - new connections of an end-point during a sweep and its
+// active connections
+type outlierStat struct {
+	ok     int
+	fail   int
+	active int
+}
+
+// outlierH - context container of outlier detection
+type outlierH struct {
+	mtx   sync.Mutex
+	busy  bool
+	sT    time.Time
+	stats map[outlierKey]*outlierStat
+	// seen has the conntrack entries of the last sweep, true if they
+	// have been accounted already
+	seen map[string]bool
+}
+
+// outlierConnState - outcome of a connection given its conntrack state
+func outlierConnState(cState string) int {
+	switch cState {
+	case "est", "udp-est", "bidir", "fini", "closed-wait",
+		"shut", "shut-ack", "shut-complete":
+		return outlierConnOk
+	case "h/e", "err", "abort", "dest-unr":
+		return outlierConnFail
+	case "sync-sent", "sync-ack", "udp-uni", "req-sent", "pre-est",
+		"init", "init-ack", "cookie-echo", "cookie-echo-resp":
+		return outlierConnPending
+	}
+	return outlierConnUnknown
+}
+
+// outlierConnActive - check if a connection is active given its conntrack state
+func outlierConnActive(cState string) bool {
+	switch cState {
+	case "est", "udp-est", "bidir", "sync-sent", "sync-ack", "udp-uni",
+		"req-sent", "pre-est", "init", "init-ack", "cookie-echo", "cookie-echo-resp":
+		return true
+	}
+	return false
+}
+
+// validateOutlierArgs - validate outlier detection settings of a LB rule
+func validateOutlierArgs(serv *cmn.LbServiceArg) error {
+	if serv.OutlierConsecErrors < 0 || serv.OutlierConsecErrors > MaxOutlierConsecErrs ||
+		serv.OutlierErrorRate > 100 || serv.OutlierMaxEjectPercent > 100 ||
+		serv.OutlierEjectTime > MaxOutlierEjectTime {
+		return errors.New("malformed-service-outlier error")
+	}
+	return nil
+}
+
+// makeRuleOutlier - make outlier detection settings of a LB rule. Outlier
+// detection is enabled only for rules which set consecutive errors or an
+// error rate
+func makeRuleOutlier(serv *cmn.LbServiceArg) ruleOutlier {
+	n := *serv
+	cmn.LbServOutlierNormalize(&n)
+	return ruleOutlier{consecErrs: n.OutlierConsecErrors, errRate: n.OutlierErrorRate,
+		ejectTime: n.OutlierEjectTime, maxEject: n.OutlierMaxEjectPercent}
+}
+
+// enabled - check if outlier detection is enabled
+func (ol *ruleOutlier) enabled() bool {
+	return ol.consecErrs != 0 || ol.errRate != 0
+}
+
+// collect - sweep the DP conntrack table and account new connections to the
+// end-points they were sent to. A connection which is still not established
+// in the next sweep is accounted as a failure (timeout)
+func (o *outlierH) collect() {
+	stats := make(map[outlierKey]*outlierStat)
+	seen := make(map[string]bool)
+
+	nTable := new(TableDpWorkQ)
+	nTable.Work = DpMapGet
+	nTable.Name = MapNameCt4
+
+	ret, err := mh.dp.DpWorkOnTableOp(nTable)
+	if err == nil {
+		if ctMap, ok := ret.(map[string]*DpCtInfo); ok {
+			for k, ct := range ctMap {
+				if ct.XIP == nil {
+					continue
+				}
+				key := outlierKey{ruleID: ct.RuleID, xIP: ct.XIP.String(), xPort: ct.XPort}
+				st := stats[key]
+				if st == nil {
+					st = new(outlierStat)
+					stats[key] = st
+				}
+				if outlierConnActive(ct.CState) {
+					st.active++
+				}
+
+				done, found := o.seen[k]
+				if done {
+					seen[k] = true
+					continue
+				}
+				res := outlierConnState(ct.CState)
+				if res == outlierConnUnknown {
+					continue
+				}
+				if res == outlierConnPending {
+					if !found {
+						seen[k] = false
+						continue
+					}
+					res = outlierConnFail
+				}
+				seen[k] = true
+
+				if res == outlierConnOk {
+					st.ok++
+				} else {
+					st.fail++
+				}
+			}
+		}
+	} else {
+		tk.LogIt(tk.LogError, "outlier: conntrack get failed %s\n", err)
+	}
+
+	o.seen = seen
+	o.mtx.Lock()
+	o.stats = stats
+	o.busy = false
+	o.mtx.Unlock()
+}
+
+// outlierSyncRule - eject or readmit end-points of a LB rule based on the
+// connections seen in the last sweep. Returns true if the rule changed
+func (R *RuleH) outlierSyncRule(rule *ruleEnt, stats map[outlierKey]*outlierStat, now time.Time) bool {
+	rChg := false
+	at := rule.act.action.(*ruleLBActs)
+	ejectTime := time.Duration(at.outlier.ejectTime) * time.Second
+
+	nEps := 0
+	nEjected := 0
+	for idx := range at.endPoints {
+		ep := &at.endPoints[idx]
+		if ep.inActiveEP {
+			continue
+		}
+		nEps++
+		if !ep.ol.ejected {
+			continue
+		}
+		if now.After(ep.ol.until) {
+			ep.ol.ejected = false
+			ep.ol.consec = 0
+			ep.ol.decayT = now
+			rChg = true
+			tk.LogIt(tk.LogInfo, "lb-rule %s: outlier ep %s:%d readmitted\n",
+				rule.tuples.String(), ep.xIP.String(), ep.xPort)
+			continue
+		}
+		nEjected++
+	}
+
+	// Allow one end-point to be ejected unless it is the only one
+	maxEjected := nEps * int(at.outlier.maxEject) / 100
+	if maxEjected == 0 && nEps > 1 {
+		maxEjected = 1
+	}
+
+	for idx := range at.endPoints {
+		ep := &at.endPoints[idx]
+		if ep.inActiveEP || ep.ol.ejected {
+			continue
+		}
+		// Ejections are forgotten one at a time while an end-point behaves
+		if ep.ol.ejects > 0 && now.Sub(ep.ol.decayT) >= ejectTime {
+			ep.ol.ejects--
+			ep.ol.decayT = now
+		}
+		st := stats[outlierKey{ruleID: uint32(rule.ruleNum), xIP: ep.xIP.String(), xPort: ep.xPort}]
+		if st == nil {
+			continue
+		}
+		if st.ok > 0 {
+			ep.ol.consec = 0
+		} else {
+			ep.ol.consec += st.fail
+		}
+
+		eject := false
+		if at.outlier.consecErrs != 0 && ep.ol.consec >= at.outlier.consecErrs {
+			eject = true
+		}
+		total := st.ok + st.fail
+		if at.outlier.errRate != 0 && total >= OutlierMinConns &&
+			st.fail*100 >= int(at.outlier.errRate)*total {
+			eject = true
+		}
+		if !eject {
+			continue
+		}
+		if nEjected >= maxEjected {
+			tk.LogIt(tk.LogInfo, "lb-rule %s: outlier ep %s:%d not ejected(max ejected)\n",
+				rule.tuples.String(), ep.xIP.String(), ep.xPort)
+			continue
+		}
+
+		// Ejection time doubles on each ejection
+		backoff := ep.ol.ejects
+		if backoff > outlierEjectBackoffMax {
+			backoff = outlierEjectBackoffMax
+		}
+		dur := ejectTime << backoff
+		if dur > MaxOutlierEjectTime*time.Second {
+			dur = MaxOutlierEjectTime * time.Second
+		}
+		ep.ol.ejects++
+		ep.ol.ejected = true
+		ep.ol.until = now.Add(dur)
+		ep.ol.decayT = ep.ol.until
+		nEjected++
+		rChg = true
+		tk.LogIt(tk.LogInfo, "lb-rule %s: outlier ep %s:%d ejected for %v(ok %d fail %d)\n",
+			rule.tuples.String(), ep.xIP.String(), ep.xPort, dur, st.ok, st.fail)
+	}
+
+	return rChg
+}
+
+// outlierSync - apply the last conntrack sweep to LB rules with outlier
+// detection enabled or weighted least connections selection and start the
+// next sweep when due
+func (R *RuleH) outlierSync() {
+	o := &R.outlier
+
+	o.mtx.Lock()
+	stats := o.stats
+	o.stats = nil
+	busy := o.busy
+	o.mtx.Unlock()
+
+	enabled := false
+	now := time.Now()
+	for _, rule := range R.tables[RtLB].eMap {
+		at, ok := rule.act.action.(*ruleLBActs)
+		if !ok {
+			continue
+		}
+		if at.sel == cmn.LbSelWeightedLeastConnections {
+			enabled = true
+			if stats != nil {
+				for idx := range at.endPoints {
+					ep := &at.endPoints[idx]
+					ep.conns = 0
+					if st := stats[outlierKey{ruleID: uint32(rule.ruleNum), xIP: ep.xIP.String(), xPort: ep.xPort}]; st != nil {
+						ep.conns = st.active
+					}
+				}
+			}
+		}
+		if !at.outlier.enabled() {
+			rChg := false
+			for idx := range at.endPoints {
+				if at.endPoints[idx].ol.ejected {
+					rChg = true
+				}
+				at.endPoints[idx].ol = epOutlier{}
+			}
+			if rChg {
+				rule.DP(DpCreate)
+			}
+			continue
+		}
+		enabled = true
+		if R.outlierSyncRule(rule, stats, now) {
+			rule.DP(DpCreate)
+		}
+	}
+
+	if !enabled {
+		if !busy {
+			o.seen = nil
+		}
+		return
+	}
+
+	if !busy && time.Since(o.sT) >= OutlierSweepDuration*time.Second {
+		o.mtx.Lock()
+		o.busy = true
+		o.mtx.Unlock()
+		o.sT = now
+		go o.collect()
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+// outlierTestStats - connection outcomes of end-points of a rule for a sweep
+func outlierTestStats(r *ruleEnt, outcomes map[int][2]int) map[outlierKey]*outlierStat {
+	stats := make(map[outlierKey]*outlierStat)
+	at := r.act.action.(*ruleLBActs)
+	for idx, o := range outcomes {
+		ep := &at.endPoints[idx]
+		stats[outlierKey{ruleID: uint32(r.ruleNum), xIP: ep.xIP.String(), xPort: ep.xPort}] = &outlierStat{ok: o[0], fail: o[1]}
+	}
+	return stats
+}
+
+func outlierTestEjected(r *ruleEnt) []int {
+	res := []int{}
+	for idx, ep := range r.act.action.(*ruleLBActs).endPoints {
+		if ep.ol.ejected {
+			res = append(res, idx)
+		}
+	}
+	return res
+}
+
+func TestMakeRuleOutlier(t *testing.T) {
+	tests := []struct {
+		name    string
+		serv    cmn.LbServiceArg
+		enabled bool
+		want    ruleOutlier
+	}{
+		{"disabled", cmn.LbServiceArg{OutlierEjectTime: 60}, false, ruleOutlier{}},
+		{"defaults", cmn.LbServiceArg{OutlierErrorRate: 50}, true,
+			ruleOutlier{errRate: 50, ejectTime: DflOutlierEjectTime, maxEject: DflOutlierMaxEjectPct}},
+		{"set", cmn.LbServiceArg{OutlierConsecErrors: 3, OutlierEjectTime: 60, OutlierMaxEjectPercent: 50}, true,
+			ruleOutlier{consecErrs: 3, ejectTime: 60, maxEject: 50}},
+	}
+
+	for _, tc := range tests {
+		ol := makeRuleOutlier(&tc.serv)
+		if ol.enabled() != tc.enabled || ol != tc.want {
+			t.Errorf("%s: outlier settings %+v, want %+v", tc.name, ol, tc.want)
+		}
+	}
+}
+
+func TestOutlierEject(t *testing.T) {
+	failing := map[int][2]int{0: {0, 5}, 1: {0, 5}, 2: {0, 5}, 3: {0, 5}}
+	tests := []struct {
+		name     string
+		serv     cmn.LbServiceArg
+		eps      int
+		inactive []int
+		sweeps   []map[int][2]int
+		ejected  []int
+	}{
+		{"below consecutive errors", cmn.LbServiceArg{OutlierConsecErrors: 3, OutlierMaxEjectPercent: 100}, 3, nil,
+			[]map[int][2]int{{0: {0, 2}, 1: {0, 2}}}, []int{}},
+		// A successful connection resets the consecutive errors
+		{"consecutive errors", cmn.LbServiceArg{OutlierConsecErrors: 3, OutlierMaxEjectPercent: 100}, 3, nil,
+			[]map[int][2]int{{0: {0, 2}, 1: {0, 2}}, {0: {0, 1}, 1: {1, 1}}}, []int{0}},
+		{"error rate", cmn.LbServiceArg{OutlierErrorRate: 50, OutlierMaxEjectPercent: 100}, 4, nil,
+			[]map[int][2]int{{
+				0: {4, 6}, // error rate over threshold
+				1: {6, 4}, // error rate below threshold
+				2: {1, 4}, // too few connections
+				3: {5, 5}, // error rate at threshold
+			}}, []int{0, 3}},
+		{"max eject quarter", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 25}, 4, nil,
+			[]map[int][2]int{failing}, []int{0}},
+		{"max eject half", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 50}, 4, nil,
+			[]map[int][2]int{failing}, []int{0, 1}},
+		{"max eject at least one", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 10}, 4, nil,
+			[]map[int][2]int{failing}, []int{0}},
+		{"max eject not the only one", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 50}, 1, nil,
+			[]map[int][2]int{{0: {0, 5}}}, []int{}},
+		{"max eject all", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 100}, 4, nil,
+			[]map[int][2]int{failing}, []int{0, 1, 2, 3}},
+		// Inactive end-points don't count towards the cap
+		{"max eject with inactive", cmn.LbServiceArg{OutlierConsecErrors: 1, OutlierMaxEjectPercent: 50}, 4, []int{2, 3},
+			[]map[int][2]int{failing}, []int{0}},
+	}
+
+	for _, tc := range tests {
+		zone, _ := testZone(t)
+		r := testLbRule(zone, tc.serv, "20.20.20.1", tc.eps)
+		at := r.act.action.(*ruleLBActs)
+		for _, idx := range tc.inactive {
+			at.endPoints[idx].inActiveEP = true
+		}
+		now := time.Now()
+		for _, sweep := range tc.sweeps {
+			zone.Rules.outlierSyncRule(r, outlierTestStats(r, sweep), now)
+		}
+		if ej := outlierTestEjected(r); fmt.Sprint(ej) != fmt.Sprint(tc.ejected) {
+			t.Errorf("%s: ejected %v, want %v", tc.name, ej, tc.ejected)
+		}
+	}
+}
+
+func TestOutlierReadmit(t *testing.T) {
+	zone, _ := testZone(t)
+	R := zone.Rules
+	r := testLbRule(zone, cmn.LbServiceArg{OutlierConsecErrors: 3, OutlierMaxEjectPercent: 100}, "20.20.20.1", 3)
+	at := r.act.action.(*ruleLBActs)
+	now := time.Now()
+
+	if !R.outlierSyncRule(r, outlierTestStats(r, map[int][2]int{0: {0, 3}}), now) {
+		t.Fatalf("end-point not ejected at consecutive errors")
+	}
+	if d := at.endPoints[0].ol.until.Sub(now); d != DflOutlierEjectTime*time.Second {
+		t.Errorf("ejected for %v", d)
+	}
+
+	// Readmitted after the ejection time, and ejected for twice as long next
+	now = at.endPoints[0].ol.until.Add(time.Second)
+	if !R.outlierSyncRule(r, nil, now) || len(outlierTestEjected(r)) != 0 {
+		t.Fatalf("end-point not readmitted")
+	}
+	R.outlierSyncRule(r, outlierTestStats(r, map[int][2]int{0: {0, 3}}), now)
+	if d := at.endPoints[0].ol.until.Sub(now); !at.endPoints[0].ol.ejected || d != 2*DflOutlierEjectTime*time.Second {
+		t.Errorf("second ejection for %v", d)
+	}
+}
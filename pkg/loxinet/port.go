@@ -206,6 +206,10 @@ func (P *PortsH) PortAdd(name string, osid int, ptype int, zone string,
 
 	if P.portSmap[name] != nil {
 		p := P.portSmap[name]
+		if p.HInfo.Link != hwi.Link || p.HInfo.State != hwi.State {
+			mh.watch.WatchPublish(cmn.WatchKindPort, cmn.WatchEvModified, name,
+				cmn.PortStateMod{Name: name, Link: hwi.Link, State: hwi.State})
+		}
 		p.HInfo.Link = hwi.Link
 		p.HInfo.State = hwi.State
 		p.HInfo.Mtu = hwi.Mtu
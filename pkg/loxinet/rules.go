@@ -23,20 +23,25 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/loxilb-io/loxilb/api/loxinlp"
+	prom "github.com/loxilb-io/loxilb/api/prometheus"
 	cmn "github.com/loxilb-io/loxilb/common"
 	utils "github.com/loxilb-io/loxilb/pkg/utils"
 	tk "github.com/loxilb-io/loxilib"
 	probing "github.com/prometheus-community/pro-bing"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // error codes
@@ -81,11 +86,19 @@ const (
 const (
 	MaxLBEndPoints             = 32         // Max number of supported LB end-points
 	MaxLBPrioSlots             = 16         // Max DP slots for wRR expansion; LLB_NAT_STAT_CID keeps only 4 bits of aid so slots beyond 16 alias their stats into slots 0-15
+	MaxLBMaglevSlots           = 13         // Maglev lookup table size, a prime which fits in MaxLBPrioSlots
+	MaxLBMaglevEndPoints       = 4          // Max maglev end-points, so each gets 3 or 4 of the MaxLBMaglevSlots
 	DflLbaInactiveTries        = 2          // Default number of inactive tries before LB arm is turned off
 	MaxDflLbaInactiveTries     = 100        // Max number of inactive tries before LB arm is turned off
+	DflLbaActiveTries          = 1          // Default number of active tries before LB arm is turned on
+	MaxLbSlowStart             = 3600       // Max slow-start window of a LB rule
+	SlowStartSteps             = 10         // Steps in which weight of a LB arm is ramped up on slow-start
 	DflLbaCheckTimeout         = 10         // Default timeout for checking LB arms
 	DflHostProbeTimeout        = 60         // Default probe timeout for end-point host
 	InitHostProbeTimeout       = 15         // Initial probe timeout for end-point host
+	DflHostProbeBackoffMax     = 180        // Default max probe duration of inactive end-point host
+	DflHostProbeJitter         = 10         // Default jitter(percent) of end-point host probe duration
+	ProbeLoadDuration          = 10         // Duration at which end-point probe load is published
 	MaxHostProbeTime           = 24 * 3600  // Max possible host health check duration
 	LbDefaultInactiveTimeout   = 4 * 60     // Default inactive timeout for established sessions
 	LbDefaultInactiveNSTimeout = 20         // Default inactive timeout for non-session oriented protocols
@@ -93,6 +106,7 @@ const (
 	MaxEndPointCheckers        = 4          // Maximum helpers to check endpoint health
 	EndPointCheckerDuration    = 2          // Duration at which ep-helpers will run
 	MaxEndPointSweeps          = 20         // Maximum end-point sweeps per round
+	MaxExecProbes              = 2          // Maximum exec probes running at once
 	VIPSweepDuration           = 30         // Duration of periodic VIP maintenance
 	DefaultPersistTimeOut      = 10800      // Default persistent LB session timeout
 	NatFwMark                  = 0x80000000 // NAT Marker
@@ -192,17 +206,30 @@ const (
 	HostProbeConnectSCTP = "sctp"
 	HostProbeHTTP        = "http"
 	HostProbeHTTPS       = "https"
+	HostProbeGRPC        = "grpc"
+	HostProbeExec        = "exec"
 	HostProbeNone        = "none"
 )
 
 type epHostOpts struct {
 	inActTryThr       int
+	actTryThr         int
+	probeBackoffMax   uint32
+	probeJitter       uint8
 	probeType         string
 	probeReq          string
 	probeResp         string
 	probeDuration     uint32
 	currProbeDuration uint32
 	probePort         uint16
+	probeTimeout      uint32
+	probeHTTP         utils.HTTPProbeSpec
+	httpProbe         *utils.HTTPProbe
+	probeGRPCService  string
+	probeTLS          bool
+	probeExec         string
+	probeExecState    bool
+	execCmd           []string
 	probeActivated    bool
 	egress            bool
 }
@@ -220,6 +247,7 @@ type epHost struct {
 	maxDelay     time.Duration
 	hID          uint8
 	inActTries   int
+	actTries     int
 	opts         epHostOpts
 }
 
@@ -236,6 +264,11 @@ type ruleLBEp struct {
 	stat          ruleStat
 	foldEndPoints []ruleLBEp
 	foldRuleKey   string
+	ssT           time.Time
+	ssStep        int
+	ol            epOutlier
+	conns         int
+	dynWeight     uint8
 }
 
 type ruleLBSIP struct {
@@ -247,10 +280,14 @@ type ruleLBActs struct {
 	sel       cmn.EpSelect
 	endPoints []ruleLBEp
 	// prioSlotOf[slot] is the endPoints index programmed into that DP
-	// slot for LbSelPrio rules. The DP counts stats per slot, not per
-	// endpoint, so stat collection must fold slots back via this map.
+	// slot for rules with slotted() selection. The DP counts stats per slot,
+	// not per endpoint, so stat collection must fold slots back via this map.
 	// nil until the rule has been programmed to the DP.
 	prioSlotOf []int
+	// slowStart is the window in seconds over which the weight of an
+	// end-point which recovered is ramped up to its full weight
+	slowStart uint32
+	outlier   ruleOutlier
 }
 
 type ruleFwOpt struct {
@@ -278,6 +315,8 @@ type ruleAct struct {
 type ruleStat struct {
 	bytes   uint64
 	packets uint64
+	hitPkts uint64
+	lastHit time.Time
 }
 
 type ruleProbe struct {
@@ -288,6 +327,7 @@ type ruleProbe struct {
 	prbResp    string
 	prbTimeo   uint32
 	prbRetries int
+	prbRise    int
 }
 
 type ruleEnt struct {
@@ -312,8 +352,12 @@ type ruleEnt struct {
 	secMode  cmn.LBSec
 	ppv2En   bool
 	egress   bool
+	srcChk   bool
 	srcList  []*allowedSrcElem
+	srcSched map[string]*ruleSched
+	sched    ruleSched
 	locIPs   map[string]struct{}
+	ipamKey  string
 }
 
 type ruleTable struct {
@@ -349,6 +393,7 @@ type RuleCfg struct {
 type epChecker struct {
 	hChk *time.Ticker
 	tD   chan bool
+	nEps int
 }
 
 type vipElem struct {
@@ -380,7 +425,13 @@ type RuleH struct {
 	epMx       sync.RWMutex
 	rootCAPool *x509.CertPool
 	tlsCert    tls.Certificate
+	execSem    chan struct{}
 	vipST      time.Time
+	outlier    outlierH
+	probeCnt   uint64
+	probeST    time.Time
+	dynWST     time.Time
+	fwST       time.Time
 }
 
 // RulesInit - initialize the Rules subsystem
@@ -395,6 +446,8 @@ func RulesInit(zone *Zone) *RuleH {
 	nRh.epMap = make(map[string]*epHost)
 	nRh.lbSrcMap = make(map[string]*allowedSrcElem)
 	nRh.srcMark = tk.NewCounter(1, RtMaximumFw4s)
+	nRh.execSem = make(chan struct{}, MaxExecProbes)
+	nRh.probeST = time.Now()
 	nRh.tables[RtFw].tableMatch = RmMax - 1
 	nRh.tables[RtFw].tableType = RtMf
 	nRh.tables[RtFw].eMap = make(map[string]*ruleEnt)
@@ -773,84 +826,101 @@ func (R *RuleH) GetLBRule() ([]cmn.LbRuleMod, error) {
 	var res []cmn.LbRuleMod
 
 	for _, data := range R.tables[RtLB].eMap {
-		var ret cmn.LbRuleMod
-		// Make Service Arguments
-		ret.Serv.ServIP = data.tuples.l3Dst.addr.IP.String()
-		if data.tuples.l4Prot.val == 6 {
-			ret.Serv.Proto = "tcp"
-		} else if data.tuples.l4Prot.val == 17 {
-			ret.Serv.Proto = "udp"
-		} else if data.tuples.l4Prot.val == 1 {
-			ret.Serv.Proto = "icmp"
-		} else if data.tuples.l4Prot.val == 132 {
-			ret.Serv.Proto = "sctp"
-		} else if data.tuples.l4Prot.val == 0 {
-			ret.Serv.Proto = "none"
-		} else {
-			return []cmn.LbRuleMod{}, errors.New("malformed service proto")
-		}
-		ret.Serv.ServPort = data.tuples.l4Dst.valMin
-		ret.Serv.ServPortMax = data.tuples.l4Dst.valMax
-		if ret.Serv.ServPort == ret.Serv.ServPortMax {
-			ret.Serv.ServPortMax = 0
-		}
-		ret.Serv.Sel = data.act.action.(*ruleLBActs).sel
-		ret.Serv.Mode = data.act.action.(*ruleLBActs).mode
-		ret.Serv.Monitor = data.hChk.actChk
-		ret.Serv.InactiveTimeout = data.iTO
-		ret.Serv.Bgp = data.bgp
-		ret.Serv.BlockNum = data.tuples.pref
-		ret.Serv.Managed = data.managed
-		ret.Serv.Security = data.secMode
-		ret.Serv.ProbeType = data.hChk.prbType
-		ret.Serv.ProbePort = data.hChk.prbPort
-		ret.Serv.ProbeReq = data.hChk.prbReq
-		ret.Serv.ProbeResp = data.hChk.prbResp
-		ret.Serv.Name = data.name
-		ret.Serv.HostUrl = data.tuples.path
-		ret.Serv.ProxyProtocolV2 = data.ppv2En
-		ret.Serv.Egress = data.egress
-		if data.act.actType == RtActSnat {
-			ret.Serv.Snat = true
+		data.DP(DpStatsGetImm)
+		ret, err := R.lbRule2Mod(data)
+		if err != nil {
+			return []cmn.LbRuleMod{}, err
 		}
+		// Make LB rule
+		res = append(res, ret)
+	}
 
-		for _, sip := range data.secIP {
-			ret.SecIPs = append(ret.SecIPs, cmn.LbSecIPArg{SecIP: sip.sIP.String()})
-		}
+	return res, nil
+}
 
-		for _, src := range data.srcList {
-			ret.SrcIPs = append(ret.SrcIPs, cmn.LbAllowedSrcIPArg{Prefix: src.srcPref.String()})
+// lbRule2Mod - pack a LB rule into a cmn.LbRuleMod
+func (R *RuleH) lbRule2Mod(data *ruleEnt) (cmn.LbRuleMod, error) {
+	var ret cmn.LbRuleMod
+	// Make Service Arguments
+	ret.Serv.ServIP = data.tuples.l3Dst.addr.IP.String()
+	if data.tuples.l4Prot.val == 6 {
+		ret.Serv.Proto = "tcp"
+	} else if data.tuples.l4Prot.val == 17 {
+		ret.Serv.Proto = "udp"
+	} else if data.tuples.l4Prot.val == 1 {
+		ret.Serv.Proto = "icmp"
+	} else if data.tuples.l4Prot.val == 132 {
+		ret.Serv.Proto = "sctp"
+	} else if data.tuples.l4Prot.val == 0 {
+		ret.Serv.Proto = "none"
+	} else {
+		return ret, errors.New("malformed service proto")
+	}
+	ret.Serv.ServPort = data.tuples.l4Dst.valMin
+	ret.Serv.ServPortMax = data.tuples.l4Dst.valMax
+	if ret.Serv.ServPort == ret.Serv.ServPortMax {
+		ret.Serv.ServPortMax = 0
+	}
+	ret.Serv.Sel = data.act.action.(*ruleLBActs).sel
+	ret.Serv.Mode = data.act.action.(*ruleLBActs).mode
+	ret.Serv.Monitor = data.hChk.actChk
+	ret.Serv.InactiveTimeout = data.iTO
+	ret.Serv.Bgp = data.bgp
+	ret.Serv.BlockNum = data.tuples.pref
+	ret.Serv.Managed = data.managed
+	ret.Serv.Security = data.secMode
+	ret.Serv.ProbeType = data.hChk.prbType
+	ret.Serv.ProbePort = data.hChk.prbPort
+	ret.Serv.ProbeReq = data.hChk.prbReq
+	ret.Serv.ProbeResp = data.hChk.prbResp
+	ret.Serv.ProbeRise = data.hChk.prbRise
+	ret.Serv.SlowStart = data.act.action.(*ruleLBActs).slowStart
+	if ol := data.act.action.(*ruleLBActs).outlier; ol.enabled() {
+		ret.Serv.OutlierConsecErrors = ol.consecErrs
+		ret.Serv.OutlierErrorRate = ol.errRate
+		ret.Serv.OutlierEjectTime = ol.ejectTime
+		ret.Serv.OutlierMaxEjectPercent = ol.maxEject
+	}
+	ret.Serv.Name = data.name
+	ret.Serv.HostUrl = data.tuples.path
+	ret.Serv.ProxyProtocolV2 = data.ppv2En
+	ret.Serv.Egress = data.egress
+	if data.act.actType == RtActSnat {
+		ret.Serv.Snat = true
+	}
+
+	for _, sip := range data.secIP {
+		ret.SecIPs = append(ret.SecIPs, cmn.LbSecIPArg{SecIP: sip.sIP.String()})
+	}
+
+	ret.SrcIPs = data.lbSrcArgs()
+
+	// Make Endpoints
+	tmpEp := data.act.action.(*ruleLBActs).endPoints
+	for _, ep := range tmpEp {
+		state := "active"
+		if ep.noService {
+			state = "inactive"
+		} else if ep.ol.ejected {
+			state = "ejected"
+		}
+
+		if ep.inActiveEP {
+			continue
 		}
 
-		data.DP(DpStatsGetImm)
-
-		// Make Endpoints
-		tmpEp := data.act.action.(*ruleLBActs).endPoints
-		for _, ep := range tmpEp {
-			state := "active"
-			if ep.noService {
-				state = "inactive"
-			}
-
-			if ep.inActiveEP {
-				continue
-			}
-
-			counterStr := fmt.Sprintf("%v:%v", ep.stat.packets, ep.stat.bytes)
+		counterStr := fmt.Sprintf("%v:%v", ep.stat.packets, ep.stat.bytes)
 
-			ret.Eps = append(ret.Eps, cmn.LbEndPointArg{
-				EpIP:     ep.xIP.String(),
-				EpPort:   ep.xPort,
-				Weight:   ep.weight,
-				State:    state,
-				Counters: counterStr,
-			})
-		}
-		// Make LB rule
-		res = append(res, ret)
+		ret.Eps = append(ret.Eps, cmn.LbEndPointArg{
+			EpIP:     ep.xIP.String(),
+			EpPort:   ep.xPort,
+			Weight:   ep.weight,
+			State:    state,
+			Counters: counterStr,
+		})
 	}
 
-	return res, nil
+	return ret, nil
 }
 
 // validateXlateEPWeights - validate and adjust weights if necessary
@@ -882,11 +952,17 @@ func (R *RuleH) modNatEpHost(r *ruleEnt, endpoints []ruleLBEp, doAddOp bool, liv
 	} else {
 		hopts.inActTryThr = r.hChk.prbRetries
 	}
+	if r.hChk.prbRise == 0 {
+		hopts.actTryThr = DflLbaActiveTries
+	} else {
+		hopts.actTryThr = r.hChk.prbRise
+	}
 	if r.hChk.prbTimeo == 0 {
 		hopts.probeDuration = DflHostProbeTimeout
 	} else {
 		hopts.probeDuration = r.hChk.prbTimeo
 	}
+	hopts.probeTimeout = r.hChk.prbTimeo
 	for idx := range endpoints {
 		nep := &endpoints[idx]
 		if r.tuples.l4Prot.val == 6 {
@@ -911,6 +987,9 @@ func (R *RuleH) modNatEpHost(r *ruleEnt, endpoints []ruleLBEp, doAddOp bool, liv
 			hopts.probePort = r.hChk.prbPort
 			hopts.probeReq = r.hChk.prbReq
 			hopts.probeResp = r.hChk.prbResp
+			if r.hChk.prbType == HostProbeExec {
+				hopts.probeExec = r.hChk.prbReq
+			}
 		} else {
 			hopts.probeType = pType
 			hopts.probePort = pPort
@@ -1027,6 +1106,20 @@ func (R *RuleH) GetLBRuleSecIPs(serv cmn.LbServiceArg) []string {
 	return ips
 }
 
+// key - allowed source prefix
+func (s *allowedSrcElem) key() string {
+	return s.srcPref.String()
+}
+
+// fwArg - firewall rule matching an allowed source
+func (s *allowedSrcElem) fwArg() cmn.FwRuleArg {
+	fwarg := cmn.FwRuleArg{SrcIP: s.srcPref.String(), DstIP: "0.0.0.0/0"}
+	if tk.IsNetIPv6(s.srcPref.String()) {
+		fwarg.DstIP = "::/0"
+	}
+	return fwarg
+}
+
 func (R *RuleH) addAllowedLbSrc(CIDR string, lbMark uint32) *allowedSrcElem {
 
 	_, srcPref, err := net.ParseCIDR(CIDR)
@@ -1060,10 +1153,7 @@ func (R *RuleH) addAllowedLbSrc(CIDR string, lbMark uint32) *allowedSrcElem {
 	}
 
 addFw:
-	fwarg := cmn.FwRuleArg{SrcIP: srcPref.String(), DstIP: "0.0.0.0/0"}
-	if tk.IsNetIPv6(srcPref.String()) {
-		fwarg.DstIP = "::/0"
-	}
+	fwarg := srcElem.fwArg()
 	fwOpts := cmn.FwOptArg{Allow: true, Mark: srcElem.lbmark | SrcChkFwMark}
 	_, err = R.AddFwRule(fwarg, fwOpts)
 	if err != nil {
@@ -1078,7 +1168,7 @@ addFw:
 		R.lbSrcMap[CIDR] = srcElem
 	}
 
-	tk.LogIt(tk.LogInfo, "added allowed-cidr %s: 0x%x(%v)\n", srcPref.String(), srcElem.lbmark, srcElem.ref)
+	tk.LogIt(tk.LogInfo, "added allowed-cidr %s: 0x%x(%v)\n", srcElem.key(), srcElem.lbmark, srcElem.ref)
 
 	return srcElem
 }
@@ -1097,23 +1187,19 @@ func (R *RuleH) deleteAllowedLbSrc(CIDR string, lbMark uint32) error {
 	srcElem.ref--
 
 	if srcElem.ref == 0 {
-		fwarg := cmn.FwRuleArg{SrcIP: srcElem.srcPref.String(), DstIP: "0.0.0.0/0"}
-		if tk.IsNetIPv6(srcElem.srcPref.String()) {
-			fwarg.DstIP = "::/0"
-		}
-		_, err := R.DeleteFwRule(fwarg)
+		_, err := R.DeleteFwRule(srcElem.fwArg())
 		if err != nil {
-			tk.LogIt(tk.LogError, "Failed to delete allowedSRC %s\n", srcElem.srcPref.String())
+			tk.LogIt(tk.LogError, "Failed to delete allowedSRC %s\n", srcElem.key())
 		}
 		R.srcMark.PutCounter(srcElem.mark)
 		delete(R.lbSrcMap, CIDR)
-		tk.LogIt(tk.LogInfo, "delete allowed-cidr %s\n", srcElem.srcPref.String())
+		tk.LogIt(tk.LogInfo, "delete allowed-cidr %s\n", srcElem.key())
 	} else {
 		srcElem.lbmark &= ^(1 << lbMark)
-		fwarg := cmn.FwRuleArg{SrcIP: srcElem.srcPref.String()}
+		fwarg := srcElem.fwArg()
 		fwOpts := cmn.FwOptArg{Allow: true, Mark: srcElem.lbmark | SrcChkFwMark}
 		R.AddFwRule(fwarg, fwOpts)
-		tk.LogIt(tk.LogInfo, "updated allowed-cidr %s : 0x%x\n", srcElem.srcPref.String(), srcElem.lbmark)
+		tk.LogIt(tk.LogInfo, "updated allowed-cidr %s : 0x%x\n", srcElem.key(), srcElem.lbmark)
 
 	}
 
@@ -1307,6 +1393,7 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 				if sOk == false {
 					if np.noService == false {
 						np.noService = true
+						np.ssT = time.Time{}
 						rChg = true
 						tk.LogIt(tk.LogDebug, "lb-rule service-down ep - %s:%s\n", sType, n.xIP.String())
 					}
@@ -1314,6 +1401,9 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 					if n.noService {
 						np.noService = false
 						np.inActTries = 0
+						if na.slowStart != 0 {
+							np.ssT = time.Now()
+						}
 						rChg = true
 						tk.LogIt(tk.LogDebug, "lb-rule service-up ep - %s:%s\n", sType, n.xIP.String())
 					}
@@ -1326,6 +1416,49 @@ func (R *RuleH) syncEPHostState2Rule(rule *ruleEnt, checkNow bool) bool {
 	return rChg
 }
 
+// epSlowStartStep - slow-start step (1 to SlowStartSteps) of an end-point of
+// a LB rule. An end-point which recovered gets a share of its weight which
+// grows by a step every slowStart/SlowStartSteps seconds
+func (at *ruleLBActs) epSlowStartStep(ep *ruleLBEp, now time.Time) int {
+	if at.slowStart == 0 || ep.ssT.IsZero() {
+		return SlowStartSteps
+	}
+	step := 1 + int(now.Sub(ep.ssT)*SlowStartSteps/(time.Duration(at.slowStart)*time.Second))
+	if step > SlowStartSteps {
+		step = SlowStartSteps
+	}
+	return step
+}
+
+// syncEPSlowStart - advance slow-start of the end-points of a LB rule.
+// Returns true if the rule needs to be reprogrammed
+func (R *RuleH) syncEPSlowStart(rule *ruleEnt) bool {
+	rChg := false
+	at, ok := rule.act.action.(*ruleLBActs)
+	if !ok {
+		return rChg
+	}
+
+	now := time.Now()
+	for idx := range at.endPoints {
+		ep := &at.endPoints[idx]
+		if ep.ssT.IsZero() {
+			continue
+		}
+		step := at.epSlowStartStep(ep, now)
+		if step != ep.ssStep && at.wrrSlots() {
+			rChg = true
+		}
+		ep.ssStep = step
+		if step == SlowStartSteps {
+			ep.ssT = time.Time{}
+			tk.LogIt(tk.LogDebug, "lb-rule slow-start done ep - %s:%d\n", ep.xIP.String(), ep.xPort)
+		}
+	}
+
+	return rChg
+}
+
 // foldRecursiveEPs - Check if this rule's key matches endpoint of another rule.
 // If so, replace that rule's endpoints to this rule's endpoints
 func (R *RuleH) foldRecursiveEPs(r *ruleEnt) {
@@ -1358,7 +1491,7 @@ func (R *RuleH) foldRecursiveEPs(r *ruleEnt) {
 
 		switch at := tr.act.action.(type) {
 		case *ruleLBActs:
-			if r.act.action.(*ruleLBActs).sel != at.sel || r.act.action.(*ruleLBActs).sel == cmn.LbSelPrio {
+			if r.act.action.(*ruleLBActs).sel != at.sel || r.act.action.(*ruleLBActs).slotted() {
 				continue
 			}
 			fold := false
@@ -1419,7 +1552,7 @@ func (R *RuleH) unFoldRecursiveEPs(r *ruleEnt) {
 		}
 		switch at := tr.act.action.(type) {
 		case *ruleLBActs:
-			if selPolicy != at.sel || selPolicy == cmn.LbSelPrio {
+			if selPolicy != at.sel || at.slotted() {
 				continue
 			}
 			for i := range at.endPoints {
@@ -1532,6 +1665,18 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 	var ipProto uint8
 	var privIP net.IP
 
+	// Allocate service IP from IPAM pool if needed. The allocation is
+	// given back if the rule doesn't end up using it
+	if net.ParseIP(serv.ServIP) == nil {
+		vip, err := R.zone.Ipam.IpamServIPResolve(&serv, true)
+		if err != nil {
+			tk.LogIt(tk.LogError, "lb-rule %s-%v-%s ipam error: %s\n", serv.ServIP, serv.ServPort, serv.Proto, err)
+			return RuleArgsErr, err
+		}
+		serv.ServIP = vip
+		defer R.zone.Ipam.IpamServIPRelease(vip)
+	}
+
 	// Validate service args
 	service := ""
 	if tk.IsNetIPv4(serv.ServIP) {
@@ -1559,6 +1704,15 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		}
 	}
 
+	// Validate schedules of allowed sources. A rule given allowed sources
+	// only lets them in, even once all of them expired
+	srcChk := len(allowedSources) > 0
+	for _, src := range allowedSources {
+		if R.zone.IPSets.IPSetFind(src.Prefix) != nil {
+			return RuleArgsErr, errors.New("malformed-service allowed-src ipset unsupported by datapath error")
+		}
+	}
+
 	// Validate inactivity timeout
 	if serv.InactiveTimeout > LbMaxInactiveTimeout {
 		return RuleArgsErr, errors.New("service-args error")
@@ -1575,17 +1729,28 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 			serv.ProbeType != HostProbeConnectTCP &&
 			serv.ProbeType != HostProbeConnectUDP &&
 			serv.ProbeType != HostProbePing &&
+			serv.ProbeType != HostProbeHTTP &&
+			serv.ProbeType != HostProbeHTTPS &&
+			serv.ProbeType != HostProbeGRPC &&
+			serv.ProbeType != HostProbeExec &&
 			serv.ProbeType != HostProbeNone {
 			return RuleArgsErr, errors.New("malformed-service-ptype error")
 		}
 
 		if (serv.ProbeType == HostProbeConnectSCTP ||
 			serv.ProbeType == HostProbeConnectTCP ||
-			serv.ProbeType == HostProbeConnectUDP) &&
+			serv.ProbeType == HostProbeConnectUDP ||
+			serv.ProbeType == HostProbeHTTP ||
+			serv.ProbeType == HostProbeHTTPS ||
+			serv.ProbeType == HostProbeGRPC) &&
 			(serv.ProbePort == 0) {
 			return RuleArgsErr, errors.New("malformed-service-pport error")
 		}
 
+		if serv.ProbeType == HostProbeExec && serv.ProbeReq == "" {
+			return RuleArgsErr, errors.New("malformed-service-preq error")
+		}
+
 		if (serv.ProbeType == HostProbeNone || serv.ProbeType == HostProbePing) &&
 			(serv.ProbePort != 0) {
 			return RuleArgsErr, errors.New("malformed-service-pport error")
@@ -1599,11 +1764,36 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		return RuleArgsErr, errors.New("malformed-service-pport error")
 	}
 
+	// Least response time selection works off probe response times
+	if serv.Sel == cmn.LbSelLeastResponseTime && (!serv.Monitor || serv.ProbeType == HostProbeNone) {
+		return RuleArgsErr, errors.New("malformed-service-sel error: least response time needs monitoring")
+	}
+
+	// Validate liveness thresholds and slow-start
+	if serv.ProbeRetries < 0 || serv.ProbeRetries > MaxDflLbaInactiveTries ||
+		serv.ProbeRise < 0 || serv.ProbeRise > MaxDflLbaInactiveTries {
+		return RuleArgsErr, errors.New("malformed-service-retries error")
+	}
+
+	if serv.SlowStart > MaxLbSlowStart {
+		return RuleArgsErr, errors.New("malformed-service-slowstart error")
+	}
+
+	if err := validateOutlierArgs(&serv); err != nil {
+		return RuleArgsErr, err
+	}
+
 	// Currently support a maximum of MaxLBEndPoints
 	if len(servEndPoints) <= 0 || len(servEndPoints) > MaxLBEndPoints {
 		return RuleEpCountErr, errors.New("endpoints-range error")
 	}
 
+	// The maglev lookup table is too small to share it evenly among more
+	// end-points. Weights are not taken into account either
+	if serv.Sel == cmn.LbSelMaglev && len(servEndPoints) > MaxLBMaglevEndPoints {
+		return RuleEpCountErr, errors.New("endpoints-range error: too many maglev end-points")
+	}
+
 	// Validate persist timeout
 	if serv.Sel == cmn.LbSelRrPersist {
 		if serv.PersistTimeout == 0 || serv.PersistTimeout > 24*60*60 {
@@ -1677,6 +1867,8 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 
 	lBActs.sel = serv.Sel
 	lBActs.mode = cmn.LBMode(serv.Mode)
+	lBActs.slowStart = serv.SlowStart
+	lBActs.outlier = makeRuleOutlier(&serv)
 
 	if lBActs.mode == cmn.LBModeOneArm || lBActs.mode == cmn.LBModeFullNAT || lBActs.mode == cmn.LBModeHostOneArm || serv.Monitor {
 		activateProbe = true
@@ -1698,7 +1890,7 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		if lBActs.mode == cmn.LBModeDSR && k.EpPort != serv.ServPort {
 			return RuleUnknownServiceErr, errors.New("malformed-service dsr-port error")
 		}
-		ep := ruleLBEp{pNetAddr, xNetAddr, k.EpPort, k.Weight, 0, false, false, false, false, ruleStat{0, 0}, nil, ""}
+		ep := ruleLBEp{pNetAddr, xNetAddr, k.EpPort, k.Weight, 0, false, false, false, false, ruleStat{}, nil, "", time.Time{}, 0, epOutlier{}, 0, 0}
 		lBActs.endPoints = append(lBActs.endPoints, ep)
 	}
 
@@ -1719,6 +1911,17 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 
 	eRule := R.tables[RtLB].eMap[rt.ruleKey()]
 
+	// Allowed sources of an existing rule given the same schedule keep the
+	// time their TTL runs out
+	var eSrcSched map[string]*ruleSched
+	if eRule != nil {
+		eSrcSched = eRule.srcSched
+	}
+	allowedSources, srcSched, err := lbSrcSched(allowedSources, eSrcSched)
+	if err != nil {
+		return RuleArgsErr, errors.New("malformed-service allowed-src error")
+	}
+
 	if eRule != nil {
 		if !reflect.DeepEqual(eRule.secIP, nSecIP) {
 			return RuleUnknownServiceErr, errors.New("secIP modify error")
@@ -1732,27 +1935,13 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 			eRule.hChk.prbReq != serv.ProbeReq || eRule.hChk.prbResp != serv.ProbeResp ||
 			eRule.pTO != serv.PersistTimeout || eRule.act.action.(*ruleLBActs).sel != lBActs.sel ||
 			eRule.act.action.(*ruleLBActs).mode != lBActs.mode ||
-			eRule.ppv2En != serv.ProxyProtocolV2 ||
-			len(allowedSources) != len(eRule.srcList) {
+			eRule.act.action.(*ruleLBActs).slowStart != lBActs.slowStart ||
+			eRule.act.action.(*ruleLBActs).outlier != lBActs.outlier ||
+			eRule.ppv2En != serv.ProxyProtocolV2 || eRule.srcChk != srcChk ||
+			eRule.lbSrcChanged(allowedSources, srcSched) {
 			ruleChg = true
 		}
 
-		if len(allowedSources) == len(eRule.srcList) {
-			for _, newSrc := range allowedSources {
-				srcMatch := false
-				for _, src := range eRule.srcList {
-					if src.srcPref.String() != newSrc.Prefix {
-						srcMatch = true
-						break
-					}
-				}
-				if !srcMatch {
-					ruleChg = true
-					break
-				}
-			}
-		}
-
 		if !ruleChg {
 			return RuleExistsErr, errors.New("lbrule-exists error")
 		}
@@ -1775,9 +1964,13 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 			return RuleExistsErr, errors.New("lbrule-exist error: cant modify fullproxy rule mode")
 		}
 
-		if eRule.act.action.(*ruleLBActs).mode == cmn.LBModeFullProxy || len(retEps) > MaxLBEndPoints {
+		maxEps := MaxLBEndPoints
+		if lBActs.sel == cmn.LbSelMaglev {
+			maxEps = MaxLBMaglevEndPoints
+		}
+		if eRule.act.action.(*ruleLBActs).mode == cmn.LBModeFullProxy || len(retEps) > maxEps {
 			eRule.DP(DpRemove)
-			if len(retEps) > MaxLBEndPoints {
+			if len(retEps) > maxEps {
 				tk.LogIt(tk.LogInfo, "lb-rule %s-%v-%s reset all end-points (too many)\n", serv.ServIP, serv.ServPort, serv.Proto)
 				delEps = eRule.act.action.(*ruleLBActs).endPoints
 				retEps = lBActs.endPoints
@@ -1788,10 +1981,13 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		eRule.srcList = nil
 
 		for _, allowedSource := range allowedSources {
+			if sched := srcSched[allowedSource.Prefix]; sched != nil && sched.waiting {
+				continue
+			}
 			srcElem := R.addAllowedLbSrc(allowedSource.Prefix, uint32(eRule.ruleNum))
 			if srcElem == nil {
 				for _, src := range eRule.srcList {
-					R.deleteAllowedLbSrc(src.srcPref.String(), uint32(eRule.ruleNum))
+					R.deleteAllowedLbSrc(src.key(), uint32(eRule.ruleNum))
 				}
 				eRule.srcList = eSrcList
 				tk.LogIt(tk.LogError, "nat lb-rule - %s:%s allowedSRC error\n", eRule.tuples.String(), eRule.act.String())
@@ -1801,8 +1997,10 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		}
 
 		for _, srcElem := range eSrcList {
-			R.deleteAllowedLbSrc(srcElem.srcPref.String(), uint32(eRule.ruleNum))
+			R.deleteAllowedLbSrc(srcElem.key(), uint32(eRule.ruleNum))
 		}
+		eRule.srcSched = srcSched
+		eRule.srcChk = srcChk
 
 		// Update the rule
 		eRule.hChk.prbType = serv.ProbeType
@@ -1810,10 +2008,13 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		eRule.hChk.prbReq = serv.ProbeReq
 		eRule.hChk.prbResp = serv.ProbeResp
 		eRule.hChk.prbRetries = serv.ProbeRetries
+		eRule.hChk.prbRise = serv.ProbeRise
 		eRule.hChk.prbTimeo = serv.ProbeTimeout
 		eRule.pTO = serv.PersistTimeout
 		eRule.ppv2En = serv.ProxyProtocolV2
 		eRule.act.action.(*ruleLBActs).sel = lBActs.sel
+		eRule.act.action.(*ruleLBActs).slowStart = lBActs.slowStart
+		eRule.act.action.(*ruleLBActs).outlier = lBActs.outlier
 
 		// Capture old endpoints before updating for selective session reset
 		oldEndPoints := eRule.act.action.(*ruleLBActs).endPoints
@@ -1845,6 +2046,7 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		eRule.sT = time.Now()
 		eRule.iTO = serv.InactiveTimeout
 		tk.LogIt(tk.LogDebug, "lb-rule updated - %s:%s\n", eRule.tuples.String(), eRule.act.String())
+		R.watchLBRule(eRule, cmn.WatchEvModified)
 		R.flushLBCtEntries(eRule, CtFlushRidMatchOrZero)
 		eRule.DP(DpCreate)
 		DpBrokerSyncBarrier(mh.dp)
@@ -1860,6 +2062,7 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 	r.tuples = rt
 	r.zone = R.zone
 	r.name = serv.Name
+	r.ipamKey = cmn.LbServOwnerKey(&serv)
 	names := strings.Split(r.name, ":")
 	if len(names) >= 2 {
 		r.inst = names[1]
@@ -1889,6 +2092,7 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 	r.hChk.prbReq = serv.ProbeReq
 	r.hChk.prbResp = serv.ProbeResp
 	r.hChk.prbRetries = serv.ProbeRetries
+	r.hChk.prbRise = serv.ProbeRise
 	r.hChk.prbTimeo = serv.ProbeTimeout
 	r.hChk.actChk = serv.Monitor
 
@@ -1899,17 +2103,22 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 		return RuleAllocErr, errors.New("rule-hwm error")
 	}
 	for _, allowedSource := range allowedSources {
+		if sched := srcSched[allowedSource.Prefix]; sched != nil && sched.waiting {
+			continue
+		}
 		srcElem := R.addAllowedLbSrc(allowedSource.Prefix, uint32(r.ruleNum))
 		if srcElem == nil {
 			R.tables[RtLB].Mark.ReleaseMarker(r.ruleNum)
 			for _, src := range r.srcList {
-				R.deleteAllowedLbSrc(src.srcPref.String(), uint32(r.ruleNum))
+				R.deleteAllowedLbSrc(src.key(), uint32(r.ruleNum))
 			}
 			tk.LogIt(tk.LogError, "nat lb-rule - %s:%s allowedSRC error\n", r.tuples.String(), r.act.String())
 			return RuleAllocErr, errors.New("rule-allowed-src error")
 		}
 		r.srcList = append(r.srcList, srcElem)
 	}
+	r.srcSched = srcSched
+	r.srcChk = srcChk
 	r.sT = time.Now()
 	r.iTO = serv.InactiveTimeout
 	r.bgp = serv.Bgp
@@ -1935,12 +2144,14 @@ func (R *RuleH) AddLbRule(serv cmn.LbServiceArg, servSecIPs []cmn.LbSecIPArg, al
 	if r.ruleNum < RtMaximumLbs {
 		R.tables[RtLB].rArr[r.ruleNum] = r
 	}
+	R.zone.Ipam.IpamBindRule(r)
 	R.flushLBCtEntries(r, CtFlushRidMatchOrZero)
 	r.DP(DpCreate)
 	DpBrokerSyncBarrier(mh.dp)
 	R.flushLBCtEntries(r, CtFlushRidZeroOnly)
 	R.addVIPSys(r)
 	tk.LogIt(tk.LogDebug, "lb-rule added - %d:%s-%s\n", r.ruleNum, r.tuples.String(), r.act.String())
+	R.watchLBRule(r, cmn.WatchEvAdded)
 
 	return 0, nil
 }
@@ -1963,6 +2174,14 @@ func (R *RuleH) deleteVIPSys(r *ruleEnt) {
 func (R *RuleH) DeleteLbRule(serv cmn.LbServiceArg) (int, error) {
 	var ipProto uint8
 
+	if net.ParseIP(serv.ServIP) == nil {
+		vip, err := R.zone.Ipam.IpamServIPResolve(&serv, false)
+		if err != nil {
+			return RuleNotExistsErr, errors.New("no-rule error")
+		}
+		serv.ServIP = vip
+	}
+
 	service := ""
 	if tk.IsNetIPv4(serv.ServIP) {
 		service = serv.ServIP + "/32"
@@ -2028,9 +2247,10 @@ func (R *RuleH) DeleteLbRule(serv cmn.LbServiceArg) (int, error) {
 	}
 
 	for _, srcElem := range rule.srcList {
-		R.deleteAllowedLbSrc(srcElem.srcPref.String(), uint32(rule.ruleNum))
+		R.deleteAllowedLbSrc(srcElem.key(), uint32(rule.ruleNum))
 	}
 	rule.srcList = nil
+	rule.srcSched = nil
 
 	delete(R.tables[RtLB].eMap, rt.ruleKey())
 	if rule.ruleNum < RtMaximumLbs {
@@ -2038,9 +2258,11 @@ func (R *RuleH) DeleteLbRule(serv cmn.LbServiceArg) (int, error) {
 	}
 
 	R.deleteVIPSys(rule)
+	R.zone.Ipam.IpamUnbindRule(rule)
 	R.flushLBCtEntries(rule, CtFlushRidMatchOrZero)
 
 	tk.LogIt(tk.LogDebug, "lb-rule deleted %s-%s\n", rule.tuples.String(), rule.act.String())
+	R.watchLBRule(rule, cmn.WatchEvDeleted)
 
 	rule.DP(DpRemove)
 
@@ -2077,6 +2299,42 @@ func (R *RuleH) flushLBCtEntries(r *ruleEnt, flushMode uint8) {
 	}
 }
 
+// fwRuleArg - firewall rule arguments of a rule
+func (r *ruleEnt) fwRuleArg() cmn.FwRuleArg {
+	var fwr cmn.FwRuleArg
+
+	fwr.DstIP = r.tuples.l3Dst.addr.String()
+	fwr.SrcIP = r.tuples.l3Src.addr.String()
+	if r.tuples.l4Dst.valid {
+		fwr.DstPortMin = r.tuples.l4Dst.valMin
+		fwr.DstPortMax = r.tuples.l4Dst.valMax
+	}
+	if r.tuples.l4Src.valid {
+		fwr.SrcPortMin = r.tuples.l4Src.valMin
+		fwr.SrcPortMax = r.tuples.l4Src.valMax
+	}
+
+	fwr.Proto = r.tuples.l4Prot.val
+	fwr.InPort = r.tuples.port.val
+	fwr.Pref = r.tuples.pref
+	return fwr
+}
+
+// fwRuleInstall - install a firewall rule in DP. Rules triggered on default
+// cases are installed only when not in backup state
+func (R *RuleH) fwRuleInstall(r *ruleEnt) {
+	if r.act.action.(*ruleFwOpts).opt.onDflt {
+		state, err := mh.has.CIStateGetInst(cmn.CIDefault)
+		if err == nil {
+			if state == cmn.CIBackupStateString {
+				return
+			}
+		}
+	}
+
+	r.Fw2DP(DpCreate)
+}
+
 // GetFwRule - get all Fwrules and pack them into a cmn.FwRuleMod slice
 func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
 	var res []cmn.FwRuleMod
@@ -2084,20 +2342,7 @@ func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
 	for _, data := range R.tables[RtFw].eMap {
 		var ret cmn.FwRuleMod
 		// Make Fw Arguments
-		ret.Rule.DstIP = data.tuples.l3Dst.addr.String()
-		ret.Rule.SrcIP = data.tuples.l3Src.addr.String()
-		if data.tuples.l4Dst.valid {
-			ret.Rule.DstPortMin = data.tuples.l4Dst.valMin
-			ret.Rule.DstPortMax = data.tuples.l4Dst.valMax
-		}
-		if data.tuples.l4Src.valid {
-			ret.Rule.SrcPortMin = data.tuples.l4Src.valMin
-			ret.Rule.SrcPortMin = data.tuples.l4Src.valMax
-		}
-
-		ret.Rule.Proto = data.tuples.l4Prot.val
-		ret.Rule.InPort = data.tuples.port.val
-		ret.Rule.Pref = data.tuples.pref
+		ret.Rule = data.fwRuleArg()
 
 		// Make Fw Opts
 		fwOpts := data.act.action.(*ruleFwOpts)
@@ -2120,9 +2365,12 @@ func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
 		}
 		ret.Opts.Record = fwOpts.opt.record
 		ret.Opts.OnDefault = fwOpts.opt.onDflt
+		ret.Opts.ValidFrom, ret.Opts.ExpiresAt, ret.Opts.TTL, ret.Opts.TTLExpiresAt = data.sched.args()
 
 		data.Fw2DP(DpStatsGetImm)
+		data.fwHitSync(time.Now())
 		ret.Opts.Counter = fmt.Sprintf("%v:%v", data.stat.packets, data.stat.bytes)
+		ret.Stats = data.fwRuleStats()
 
 		// Make FwRule
 		res = append(res, ret)
@@ -2131,6 +2379,16 @@ func (R *RuleH) GetFwRule() ([]cmn.FwRuleMod, error) {
 	return res, nil
 }
 
+// fwRuleIPSets - check IP sets referred by a firewall rule. The datapath
+// can't match IP sets yet, so such rules are refused rather than installed
+// without the set match
+func fwRuleIPSets(fwRule *cmn.FwRuleArg) error {
+	if fwRule.SrcIPSet != "" || fwRule.DstIPSet != "" {
+		return errors.New("malformed-rule ipset unsupported by datapath error")
+	}
+	return nil
+}
+
 // AddFwRule - Add a firewall rule. The rule details are passed in fwRule argument
 // it will return 0 and nil error, else appropriate return code and error string will be set
 func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, error) {
@@ -2140,6 +2398,15 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 	var l4prot rule8Tuple
 
 	// Validate rule args
+	if err := fwRuleIPSets(&fwRule); err != nil {
+		return RuleTupleErr, err
+	}
+
+	sched, err := makeRuleSched(fwOptArgs.ValidFrom, fwOptArgs.ExpiresAt, fwOptArgs.TTL, fwOptArgs.TTLExpiresAt)
+	if err != nil {
+		return RuleArgsErr, err
+	}
+
 	if fwOptArgs.DoSnat {
 		if tk.IsNetIPv6(fwOptArgs.ToIP) {
 			if fwRule.DstIP == "0.0.0.0/0" {
@@ -2190,6 +2457,18 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 				eFw.Fw2DP(DpCreate)
 			}
 		}
+		// Schedule of an existing rule can be changed e.g. to extend it
+		if !eFw.sched.same(&sched) {
+			waiting := eFw.sched.waiting
+			eFw.sched = sched
+			if !waiting && sched.waiting {
+				eFw.Fw2DP(DpRemove)
+			} else if waiting && !sched.waiting {
+				R.fwRuleInstall(eFw)
+			}
+			tk.LogIt(tk.LogInfo, "fw-rule %s schedule updated\n", eFw.tuples.String())
+			return 0, nil
+		}
 		// If a FW rule already exists
 		return RuleExistsErr, errors.New("fwrule-exists error")
 	}
@@ -2197,6 +2476,7 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 	r := new(ruleEnt)
 	r.tuples = rt
 	r.zone = R.zone
+	r.sched = sched
 
 	/* Default is drop */
 	fwOpts.op = RtActDrop
@@ -2279,16 +2559,12 @@ func (R *RuleH) AddFwRule(fwRule cmn.FwRuleArg, fwOptArgs cmn.FwOptArg) (int, er
 
 	R.tables[RtFw].eMap[rt.ruleKey()] = r
 
-	if fwOptArgs.OnDefault {
-		state, err := mh.has.CIStateGetInst(cmn.CIDefault)
-		if err == nil {
-			if state == cmn.CIBackupStateString {
-				return 0, nil
-			}
-		}
+	if r.sched.waiting {
+		tk.LogIt(tk.LogDebug, "fw-rule %d:%s scheduled\n", r.ruleNum, r.tuples.String())
+		return 0, nil
 	}
 
-	r.Fw2DP(DpCreate)
+	R.fwRuleInstall(r)
 
 	return 0, nil
 }
@@ -2302,6 +2578,10 @@ func (R *RuleH) DeleteFwRule(fwRule cmn.FwRuleArg) (int, error) {
 	var l4prot rule8Tuple
 
 	// Vaildate rule args
+	if err := fwRuleIPSets(&fwRule); err != nil {
+		return RuleTupleErr, err
+	}
+
 	_, dNetAddr, err := net.ParseCIDR(fwRule.DstIP)
 	if err != nil {
 		return RuleTupleErr, errors.New("malformed-rule dst error")
@@ -2386,35 +2666,7 @@ func (R *RuleH) GetEpHosts() ([]cmn.EndPointMod, error) {
 	var res []cmn.EndPointMod
 
 	for _, data := range R.epMap {
-		var ret cmn.EndPointMod
-		// Make end-point
-		ret.HostName = data.hostName
-		ret.Name = data.epKey
-		if !data.opts.probeActivated {
-			ret.ProbeType = HostProbeNone
-		} else {
-			ret.ProbeType = data.opts.probeType
-			ret.ProbeDuration = data.opts.probeDuration
-			ret.InActTries = data.opts.inActTryThr
-		}
-		ret.ProbeReq = data.opts.probeReq
-		ret.ProbeResp = data.opts.probeResp
-		ret.ProbePort = data.opts.probePort
-		if ret.ProbeType == HostProbePing {
-			ret.MinDelay = fmt.Sprintf("%v", data.minDelay)
-			ret.AvgDelay = fmt.Sprintf("%v", data.avgDelay)
-			ret.MaxDelay = fmt.Sprintf("%v", data.maxDelay)
-		}
-		if data.inactive {
-			ret.CurrState = "nok"
-		} else {
-			ret.CurrState = "ok"
-		}
-
-		if data.hostState == cmn.HostStateRed {
-			ret.CurrState = "red"
-		}
-
+		ret := epHost2Mod(data)
 		// Append to slice
 		res = append(res, ret)
 	}
@@ -2422,6 +2674,55 @@ func (R *RuleH) GetEpHosts() ([]cmn.EndPointMod, error) {
 	return res, nil
 }
 
+// epHost2Mod - pack an end-point into a cmn.EndPointMod
+func epHost2Mod(data *epHost) cmn.EndPointMod {
+	var ret cmn.EndPointMod
+	// Make end-point
+	ret.HostName = data.hostName
+	ret.Name = data.epKey
+	if !data.opts.probeActivated {
+		ret.ProbeType = HostProbeNone
+	} else {
+		ret.ProbeType = data.opts.probeType
+		ret.ProbeDuration = data.opts.probeDuration
+		ret.InActTries = data.opts.inActTryThr
+		ret.ActTries = data.opts.actTryThr
+		ret.ProbeBackoffMax = data.opts.probeBackoffMax
+		ret.ProbeJitter = data.opts.probeJitter
+	}
+	ret.ProbeReq = data.opts.probeReq
+	ret.ProbeResp = data.opts.probeResp
+	ret.ProbePort = data.opts.probePort
+	ret.ProbeTimeout = data.opts.probeTimeout
+	ret.ProbeMethod = data.opts.probeHTTP.Method
+	ret.ProbeHost = data.opts.probeHTTP.Host
+	ret.ProbeHeaders = data.opts.probeHTTP.Headers
+	ret.ProbeStatus = data.opts.probeHTTP.Status
+	ret.ProbeBodyRegex = data.opts.probeHTTP.BodyRegex
+	ret.ProbeJSONPath = data.opts.probeHTTP.JSONPath
+	ret.ProbeJSONValue = data.opts.probeHTTP.JSONValue
+	ret.ProbeGRPCService = data.opts.probeGRPCService
+	ret.ProbeTLS = data.opts.probeTLS
+	ret.ProbeExec = data.opts.probeExec
+	ret.ProbeExecState = data.opts.probeExecState
+	if ret.ProbeType == HostProbePing {
+		ret.MinDelay = fmt.Sprintf("%v", data.minDelay)
+		ret.AvgDelay = fmt.Sprintf("%v", data.avgDelay)
+		ret.MaxDelay = fmt.Sprintf("%v", data.maxDelay)
+	}
+	if data.inactive {
+		ret.CurrState = "nok"
+	} else {
+		ret.CurrState = "ok"
+	}
+
+	if data.hostState == cmn.HostStateRed {
+		ret.CurrState = "red"
+	}
+
+	return ret
+}
+
 // IsEPHostActive - Check if end-point is active
 func (R *RuleH) IsEPHostActive(epKey string) bool {
 	ep := R.epMap[epKey]
@@ -2443,6 +2744,7 @@ func validateEPHostOpts(hostName string, args epHostOpts) (int, error) {
 	}
 
 	if args.inActTryThr > MaxDflLbaInactiveTries ||
+		args.actTryThr > MaxDflLbaInactiveTries ||
 		args.probeDuration > MaxHostProbeTime {
 		return RuleArgsErr, errors.New("host-args error")
 	}
@@ -2453,20 +2755,73 @@ func validateEPHostOpts(hostName string, args epHostOpts) (int, error) {
 		args.probeType != HostProbeConnectSCTP &&
 		args.probeType != HostProbeHTTP &&
 		args.probeType != HostProbeHTTPS &&
+		args.probeType != HostProbeGRPC &&
+		args.probeType != HostProbeExec &&
 		args.probeType != HostProbeNone {
 		return RuleArgsErr, errors.New("host-args unknown probe type")
 	}
 
 	if (args.probeType == HostProbeConnectTCP ||
 		args.probeType == HostProbeConnectUDP ||
-		args.probeType == HostProbeConnectSCTP) &&
+		args.probeType == HostProbeConnectSCTP ||
+		args.probeType == HostProbeGRPC) &&
 		args.probePort == 0 {
 		return RuleArgsErr, errors.New("host-args unknown probe port")
 	}
 
+	if args.probeTimeout > MaxHostProbeTime ||
+		args.probeBackoffMax > MaxHostProbeTime || args.probeJitter > 50 {
+		return RuleArgsErr, errors.New("host-args error")
+	}
+
+	if args.probeType == HostProbeExec && strings.TrimSpace(args.probeExec) == "" {
+		return RuleArgsErr, errors.New("host-args error : no probe command")
+	}
+
 	return 0, nil
 }
 
+// epProbeTimeout - timeout of a probe of an end-point. A probe times out
+// after probeTimeout (dfl if not set), but never later than the next probe is due
+func epProbeTimeout(args *epHostOpts, dfl time.Duration) time.Duration {
+	timeout := dfl
+	if args.probeTimeout != 0 {
+		timeout = time.Duration(args.probeTimeout) * time.Second
+	}
+	if args.probeDuration != 0 && timeout > time.Duration(args.probeDuration)*time.Second {
+		timeout = time.Duration(args.probeDuration) * time.Second
+	}
+	return timeout
+}
+
+// epHTTPProbe - make the http(s) probe of an end-point
+func epHTTPProbe(args *epHostOpts) (*utils.HTTPProbe, error) {
+	spec := args.probeHTTP
+	spec.Resp = args.probeResp
+	spec.Timeout = epProbeTimeout(args, utils.DflHTTPProbeTimeout)
+	p, err := utils.NewHTTPProbe(spec)
+	if err != nil {
+		return nil, fmt.Errorf("host-args error : %s", err)
+	}
+	return p, nil
+}
+
+// epExecCmd - get the command line of the exec probe of an end-point. Only
+// commands kept in cmn.ProbeScriptPath can be run
+func epExecCmd(args *epHostOpts) ([]string, error) {
+	cmd := strings.Fields(args.probeExec)
+	path := cmd[0]
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(cmn.ProbeScriptPath, path)
+	}
+	path = filepath.Clean(path)
+	if filepath.Dir(path) != filepath.Clean(cmn.ProbeScriptPath) {
+		return nil, fmt.Errorf("host-args error : probe command has to be in %s", cmn.ProbeScriptPath)
+	}
+	cmd[0] = path
+	return cmd, nil
+}
+
 func makeEPKey(hostName string, probeType string, probePort uint16) string {
 	return hostName + "_" + probeType + "_" + strconv.Itoa(int(probePort))
 }
@@ -2490,8 +2845,21 @@ func (R *RuleH) AddEPHost(apiCall bool, hostName string, name string, args epHos
 		tk.LogIt(tk.LogError, "Failed to add EP :%s\n", err)
 		return RuleArgsErr, err
 	}
+	if args.probeType == HostProbeHTTP || args.probeType == HostProbeHTTPS {
+		args.httpProbe, err = epHTTPProbe(&args)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to add EP :%s\n", err)
+			return RuleArgsErr, err
+		}
+	} else if args.probeType == HostProbeExec {
+		args.execCmd, err = epExecCmd(&args)
+		if err != nil {
+			tk.LogIt(tk.LogError, "Failed to add EP :%s\n", err)
+			return RuleArgsErr, err
+		}
+	}
 	// Load CA cert into pool
-	if args.probeType == HostProbeHTTPS {
+	if args.probeType == HostProbeHTTPS || (args.probeType == HostProbeGRPC && args.probeTLS) {
 		// Check if there exist a CA certificate particularly for this EP
 		rootCACertile := cmn.CertPath + hostName + "/" + cmn.CACertFileName
 		if exists := utils.FileExists(rootCACertile); exists {
@@ -2538,7 +2906,14 @@ func (R *RuleH) AddEPHost(apiCall bool, hostName string, name string, args epHos
 	}
 	// if args.probeType != HostProbeConnectUDP
 	// Set ep.hID = 0, if we need to disable threads
+	// Spread end-points evenly across helpers
 	ep.hID = R.lepHID % MaxEndPointCheckers
+	for i := range R.epCs {
+		if R.epCs[i].nEps < R.epCs[ep.hID].nEps {
+			ep.hID = uint8(i)
+		}
+	}
+	R.epCs[ep.hID].nEps++
 	//ep.sT = time.Now()
 	R.lepHID++
 
@@ -2584,6 +2959,7 @@ func (R *RuleH) DeleteEPHost(apiCall bool, name string, hostName string, probeTy
 	}
 
 	delete(R.epMap, ep.epKey)
+	R.epCs[ep.hID].nEps--
 
 	tk.LogIt(tk.LogDebug, "ep-host deleted %v\n", key)
 
@@ -2630,7 +3006,42 @@ func (R *RuleH) SetEPHostState(hostName string, epPort uint16, epProto string, s
 	return 0, nil
 }
 
-func (ep *epHost) transitionEPState(currState bool, inactThr int) {
+// probeBackoff - next probe duration of an inactive end-point. It doubles
+// on each failed probe till probeBackoffMax
+func (ep *epHost) probeBackoff() uint32 {
+	max := ep.opts.probeBackoffMax
+	if max == 0 {
+		max = DflHostProbeBackoffMax
+	}
+	dur := ep.opts.currProbeDuration
+	if dur < EndPointCheckerDuration {
+		dur = EndPointCheckerDuration
+	}
+	dur *= 2
+	if dur > max {
+		dur = max
+	}
+	if dur < ep.opts.probeDuration {
+		dur = ep.opts.probeDuration
+	}
+	return dur
+}
+
+// probeJitter - random offset of the next probe of an end-point, within
+// probeJitter percent of its probe duration
+func (ep *epHost) probeJitter() time.Duration {
+	jitter := int64(ep.opts.probeJitter)
+	if jitter == 0 {
+		jitter = DflHostProbeJitter
+	}
+	span := int64(ep.opts.currProbeDuration) * int64(time.Second) * jitter / 100
+	if span <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(2*span+1) - span)
+}
+
+func (ep *epHost) transitionEPState(currState bool, inactThr int, actThr int) {
 	if currState {
 		// Reset the failure counter on ANY successful probe, not only when
 		// transitioning back from inactive. Otherwise inActTries accumulates
@@ -2640,40 +3051,78 @@ func (ep *epHost) transitionEPState(currState bool, inactThr int) {
 		// retries before marking an endpoint inactive (consecutive failures).
 		ep.inActTries = 0
 		if ep.inactive {
-			ep.inactive = false
+			// An inactive end-point needs actThr consecutive successful
+			// probes before it is marked active again
+			ep.actTries++
 			ep.opts.currProbeDuration = ep.opts.probeDuration
+			if ep.actTries < actThr {
+				return
+			}
+			ep.actTries = 0
+			ep.inactive = false
 			tk.LogIt(tk.LogDebug, "active ep - %s:%s:%d(%v)\n",
 				ep.epKey, ep.opts.probeType, ep.opts.probePort, ep.avgDelay)
+			ep.watchEPHost()
 		}
 	} else {
+		ep.actTries = 0
 		if ep.inActTries < inactThr {
 			ep.inActTries++
 			if ep.inActTries >= inactThr {
 				if !ep.inactive {
 					tk.LogIt(tk.LogDebug, "inactive ep - %s:%s:%d(next try after %ds)\n",
 						ep.epKey, ep.opts.probeType, ep.opts.probePort, ep.opts.currProbeDuration)
+					ep.inactive = true
+					ep.watchEPHost()
 				}
-				ep.inactive = true
 			}
 		} else {
 			ep.inActTries++
 			// Inactive eps are moved back
-			if ep.opts.currProbeDuration < 3*DflHostProbeTimeout {
-				ep.opts.currProbeDuration += 20
-			}
+			ep.opts.currProbeDuration = ep.probeBackoff()
 			//tk.LogIt(tk.LogDebug, "inactive ep - %s:%s:%d(next try after %ds)\n",
 			//	ep.epKey, ep.opts.probeType, ep.opts.probePort, ep.opts.currProbeDuration)
 		}
 	}
 }
 
+// recordProbeDelay - record the response time of a probe started at begin.
+// The average is smoothed over successive probes
+func (ep *epHost) recordProbeDelay(begin time.Time) {
+	if ep.inactive {
+		ep.avgDelay = time.Duration(0)
+		ep.minDelay = time.Duration(0)
+		ep.maxDelay = time.Duration(0)
+		return
+	}
+	if ep.inActTries != 0 {
+		return
+	}
+	d := time.Since(begin)
+	if ep.avgDelay == 0 {
+		ep.avgDelay = d
+		ep.minDelay = d
+		ep.maxDelay = d
+		return
+	}
+	ep.avgDelay = (7*ep.avgDelay + d) / 8
+	if d < ep.minDelay {
+		ep.minDelay = d
+	}
+	if d > ep.maxDelay {
+		ep.maxDelay = d
+	}
+}
+
 func (R *RuleH) epCheckNow(ep *epHost) {
 	var sType string
 	sHint := ""
 
 	inActTryThr := ep.opts.inActTryThr
+	actTryThr := ep.opts.actTryThr
 	if ep.initProberOn {
 		inActTryThr = 1
+		actTryThr = 1
 		ep.initProberOn = false
 	}
 
@@ -2688,6 +3137,17 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		return
 	}
 
+	// Pings measure their own round-trip times. Probes are timed from when
+	// they actually start, and nothing is recorded if no probe was run
+	var begin time.Time
+	if ep.opts.probeType != HostProbePing {
+		defer func() {
+			if !begin.IsZero() {
+				ep.recordProbeDelay(begin)
+			}
+		}()
+	}
+
 	if ep.opts.probeType == HostProbeConnectTCP ||
 		ep.opts.probeType == HostProbeConnectUDP ||
 		ep.opts.probeType == HostProbeConnectSCTP {
@@ -2715,8 +3175,9 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 				}
 			}
 		}
+		begin = time.Now()
 		sOk := tk.L4ServiceProber(sType, sName, sHint, ep.opts.probeReq, ep.opts.probeResp)
-		ep.transitionEPState(sOk, inActTryThr)
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
 	} else if ep.opts.probeType == HostProbePing {
 		pinger, err := probing.NewPinger(ep.hostName)
 		if err != nil {
@@ -2752,12 +3213,12 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 			ep.avgDelay = stats.AvgRtt
 			ep.minDelay = stats.MinRtt
 			ep.maxDelay = stats.MaxRtt
-			ep.transitionEPState(true, 1)
+			ep.transitionEPState(true, 1, actTryThr)
 		} else {
 			ep.avgDelay = time.Duration(0)
 			ep.minDelay = time.Duration(0)
 			ep.maxDelay = time.Duration(0)
-			ep.transitionEPState(false, 1)
+			ep.transitionEPState(false, 1, actTryThr)
 		}
 		pinger.Stop()
 	} else if ep.opts.probeType == HostProbeHTTP {
@@ -2768,8 +3229,9 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		}
 
 		urlStr := fmt.Sprintf("http://%s:%d/%s", addr.String(), ep.opts.probePort, ep.opts.probeReq)
-		sOk := tk.HTTPProber(urlStr)
-		ep.transitionEPState(sOk, inActTryThr)
+		begin = time.Now()
+		sOk := ep.opts.httpProbe.Probe(urlStr, tls.Certificate{}, nil)
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
 	} else if ep.opts.probeType == HostProbeHTTPS {
 		var addr net.IP
 		if addr = net.ParseIP(ep.hostName); addr == nil {
@@ -2778,9 +3240,42 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 		}
 
 		urlStr := fmt.Sprintf("https://%s:%d/%s", addr.String(), ep.opts.probePort, ep.opts.probeReq)
-		sOk := utils.HTTPSProber(urlStr, R.tlsCert, R.rootCAPool, ep.opts.probeResp)
+		begin = time.Now()
+		sOk := ep.opts.httpProbe.Probe(urlStr, R.tlsCert, R.rootCAPool)
 		//tk.LogIt(tk.LogDebug, "[PROBE] https ep - URL[%s:%s] Resp[%s] %v\n", ep.hostName, urlStr, ep.opts.probeResp, sOk)
-		ep.transitionEPState(sOk, inActTryThr)
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
+	} else if ep.opts.probeType == HostProbeGRPC {
+		spec := utils.GRPCProbeSpec{Service: ep.opts.probeGRPCService, TLS: ep.opts.probeTLS,
+			ServerName: ep.opts.probeHTTP.Host, Timeout: epProbeTimeout(&ep.opts, utils.DflHTTPProbeTimeout)}
+		begin = time.Now()
+		status, err := utils.GRPCProber(sName, spec, R.tlsCert, R.rootCAPool)
+		if err == nil && status != healthpb.HealthCheckResponse_SERVING {
+			tk.LogIt(tk.LogDebug, "grpc ep - %s:%s %s\n", ep.epKey, ep.opts.probeGRPCService, status)
+		}
+		ep.transitionEPState(status == healthpb.HealthCheckResponse_SERVING, inActTryThr, actTryThr)
+	} else if ep.opts.probeType == HostProbeExec {
+		timeout := epProbeTimeout(&ep.opts, utils.DflExecProbeTimeout)
+		select {
+		case R.execSem <- struct{}{}:
+		case <-time.After(timeout):
+			// Too many exec probes running, try again on the next round
+			tk.LogIt(tk.LogDebug, "exec ep - %s : probe limit reached\n", ep.epKey)
+			return
+		}
+		begin = time.Now()
+		sOk, out := utils.ExecProber(utils.ExecProbeSpec{Cmd: ep.opts.execCmd, Timeout: timeout},
+			ep.hostName, ep.opts.probePort)
+		<-R.execSem
+		if sOk && ep.opts.probeExecState {
+			state := strings.ToLower(out)
+			if state == cmn.HostStateGreen || state == cmn.HostStateYellow || state == cmn.HostStateRed {
+				if ep.hostState != state {
+					tk.LogIt(tk.LogDebug, "ep %s - %s\n", ep.epKey, state)
+				}
+				ep.hostState = state
+			}
+		}
+		ep.transitionEPState(sOk, inActTryThr, actTryThr)
 	} else {
 		// TODO
 		ep.inactive = false
@@ -2791,6 +3286,10 @@ func (R *RuleH) epCheckNow(ep *epHost) {
 func epTicker(R *RuleH, helper int) {
 	epc := R.epCs[helper]
 
+	// Stagger helpers so that they don't probe in lockstep
+	time.Sleep(time.Duration(helper) * EndPointCheckerDuration * time.Second / MaxEndPointCheckers)
+	epc.hChk.Reset(EndPointCheckerDuration * time.Second)
+
 	idx := 0
 	tlen := 0
 	var run uint32
@@ -2822,7 +3321,7 @@ func epTicker(R *RuleH, helper int) {
 				if host.hID == uint8(helper) {
 
 					if run%2 == 0 {
-						if (host.opts.probeType == HostProbePing && host.avgDelay == 0) || host.inactive ||
+						if (host.opts.probeType == HostProbePing && host.avgDelay == 0 && !host.inactive) ||
 							(host.initProberOn && time.Duration(t.Sub(host.sT).Seconds()) >= time.Duration(InitHostProbeTimeout)) {
 							epHosts = append(epHosts, host)
 						}
@@ -2845,8 +3344,10 @@ func epTicker(R *RuleH, helper int) {
 			begin := time.Now()
 			for _, eph := range epHosts {
 				R.epCheckNow(eph)
-				eph.sT = time.Now()
-				if time.Duration(eph.sT.Sub(begin).Seconds()) >= EndPointCheckerDuration {
+				atomic.AddUint64(&R.probeCnt, 1)
+				now := time.Now()
+				eph.sT = now.Add(eph.probeJitter())
+				if time.Duration(now.Sub(begin).Seconds()) >= EndPointCheckerDuration {
 					break
 				}
 			}
@@ -2854,6 +3355,18 @@ func epTicker(R *RuleH, helper int) {
 	}
 }
 
+// probeLoadSync - publish the rate of end-point probes
+func (R *RuleH) probeLoadSync() {
+	now := time.Now()
+	elapsed := now.Sub(R.probeST)
+	if elapsed < ProbeLoadDuration*time.Second {
+		return
+	}
+	cnt := atomic.SwapUint64(&R.probeCnt, 0)
+	prom.SetEndpointProbeRate(float64(cnt) / elapsed.Seconds())
+	R.probeST = now
+}
+
 // RulesSync - This is periodic ticker routine which does two main things :
 // 1. Syncs rule statistics counts
 // 2. Check health of lb-rule end-points
@@ -2883,12 +3396,21 @@ func (R *RuleH) RulesSync() {
 		}
 
 		rChg = R.syncEPHostState2Rule(rule, false)
+		if R.syncEPSlowStart(rule) {
+			rChg = true
+		}
 		if rChg {
 			tk.LogIt(tk.LogDebug, "lb-Rule updated %d:%s,%s\n", rule.ruleNum, ruleKeys, ruleActs)
 			rule.DP(DpCreate)
 		}
 	}
 
+	now := time.Now()
+	R.lbSrcSchedSync(now)
+	R.outlierSync()
+	R.dynWeightSync()
+	R.probeLoadSync()
+
 	if time.Duration(time.Since(R.vipST).Seconds()) > time.Duration(VIPSweepDuration) {
 		for vip, vipElem := range R.vipMap {
 			ip := vipElem.pVIP
@@ -2913,6 +3435,8 @@ func (R *RuleH) RulesSync() {
 		//	rule.ruleNum, ruleKeys, ruleActs,
 		//	rule.stat.packets, rule.stat.bytes)
 	}
+	R.fwSchedSync(now)
+	R.fwStatSync()
 }
 
 // RulesTicker - Ticker for all rules
@@ -2923,7 +3447,6 @@ func (R *RuleH) RulesTicker() {
 // RuleDestructAll - Destructor routine for all rules
 func (R *RuleH) RuleDestructAll() {
 	var lbs cmn.LbServiceArg
-	var fwr cmn.FwRuleArg
 	fmt.Printf("Deleting Rules\n")
 
 	for _, r := range R.tables[RtLB].eMap {
@@ -2949,21 +3472,7 @@ func (R *RuleH) RuleDestructAll() {
 		R.DeleteLbRule(lbs)
 	}
 	for _, r := range R.tables[RtFw].eMap {
-		fwr.DstIP = r.tuples.l3Dst.addr.String()
-		fwr.SrcIP = r.tuples.l3Src.addr.String()
-		if r.tuples.l4Src.valid {
-			fwr.SrcPortMin = r.tuples.l4Src.valMin
-			fwr.SrcPortMax = r.tuples.l4Src.valMax
-		}
-		if r.tuples.l4Dst.valid {
-			fwr.DstPortMin = r.tuples.l4Dst.valMin
-			fwr.DstPortMax = r.tuples.l4Dst.valMax
-		}
-
-		fwr.Proto = r.tuples.l4Prot.val
-		fwr.InPort = r.tuples.port.val
-
-		R.DeleteFwRule(fwr)
+		R.DeleteFwRule(r.fwRuleArg())
 	}
 }
 
@@ -3028,7 +3537,7 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 	} else if r.secMode == cmn.LBServE2EHTTPS {
 		nWork.SecMode = DpE2EHTTPS
 	}
-	if len(r.srcList) > 0 {
+	if r.srcCheck() {
 		nWork.SrcCheck = true
 	}
 
@@ -3062,7 +3571,7 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 			nWork.EpSel = EpRR
 		case at.sel == cmn.LbSelHash:
 			nWork.EpSel = EpHash
-		case at.sel == cmn.LbSelPrio:
+		case at.wrrSlots():
 			// Note that internally we use RR to achieve wRR
 			nWork.EpSel = EpRR
 		case at.sel == cmn.LbSelRrPersist:
@@ -3073,6 +3582,8 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 			nWork.EpSel = EpN2
 		case at.sel == cmn.LbSelN3:
 			nWork.EpSel = EpN3
+		case at.sel == cmn.LbSelMaglev:
+			nWork.EpSel = EpMaglev
 		default:
 			nWork.EpSel = EpRR
 		}
@@ -3081,18 +3592,31 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 			nWork.DsrMode = true
 		}
 		nWork.CsumDis = mh.sumDis
-		if at.sel == cmn.LbSelPrio {
+		if at.wrrSlots() {
 			j := 0
 			k := 0
 			var small [MaxLBEndPoints]int
 			var neps [MaxLBPrioSlots]ruleLBEp
 			slotOf := make([]int, MaxLBPrioSlots)
+			now := time.Now()
 			for i, ep := range at.endPoints {
 				if ep.inActiveEP {
 					continue
 				}
 				oEp := &at.endPoints[i]
-				sw := (int(ep.weight) * MaxLBPrioSlots) / 100
+				// An end-point in slow-start gets a share of its weight
+				// and is kept out of the padding slots
+				step := at.epSlowStartStep(oEp, now)
+				oEp.ssStep = step
+				slowStart := step < SlowStartSteps
+				weight := ep.weight
+				if at.sel != cmn.LbSelPrio {
+					weight = ep.dynWeight
+				}
+				sw := (int(weight) * step * MaxLBPrioSlots) / (100 * SlowStartSteps)
+				if slowStart && sw == 0 {
+					sw = 1
+				}
 				if sw == 0 {
 					small[k] = i
 					k++
@@ -3103,9 +3627,10 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 					neps[j].xPort = oEp.xPort
 					neps[j].inActiveEP = oEp.inActiveEP
 					neps[j].noService = oEp.noService
+					neps[j].ol.ejected = oEp.ol.ejected
 					neps[j].weight = oEp.weight
 					slotOf[j] = i
-					if sw == 1 {
+					if sw == 1 && !slowStart {
 						small[k] = i
 						k++
 					}
@@ -3125,6 +3650,7 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 					neps[j].xPort = oEp.xPort
 					neps[j].inActiveEP = oEp.inActiveEP
 					neps[j].noService = oEp.noService
+					neps[j].ol.ejected = oEp.ol.ejected
 					neps[j].weight = oEp.weight
 					slotOf[j] = idx
 					j++
@@ -3139,11 +3665,43 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 				ep.RIP = e.rIP
 				ep.XPort = e.xPort
 				ep.Weight = e.weight
-				if e.inActiveEP || e.noService {
+				if e.inActiveEP || e.noService || e.ol.ejected {
 					ep.InActive = true
 				}
 				nWork.endPoints = append(nWork.endPoints, ep)
 			}
+		} else if at.sel == cmn.LbSelMaglev {
+			// Only healthy end-points get slots of the lookup table, so
+			// flows of an end-point going down are spread over the others
+			// while the rest keep their slots. If none is healthy, all of
+			// them are laid out inactive
+			var names []string
+			var epIdx []int
+			for pass := 0; pass < 2 && len(epIdx) == 0; pass++ {
+				for i, ep := range at.endPoints {
+					if pass == 0 && (ep.inActiveEP || ep.noService || ep.ol.ejected) {
+						continue
+					}
+					names = append(names, fmt.Sprintf("%s:%d", ep.xIP.String(), ep.xPort))
+					epIdx = append(epIdx, i)
+				}
+			}
+			slotOf := make([]int, MaxLBMaglevSlots)
+			for s, n := range utils.MaglevTable(names, MaxLBMaglevSlots) {
+				var ep NatEP
+
+				e := &at.endPoints[epIdx[n]]
+				ep.XIP = e.xIP
+				ep.RIP = e.rIP
+				ep.XPort = e.xPort
+				ep.Weight = e.weight
+				if e.inActiveEP || e.noService || e.ol.ejected {
+					ep.InActive = true
+				}
+				slotOf[s] = epIdx[n]
+				nWork.endPoints = append(nWork.endPoints, ep)
+			}
+			at.prioSlotOf = slotOf
 		} else {
 			for _, k := range at.endPoints {
 				if len(k.foldEndPoints) > 0 {
@@ -3154,7 +3712,7 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 						ep.RIP = kf.rIP
 						ep.XPort = kf.xPort
 						ep.Weight = kf.weight
-						if kf.inActiveEP || kf.noService {
+						if kf.inActiveEP || kf.noService || kf.ol.ejected {
 							ep.InActive = true
 						}
 
@@ -3167,7 +3725,7 @@ func (r *ruleEnt) LB2DP(work DpWorkT) int {
 					ep.RIP = k.rIP
 					ep.XPort = k.xPort
 					ep.Weight = k.weight
-					if k.inActiveEP || k.noService {
+					if k.inActiveEP || k.noService || k.ol.ejected {
 						ep.InActive = true
 					}
 
@@ -3304,7 +3862,7 @@ func (r *ruleEnt) DP(work DpWorkT) int {
 		if isNat {
 			switch at := r.act.action.(type) {
 			case *ruleLBActs:
-				if at.sel == cmn.LbSelPrio && at.prioSlotOf != nil {
+				if at.slotted() && at.prioSlotOf != nil {
 					// WRR programs weight-expanded slots, so DP counters
 					// are per slot, not per endpoint; fold each slot's
 					// counter back into its owning endpoint
@@ -3422,6 +3980,7 @@ func (R *RuleH) AdvRuleVIP(IP net.IP, eIP net.IP, inst string, egress bool) erro
 			if !utils.IsIPHostAddr(IP.String()) {
 				if mh.cloudHook != nil {
 					err := mh.cloudHook.CloudUpdatePrivateIP(IP, eIP, true)
+					mh.has.CICloudStatusUpdate("update-private-ip", err)
 					if err != nil {
 						tk.LogIt(tk.LogError, "%s: lb-rule vip %s add failed. err: %v\n", mh.cloudLabel, IP.String(), err)
 						return err
@@ -3496,15 +4055,17 @@ func (R *RuleH) RulesSyncToClusterState(inst, ciStateStr string) {
 	// For Cloud integrations, certain operations are performed only on default instance state changes
 	if mh.cloudHook != nil && inst == cmn.CIDefault {
 		if ciStateStr == cmn.CIMasterStateString {
-			mh.cloudHook.CloudPrepareVIPNetWork()
+			err := mh.cloudHook.CloudPrepareVIPNetWork()
+			mh.has.CICloudStatusUpdate("prepare-vip-network", err)
 		} else if ciStateStr == cmn.CIBackupStateString {
-			mh.cloudHook.CloudUnPrepareVIPNetWork()
+			err := mh.cloudHook.CloudUnPrepareVIPNetWork()
+			mh.has.CICloudStatusUpdate("unprepare-vip-network", err)
 		}
 	}
 
 	if inst == cmn.CIDefault {
 		for _, eFw := range R.tables[RtFw].eMap {
-			if eFw.act.action.(*ruleFwOpts).opt.onDflt {
+			if eFw.act.action.(*ruleFwOpts).opt.onDflt && !eFw.sched.waiting {
 				if ciStateStr == cmn.CIMasterStateString || ciStateStr != cmn.CIBackupStateString {
 					eFw.Fw2DP(DpCreate)
 				} else if ciStateStr == cmn.CIBackupStateString {
@@ -3581,6 +4142,7 @@ func (R *RuleH) DeleteRuleVIP(VIP net.IP) {
 			loxinlp.DelAddrNoHook(utils.IPHostCIDRString(xVIP), ifname)
 			if mh.cloudHook != nil {
 				err := mh.cloudHook.CloudUpdatePrivateIP(xVIP, VIP, false)
+				mh.has.CICloudStatusUpdate("update-private-ip", err)
 				if err != nil {
 					tk.LogIt(tk.LogError, "%s: lb-rule vip %s delete failed. err: %v\n", mh.cloudLabel, xVIP.String(), err)
 				}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEpCheckExecProbeDelay(t *testing.T) {
+	R := &RuleH{execSem: make(chan struct{}, 1)}
+	ep := &epHost{epKey: "127.0.0.1_exec_80", hostName: "127.0.0.1"}
+	ep.opts = epHostOpts{probeType: HostProbeExec, probeActivated: true, probePort: 80,
+		probeTimeout: 1, execCmd: []string{"true"}, inActTryThr: 1, actTryThr: 1}
+
+	// No delay is recorded when the probe can't get an exec slot in time
+	R.execSem <- struct{}{}
+	R.epCheckNow(ep)
+	if ep.avgDelay != 0 || ep.maxDelay != 0 {
+		t.Errorf("slot wait recorded as probe delay %v", ep.avgDelay)
+	}
+	<-R.execSem
+
+	R.epCheckNow(ep)
+	if ep.inactive || ep.avgDelay == 0 || ep.maxDelay >= time.Second {
+		t.Errorf("exec probe delay %v (max %v), inactive %v", ep.avgDelay, ep.maxDelay, ep.inactive)
+	}
+}
+
+func TestTransitionEPState(t *testing.T) {
+	tests := []struct {
+		name     string
+		probes   string
+		inactThr int
+		actThr   int
+		inactive bool
+	}{
+		{"failures below threshold", "FF", 3, 1, false},
+		{"failures at threshold", "FFF", 3, 1, true},
+		{"sporadic failures", "FFTFFTFF", 3, 1, false},
+		{"still failing", "FFFFFF", 3, 1, true},
+		{"rise of one", "FFFT", 3, 1, false},
+		{"below rise threshold", "FFFTT", 3, 3, true},
+		{"at rise threshold", "FFFTTT", 3, 3, false},
+		{"rise interrupted", "FFFTTFTT", 3, 3, true},
+		{"rise after interruption", "FFFTTFTTT", 3, 3, false},
+		{"failure after rise", "FTTF", 1, 2, true},
+		{"rise not needed when active", "TTT", 3, 3, false},
+	}
+
+	for _, tc := range tests {
+		ep := &epHost{epKey: "10.10.10.1_tcp_80", hostName: "10.10.10.1"}
+		ep.opts = epHostOpts{probeType: HostProbeConnectTCP, probePort: 80, probeDuration: 10, currProbeDuration: 10}
+		for _, p := range tc.probes {
+			ep.transitionEPState(p == 'T', tc.inactThr, tc.actThr)
+		}
+		if ep.inactive != tc.inactive {
+			t.Errorf("%s: inactive %v, want %v", tc.name, ep.inactive, tc.inactive)
+		}
+	}
+}
+
+func TestEpSlowStartStep(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name      string
+		slowStart uint32
+		elapsed   time.Duration
+		noStart   bool
+		step      int
+	}{
+		{"slow-start off", 0, 0, false, SlowStartSteps},
+		{"not recovering", 10, 0, true, SlowStartSteps},
+		{"just recovered", 10, 0, false, 1},
+		{"within first step", 10, 999 * time.Millisecond, false, 1},
+		{"second step", 10, time.Second, false, 2},
+		{"half way", 10, 5500 * time.Millisecond, false, 6},
+		{"last step", 10, 9999 * time.Millisecond, false, SlowStartSteps},
+		{"window over", 10, 30 * time.Second, false, SlowStartSteps},
+		{"long window", 100, 25 * time.Second, false, 3},
+	}
+
+	for _, tc := range tests {
+		at := &ruleLBActs{slowStart: tc.slowStart}
+		ep := &ruleLBEp{}
+		if !tc.noStart {
+			ep.ssT = now.Add(-tc.elapsed)
+		}
+		if step := at.epSlowStartStep(ep, now); step != tc.step {
+			t.Errorf("%s: step %d, want %d", tc.name, step, tc.step)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"net"
+	"sort"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements time-scheduled firewall rules and LB allowed sources.
+// A rule can be given a time from which it is valid and a time or TTL after
+// which it expires. Rules which are not valid yet are kept but not installed
+// in the DP. The rules ticker installs them once they become valid and
+// removes them once they expire
+
+// ruleSched - validity window of a rule, zero times are unbounded. The
+// expiry time and TTL are kept as given, along with the time the TTL runs out
+type ruleSched struct {
+	from      time.Time
+	until     time.Time
+	expiresAt int64
+	ttl       uint32
+	ttlUntil  time.Time
+	waiting   bool
+}
+
+// schedTTLExpiry - time a TTL runs out (unix seconds). The TTL counts from
+// the time a rule becomes valid
+func schedTTLExpiry(validFrom int64, ttl uint32) int64 {
+	base := time.Now()
+	if from := time.Unix(validFrom, 0); validFrom > 0 && from.After(base) {
+		base = from
+	}
+	return base.Add(time.Duration(ttl) * time.Second).Unix()
+}
+
+// makeRuleSched - make the validity window of a rule given its schedule
+// arguments (unix seconds). ttlExpiresAt, if given, is when the TTL runs out
+func makeRuleSched(validFrom, expiresAt int64, ttl uint32, ttlExpiresAt int64) (ruleSched, error) {
+	var s ruleSched
+
+	if validFrom < 0 || expiresAt < 0 || ttlExpiresAt < 0 {
+		return s, errors.New("malformed-rule schedule error")
+	}
+
+	if validFrom > 0 {
+		s.from = time.Unix(validFrom, 0)
+	}
+	if expiresAt > 0 {
+		s.until = time.Unix(expiresAt, 0)
+	}
+	s.expiresAt = expiresAt
+	s.ttl = ttl
+	if ttl > 0 {
+		if ttlExpiresAt == 0 {
+			ttlExpiresAt = schedTTLExpiry(validFrom, ttl)
+		}
+		s.ttlUntil = time.Unix(ttlExpiresAt, 0)
+		if s.until.IsZero() || s.ttlUntil.Before(s.until) {
+			s.until = s.ttlUntil
+		}
+	}
+
+	if !s.from.IsZero() && !s.until.IsZero() && !s.until.After(s.from) {
+		return s, errors.New("malformed-rule schedule error")
+	}
+	if s.expired(time.Now()) {
+		return s, errors.New("rule-expired error")
+	}
+	s.waiting = s.pending(time.Now())
+	return s, nil
+}
+
+// scheduled - check if a rule has a validity window
+func (s *ruleSched) scheduled() bool {
+	return !s.from.IsZero() || !s.until.IsZero()
+}
+
+// pending - check if a rule is not valid yet
+func (s *ruleSched) pending(now time.Time) bool {
+	return !s.from.IsZero() && now.Before(s.from)
+}
+
+// expired - check if a rule has expired
+func (s *ruleSched) expired(now time.Time) bool {
+	return !s.until.IsZero() && !now.Before(s.until)
+}
+
+// same - check if two schedules were given the same arguments. A rule
+// added back with the same schedule keeps the time its TTL runs out
+func (s *ruleSched) same(o *ruleSched) bool {
+	return s.from.Equal(o.from) && s.expiresAt == o.expiresAt && s.ttl == o.ttl
+}
+
+// args - schedule arguments of a rule as given along with the time its TTL
+// runs out
+func (s *ruleSched) args() (validFrom, expiresAt int64, ttl uint32, ttlExpiresAt int64) {
+	if !s.from.IsZero() {
+		validFrom = s.from.Unix()
+	}
+	if s.ttl > 0 {
+		ttlExpiresAt = s.ttlUntil.Unix()
+	}
+	return validFrom, s.expiresAt, s.ttl, ttlExpiresAt
+}
+
+// fwSchedSync - install firewall rules which became valid and remove the
+// ones which expired
+func (R *RuleH) fwSchedSync(now time.Time) {
+	for _, rule := range R.tables[RtFw].eMap {
+		if !rule.sched.scheduled() {
+			continue
+		}
+		if rule.sched.expired(now) {
+			fwArg := rule.fwRuleArg()
+			tk.LogIt(tk.LogInfo, "fw-rule %s expired\n", rule.tuples.String())
+			if _, err := R.DeleteFwRule(fwArg); err == nil {
+				storeDel(storeKindFw, storeFwKey(&fwArg))
+			}
+			continue
+		}
+		if rule.sched.waiting && !rule.sched.pending(now) {
+			rule.sched.waiting = false
+			rule.sT = now
+			tk.LogIt(tk.LogInfo, "fw-rule %s valid\n", rule.tuples.String())
+			R.fwRuleInstall(rule)
+		}
+	}
+}
+
+// lbSrcSched - validate schedules of allowed sources of a LB rule. Returns
+// the allowed sources which did not expire yet and the schedules of the ones
+// which have one, including the expired ones. Prefixes are returned in their
+// canonical form. Sources given the same schedule as in eSched, the
+// schedules of the existing rule, keep the time their TTL runs out
+func lbSrcSched(allowedSources []cmn.LbAllowedSrcIPArg, eSched map[string]*ruleSched) ([]cmn.LbAllowedSrcIPArg, map[string]*ruleSched, error) {
+	var srcs []cmn.LbAllowedSrcIPArg
+	srcSched := make(map[string]*ruleSched)
+
+	now := time.Now()
+	for _, src := range allowedSources {
+		if _, pref, err := net.ParseCIDR(src.Prefix); err == nil {
+			src.Prefix = pref.String()
+		}
+		if es := eSched[src.Prefix]; es != nil && src.TTLExpiresAt == 0 {
+			if vf, ea, ttl, tea := es.args(); vf == src.ValidFrom && ea == src.ExpiresAt && ttl == src.TTL {
+				src.TTLExpiresAt = tea
+			}
+		}
+		sched, err := makeRuleSched(src.ValidFrom, src.ExpiresAt, src.TTL, src.TTLExpiresAt)
+		if err != nil {
+			if sched.expired(now) {
+				tk.LogIt(tk.LogInfo, "allowed-src %s expired\n", src.Prefix)
+				srcSched[src.Prefix] = &sched
+				continue
+			}
+			return nil, nil, err
+		}
+		srcs = append(srcs, src)
+		if sched.scheduled() {
+			srcSched[src.Prefix] = &sched
+		}
+	}
+	return srcs, srcSched, nil
+}
+
+// lbSrcArgs - allowed sources of a LB rule including the ones not valid yet
+// and the ones which expired
+func (r *ruleEnt) lbSrcArgs() []cmn.LbAllowedSrcIPArg {
+	var srcs []cmn.LbAllowedSrcIPArg
+	var waiting []string
+
+	active := make(map[string]bool)
+	for _, src := range r.srcList {
+		arg := cmn.LbAllowedSrcIPArg{Prefix: src.key()}
+		if s := r.srcSched[arg.Prefix]; s != nil {
+			arg.ValidFrom, arg.ExpiresAt, arg.TTL, arg.TTLExpiresAt = s.args()
+		}
+		srcs = append(srcs, arg)
+		active[arg.Prefix] = true
+	}
+	for pfx := range r.srcSched {
+		if !active[pfx] {
+			waiting = append(waiting, pfx)
+		}
+	}
+	sort.Strings(waiting)
+	for _, pfx := range waiting {
+		arg := cmn.LbAllowedSrcIPArg{Prefix: pfx}
+		arg.ValidFrom, arg.ExpiresAt, arg.TTL, arg.TTLExpiresAt = r.srcSched[pfx].args()
+		srcs = append(srcs, arg)
+	}
+	return srcs
+}
+
+// lbSrcChanged - check if allowed sources of a LB rule differ from the
+// given ones
+func (r *ruleEnt) lbSrcChanged(allowedSources []cmn.LbAllowedSrcIPArg, srcSched map[string]*ruleSched) bool {
+	nSrcs := make(map[string]bool)
+	for _, src := range allowedSources {
+		nSrcs[src.Prefix] = true
+	}
+	for pfx := range srcSched {
+		nSrcs[pfx] = true
+	}
+
+	eSrcs := r.lbSrcArgs()
+	if len(eSrcs) != len(nSrcs) {
+		return true
+	}
+	for _, src := range eSrcs {
+		if !nSrcs[src.Prefix] {
+			return true
+		}
+		eSched, nSched := r.srcSched[src.Prefix], srcSched[src.Prefix]
+		if (eSched == nil) != (nSched == nil) || eSched != nil && !eSched.same(nSched) {
+			return true
+		}
+	}
+	return false
+}
+
+// lbSrcSchedSync - install allowed sources of LB rules which became valid
+// and remove the ones which expired. Expired sources are kept in the
+// schedules of a rule, so a LB rule whose allowed sources all expired keeps
+// checking sources and lets none in
+func (R *RuleH) lbSrcSchedSync(now time.Time) {
+	for _, rule := range R.tables[RtLB].eMap {
+		rChg := false
+		for pfx, s := range rule.srcSched {
+			if s.expired(now) {
+				expired := s.waiting
+				s.waiting = false
+				for idx, src := range rule.srcList {
+					if src.key() == pfx {
+						R.deleteAllowedLbSrc(pfx, uint32(rule.ruleNum))
+						rule.srcList = append(rule.srcList[:idx], rule.srcList[idx+1:]...)
+						expired = true
+						break
+					}
+				}
+				if expired {
+					tk.LogIt(tk.LogInfo, "lb-rule %s allowed-src %s expired\n", rule.tuples.String(), pfx)
+					rChg = true
+				}
+				continue
+			}
+			if s.waiting && !s.pending(now) {
+				srcElem := R.addAllowedLbSrc(pfx, uint32(rule.ruleNum))
+				if srcElem == nil {
+					continue
+				}
+				s.waiting = false
+				rule.srcList = append(rule.srcList, srcElem)
+				tk.LogIt(tk.LogInfo, "lb-rule %s allowed-src %s valid\n", rule.tuples.String(), pfx)
+				rChg = true
+			}
+		}
+		if rChg {
+			if rule.srcCheck() && len(rule.srcList) == 0 && !rule.lbSrcWaiting() {
+				tk.LogIt(tk.LogWarning, "lb-rule %s all allowed-srcs expired, denying all\n", rule.tuples.String())
+			}
+			rule.DP(DpCreate)
+		}
+	}
+}
+
+// lbSrcWaiting - check if a LB rule has allowed sources which are not valid yet
+func (r *ruleEnt) lbSrcWaiting() bool {
+	for _, s := range r.srcSched {
+		if s.waiting {
+			return true
+		}
+	}
+	return false
+}
+
+// srcCheck - check if a LB rule only allows traffic from its allowed sources
+func (r *ruleEnt) srcCheck() bool {
+	return r.srcChk
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+func TestLbSrcSchedExpired(t *testing.T) {
+	past := time.Now().Add(-time.Minute).Unix()
+	srcs, srcSched, err := lbSrcSched([]cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.1/8", ExpiresAt: past}}, nil)
+	if err != nil || len(srcs) != 0 {
+		t.Fatalf("expired allowed-src installed: %v %v", srcs, err)
+	}
+	// Expired sources are still remembered to keep the rule restricted
+	if s := srcSched["10.0.0.0/8"]; s == nil || s.waiting || s.until.Unix() != past {
+		t.Errorf("expired allowed-src schedule %+v", s)
+	}
+}
+
+func TestLbSrcSchedSyncExpiry(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	_, pref, _ := net.ParseCIDR("10.0.0.0/8")
+
+	r := &ruleEnt{ruleNum: 3, egress: true, srcChk: true}
+	r.act.actType = RtActDnat
+	r.srcList = []*allowedSrcElem{{ref: 1, srcPref: pref}}
+	r.srcSched = map[string]*ruleSched{
+		"10.0.0.0/8": {until: now.Add(-time.Second), expiresAt: now.Add(-time.Second).Unix()},
+		"20.0.0.0/8": {from: now.Add(-20 * time.Second), until: now.Add(-10 * time.Second),
+			expiresAt: now.Add(-10 * time.Second).Unix(), waiting: true},
+	}
+	R := &RuleH{lbSrcMap: make(map[string]*allowedSrcElem)}
+	R.tables[RtLB].eMap = map[string]*ruleEnt{"r": r}
+
+	// A rule whose allowed sources all expired lets no source in
+	R.lbSrcSchedSync(now)
+	if len(r.srcList) != 0 || r.lbSrcWaiting() {
+		t.Errorf("expired allowed-srcs left active: %v", r.lbSrcArgs())
+	}
+	if !r.srcCheck() {
+		t.Fatalf("rule accepts any source after its allowed-srcs expired")
+	}
+	args := r.lbSrcArgs()
+	if len(args) != 2 || args[0].Prefix != "10.0.0.0/8" || args[1].Prefix != "20.0.0.0/8" || args[0].TTL != 0 {
+		t.Errorf("allowed-srcs reported %+v", args)
+	}
+
+	// Nothing changes on later syncs nor when the same sources are given again
+	R.lbSrcSchedSync(now.Add(time.Minute))
+	if !r.srcCheck() || len(r.srcSched) != 2 {
+		t.Errorf("rule restriction lost on resync")
+	}
+	nSrcs, nSched, err := lbSrcSched(args, r.srcSched)
+	if err != nil || len(nSrcs) != 0 || r.lbSrcChanged(nSrcs, nSched) {
+		t.Errorf("same expired allowed-srcs seen as a change: %v %v", nSrcs, err)
+	}
+
+	// A rule without allowed sources does not check them
+	if (&ruleEnt{}).srcCheck() {
+		t.Errorf("rule without allowed-srcs checks sources")
+	}
+}
+
+// TestRuleSchedTTL - a TTL is reported as given along with the time it runs
+// out, which does not move when a rule is added back
+func TestRuleSchedTTL(t *testing.T) {
+	zone, _ := testZone(t)
+	R := zone.Rules
+	fwR := cmn.FwRuleArg{SrcIP: "10.0.0.0/8", DstIP: "0.0.0.0/0", Pref: 100}
+	fwOpts := cmn.FwOptArg{Drop: true, TTL: 60}
+
+	start := time.Now().Unix()
+	if _, err := R.AddFwRule(fwR, fwOpts); err != nil {
+		t.Fatalf("fw rule add: %v", err)
+	}
+	res, err := R.GetFwRule()
+	if err != nil || len(res) != 1 {
+		t.Fatalf("got %d fw rules: %v", len(res), err)
+	}
+	opts := res[0].Opts
+	if opts.TTL != 60 || opts.ExpiresAt != 0 || opts.TTLExpiresAt < start+60 || opts.TTLExpiresAt > start+61 {
+		t.Errorf("fw rule schedule %d %d %d", opts.TTL, opts.ExpiresAt, opts.TTLExpiresAt)
+	}
+
+	// The rule read back is the same rule
+	if ret, _ := R.AddFwRule(fwR, opts); ret != RuleExistsErr {
+		t.Errorf("fw rule read back taken as changed: %d", ret)
+	}
+	for _, r := range R.tables[RtFw].eMap {
+		r.sched.ttlUntil = r.sched.ttlUntil.Add(-time.Second)
+		opts.TTLExpiresAt--
+	}
+	if ret, _ := R.AddFwRule(fwR, fwOpts); ret != RuleExistsErr {
+		t.Errorf("fw rule added again taken as changed: %d", ret)
+	}
+	if res, _ = R.GetFwRule(); res[0].Opts.TTLExpiresAt != opts.TTLExpiresAt {
+		t.Errorf("fw rule TTL restarted: %d, was %d", res[0].Opts.TTLExpiresAt, opts.TTLExpiresAt)
+	}
+
+	// Allowed sources given the same TTL again keep its run out time
+	srcs, sched, err := lbSrcSched([]cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 60}}, nil)
+	if err != nil || len(srcs) != 1 {
+		t.Fatalf("allowed-src with TTL: %v %v", srcs, err)
+	}
+	sched["10.0.0.0/8"].ttlUntil = sched["10.0.0.0/8"].ttlUntil.Add(-time.Second)
+	nSrcs, nSched, _ := lbSrcSched([]cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 60}}, sched)
+	if !nSched["10.0.0.0/8"].ttlUntil.Equal(sched["10.0.0.0/8"].ttlUntil) || nSrcs[0].TTL != 60 {
+		t.Errorf("allowed-src TTL restarted: %v, was %v", nSched["10.0.0.0/8"].ttlUntil, sched["10.0.0.0/8"].ttlUntil)
+	}
+	if _, nSched, _ = lbSrcSched([]cmn.LbAllowedSrcIPArg{{Prefix: "10.0.0.0/8", TTL: 120}}, sched); nSched["10.0.0.0/8"].ttl != 120 {
+		t.Errorf("allowed-src TTL change not taken")
+	}
+}
@@ -0,0 +1,219 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	"github.com/loxilb-io/loxilb/pkg/store"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// Kinds of loxinet config kept in the config store
+const (
+	storeKindParams = "params"
+	storeKindIPPool = "ippool"
+	storeKindIPSet  = "ipset"
+	storeKindEP     = "endpoint"
+	storeKindLB     = "loadbalancer"
+	storeKindFw     = "firewall"
+	storeKindSess   = "session"
+	storeKindUlCl   = "ulcl"
+	storeKindPol    = "policer"
+	storeKindMirr   = "mirror"
+	storeKindBFD    = "bfd"
+)
+
+// storeParamsKey - key of the single params record
+const storeParamsKey = "loxinet"
+
+// storeReplayOrder - config kinds in the order they need to be replayed
+// e.g. IPAM pools and end-points have to exist before LB rules refer to them
+var storeReplayOrder = []string{
+	storeKindParams,
+	storeKindIPPool,
+	storeKindIPSet,
+	storeKindEP,
+	storeKindLB,
+	storeKindFw,
+	storeKindSess,
+	storeKindUlCl,
+	storeKindPol,
+	storeKindMirr,
+	storeKindBFD,
+}
+
+// storeOpen - open the config store and report any damage found in it
+func storeOpen(dir string) *store.Store {
+	s, err := store.Open(dir)
+	if err != nil {
+		tk.LogIt(tk.LogError, "store: failed to open config store %s : %s\n", dir, err)
+		return nil
+	}
+	for _, d := range s.Damage() {
+		tk.LogIt(tk.LogCritical, "store: config store damaged : %s\n", d)
+	}
+	tk.LogIt(tk.LogInfo, "store: config store %s opened\n", dir)
+	return s
+}
+
+// storePut - persist an accepted config object
+func storePut(kind, key string, val interface{}) {
+	if mh.store == nil {
+		return
+	}
+	if err := mh.store.Put(kind, key, val); err != nil {
+		tk.LogIt(tk.LogError, "store: failed to persist %s %s : %s\n", kind, key, err)
+	}
+}
+
+// storeDel - remove a deleted config object
+func storeDel(kind, key string) {
+	if mh.store == nil {
+		return
+	}
+	if err := mh.store.Delete(kind, key); err != nil {
+		tk.LogIt(tk.LogError, "store: failed to remove %s %s : %s\n", kind, key, err)
+	}
+}
+
+// storeLbRule - persist the current state of a LB rule, or remove it if the
+// rule is gone. Stored rules always carry the VIP, so that a rule using an
+// IPAM pool gets the same VIP back on replay
+func storeLbRule(serv cmn.LbServiceArg) {
+	if mh.store == nil {
+		return
+	}
+	serv = mh.zr.Rules.lbBatchServ(serv)
+	if lm := mh.zr.Rules.lbBatchSnapshot(serv); lm != nil {
+		storePut(storeKindLB, cmn.LbServKey(serv), lm)
+	} else {
+		storeDel(storeKindLB, cmn.LbServKey(serv))
+	}
+}
+
+// storeFwKey - key of a firewall rule in the config store
+func storeFwKey(r *cmn.FwRuleArg) string {
+	return fmt.Sprintf("%s,%s,%d-%d,%d-%d,%d,%s,%d", r.SrcIP, r.DstIP, r.SrcPortMin, r.SrcPortMax,
+		r.DstPortMin, r.DstPortMax, r.Proto, r.InPort, r.Pref)
+}
+
+// storeEPKey - key of an end-point in the config store
+func storeEPKey(em *cmn.EndPointMod) string {
+	if em.Name != "" {
+		return em.Name
+	}
+	return makeEPKey(em.HostName, em.ProbeType, em.ProbePort)
+}
+
+// storeUlClKey - key of an ulcl filter in the config store
+func storeUlClKey(sr *cmn.SessionUlClMod) string {
+	return sr.Ident + "/" + sr.Args.Addr.String()
+}
+
+// storeBFDKey - key of a BFD session in the config store
+func storeBFDKey(bm *cmn.BFDMod) string {
+	return bm.Instance + "/" + bm.RemoteIP.String()
+}
+
+// storeApply - apply a persisted config object
+func storeApply(na *NetAPIStruct, kind string, val json.RawMessage) error {
+	var err error
+
+	switch kind {
+	case storeKindParams:
+		var p cmn.ParamMod
+		if err = json.Unmarshal(val, &p); err == nil {
+			_, err = na.NetParamSet(p)
+		}
+	case storeKindIPPool:
+		var pm cmn.IPPoolMod
+		if err = json.Unmarshal(val, &pm); err == nil {
+			_, err = na.NetIPPoolAdd(&pm)
+		}
+	case storeKindIPSet:
+		var im cmn.IPSetMod
+		if err = json.Unmarshal(val, &im); err == nil {
+			_, err = na.NetIPSetAdd(&im)
+		}
+	case storeKindEP:
+		var em cmn.EndPointMod
+		if err = json.Unmarshal(val, &em); err == nil {
+			_, err = na.NetEpHostAdd(&em)
+		}
+	case storeKindLB:
+		var lm cmn.LbRuleMod
+		if err = json.Unmarshal(val, &lm); err == nil {
+			_, err = na.NetLbRuleAdd(&lm)
+		}
+	case storeKindFw:
+		var fm cmn.FwRuleMod
+		if err = json.Unmarshal(val, &fm); err == nil {
+			_, err = na.NetFwRuleAdd(&fm)
+		}
+	case storeKindSess:
+		var sm cmn.SessionMod
+		if err = json.Unmarshal(val, &sm); err == nil {
+			_, err = na.NetSessionAdd(&sm)
+		}
+	case storeKindUlCl:
+		var sr cmn.SessionUlClMod
+		if err = json.Unmarshal(val, &sr); err == nil {
+			_, err = na.NetSessionUlClAdd(&sr)
+		}
+	case storeKindPol:
+		var pm cmn.PolMod
+		if err = json.Unmarshal(val, &pm); err == nil {
+			_, err = na.NetPolicerAdd(&pm)
+		}
+	case storeKindMirr:
+		var mm cmn.MirrMod
+		if err = json.Unmarshal(val, &mm); err == nil {
+			_, err = na.NetMirrorAdd(&mm)
+		}
+	case storeKindBFD:
+		var bm cmn.BFDMod
+		if err = json.Unmarshal(val, &bm); err == nil {
+			_, err = na.NetBFDAdd(&bm)
+		}
+	default:
+		err = fmt.Errorf("unknown config kind %s", kind)
+	}
+	return err
+}
+
+// storeReplay - replay the persisted config in dependency order. Objects
+// which fail to apply are kept in the store and tried again on next boot
+func storeReplay(na *NetAPIStruct) {
+	for _, kind := range storeReplayOrder {
+		recs := mh.store.List(kind)
+		if len(recs) == 0 {
+			continue
+		}
+		done := 0
+		for _, r := range recs {
+			if err := storeApply(na, kind, r.Val); err != nil {
+				tk.LogIt(tk.LogError, "store: replay of %s %s failed : %s\n", kind, r.Key, err)
+				continue
+			}
+			done++
+		}
+		tk.LogIt(tk.LogInfo, "store: replayed %d/%d %s\n", done, len(recs), kind)
+	}
+}
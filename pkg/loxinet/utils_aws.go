@@ -932,6 +932,17 @@ func (aws *AWSAPIStruct) CloudGetPrivateInterfaceID() (int, error) {
 	return link.Attrs().Index, nil
 }
 
+// CloudGetPrivateGateway - Get the gateway reachable over the private interface.
+// This is the first host address of the VPC subnet
+func (aws *AWSAPIStruct) CloudGetPrivateGateway() (net.IP, error) {
+	if awsCIDRnet == nil {
+		return nil, nil
+	}
+	gw := awsCIDRnet.IP.Mask(awsCIDRnet.Mask)
+	gw[3]++
+	return gw, nil
+}
+
 // AWSCloudHookNew - Create AWS specific API hooks
 func AWSCloudHookNew() *AWSAPIStruct {
 	return &AWSAPIStruct{}
@@ -18,6 +18,9 @@ package loxinet
 
 import (
 	"net"
+	"time"
+
+	opts "github.com/loxilb-io/loxilb/options"
 )
 
 // CloudHookInterface - Go interface which needs to be implemented to
@@ -28,11 +31,17 @@ type CloudHookInterface interface {
 	CloudDestroyVIPNetWork() error
 	CloudUpdatePrivateIP(vIP net.IP, eIP net.IP, add bool) error
 	CloudGetPrivateInterfaceID() (int, error)
+	CloudGetPrivateGateway() (net.IP, error)
 }
 
 func CloudHookNew(_ string) CloudHookInterface {
 	if mh.cloudLabel == "aws" {
 		return AWSCloudHookNew()
+	} else if mh.cloudLabel == "ncloud" {
+		return NcloudCloudHookNew()
+	} else if mh.cloudLabel == "webhook" {
+		return WebhookCloudHookNew(opts.Opts.CloudWebhookURL, opts.Opts.CloudWebhookSecret, opts.Opts.CloudInstance,
+			opts.Opts.CloudWebhookRetries, time.Duration(opts.Opts.CloudWebhookTimeout)*time.Second)
 	}
 	return nil
 }
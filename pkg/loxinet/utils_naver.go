@@ -29,6 +29,7 @@ import (
 	"os/user"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	tk "github.com/loxilb-io/loxilib"
@@ -44,15 +45,23 @@ type NcloudConfig struct {
 }
 
 type NcloudClient struct {
-	config    *NcloudConfig
-	client    *http.Client
-	serverURL string
+	config      *NcloudConfig
+	client      *http.Client
+	serverURL   string
+	metadataURL string
+}
+
+// NcloudAPIStruct - struct for anchoring Ncloud routines
+type NcloudAPIStruct struct {
+	client *NcloudClient
+	niID   string
+	vIPs   map[string]net.IP
+	mtx    sync.Mutex
 }
 
 func (n *NcloudClient) NcloudGetMetadataInterfaceID() (string, error) {
-	metadataURL := "http://169.254.169.254"
 	urls := "/latest/meta-data/networkInterfaceNoList/0"
-	req, err := http.NewRequest(http.MethodGet, metadataURL+urls, nil)
+	req, err := http.NewRequest(http.MethodGet, n.metadataURL+urls, nil)
 	if err != nil {
 		return "", err
 	}
@@ -69,6 +78,10 @@ func (n *NcloudClient) NcloudGetMetadataInterfaceID() (string, error) {
 		return "", err
 	}
 
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata request failed with status %d", res.StatusCode)
+	}
+
 	return strings.TrimSpace(string(resBody)), nil
 }
 
@@ -104,7 +117,7 @@ func (n *NcloudClient) NcloudCreatePrivateIp(ni string, vIP net.IP) error {
 	}
 
 	if checkReturn.AssignSecondaryIpsResponse.ReturnMessage != "success" {
-		return fmt.Errorf(string(respBody))
+		return fmt.Errorf("%s", respBody)
 	}
 
 	return nil
@@ -142,7 +155,7 @@ func (n *NcloudClient) NcloudDeletePrivateIp(ni string, vIP net.IP) error {
 	}
 
 	if checkReturn.UnassignSecondaryIpsResponse.ReturnMessage != "success" {
-		return fmt.Errorf(string(respBody))
+		return fmt.Errorf("%s", respBody)
 	}
 
 	return nil
@@ -217,9 +230,10 @@ func NcloudApiInit() error {
 
 func newFromConfig(cfg *NcloudConfig) *NcloudClient {
 	return &NcloudClient{
-		config:    cfg,
-		client:    &http.Client{},
-		serverURL: "https://ncloud.apigw.ntruss.com",
+		config:      cfg,
+		client:      &http.Client{Timeout: time.Second * 30},
+		serverURL:   "https://ncloud.apigw.ntruss.com",
+		metadataURL: "http://169.254.169.254",
 	}
 }
 
@@ -252,3 +266,122 @@ func loadDefaultConfig() (*NcloudConfig, error) {
 
 	return &ncloudConfig, nil
 }
+
+// CloudAPIInit - Initialize the Ncloud API
+func (nc *NcloudAPIStruct) CloudAPIInit(cloudCIDRBlock string) error {
+	if nc.client == nil {
+		NcloudApiInit()
+		nc.client = nClient
+	}
+
+	if cloudCIDRBlock != "" {
+		tk.LogIt(tk.LogInfo, "ncloud: cloud cidr block %s is not supported\n", cloudCIDRBlock)
+	}
+
+	if nc.client.checkNcloudCredential(); nc.client.config == nil {
+		return fmt.Errorf("failed to load Ncloud credential")
+	}
+
+	niID, err := nc.client.NcloudGetMetadataInterfaceID()
+	if err != nil {
+		tk.LogIt(tk.LogError, "ncloud: failed to get network interface: %v\n", err)
+		return err
+	}
+	nc.niID = niID
+
+	tk.LogIt(tk.LogInfo, "ncloud: API init - interface %s\n", nc.niID)
+	return nil
+}
+
+// CloudPrepareVIPNetWork - Prepare the VIP network on mastership transition.
+// Ncloud secondary IPs live on the primary interface, so only the interface
+// information needs to be valid here
+func (nc *NcloudAPIStruct) CloudPrepareVIPNetWork() error {
+	if nc.niID != "" {
+		return nil
+	}
+
+	niID, err := nc.client.NcloudGetMetadataInterfaceID()
+	if err != nil {
+		tk.LogIt(tk.LogError, "ncloud: failed to get network interface: %v\n", err)
+		return err
+	}
+	nc.niID = niID
+	return nil
+}
+
+// CloudUnPrepareVIPNetWork - Unprepare the VIP network on backup transition.
+// VIPs are unassigned one by one with CloudUpdatePrivateIP
+func (nc *NcloudAPIStruct) CloudUnPrepareVIPNetWork() error {
+	return nil
+}
+
+// CloudDestroyVIPNetWork - Unassign all VIPs assigned by this instance
+func (nc *NcloudAPIStruct) CloudDestroyVIPNetWork() error {
+	var lastErr error
+
+	nc.mtx.Lock()
+	defer nc.mtx.Unlock()
+
+	for key, vIP := range nc.vIPs {
+		if err := nc.client.NcloudDeletePrivateIp(nc.niID, vIP); err != nil {
+			tk.LogIt(tk.LogError, "ncloud: vip %s unassign failed: %v\n", key, err)
+			lastErr = err
+			continue
+		}
+		delete(nc.vIPs, key)
+		tk.LogIt(tk.LogInfo, "ncloud: vip %s unassigned\n", key)
+	}
+
+	return lastErr
+}
+
+// CloudUpdatePrivateIP - Assign or unassign a VIP as secondary IP of the instance.
+// Elastic IP association is not supported in Ncloud
+func (nc *NcloudAPIStruct) CloudUpdatePrivateIP(vIP net.IP, eIP net.IP, add bool) error {
+	var err error
+
+	if eIP != nil && !vIP.Equal(eIP) {
+		tk.LogIt(tk.LogInfo, "ncloud: elastic ip %s not supported, using vip %s\n", eIP.String(), vIP.String())
+	}
+
+	if nc.niID == "" {
+		if err = nc.CloudPrepareVIPNetWork(); err != nil {
+			return err
+		}
+	}
+
+	nc.mtx.Lock()
+	defer nc.mtx.Unlock()
+
+	if add {
+		err = nc.client.NcloudCreatePrivateIp(nc.niID, vIP)
+		if err == nil {
+			nc.vIPs[vIP.String()] = vIP
+		}
+	} else {
+		err = nc.client.NcloudDeletePrivateIp(nc.niID, vIP)
+		if err == nil {
+			delete(nc.vIPs, vIP.String())
+		}
+	}
+
+	return err
+}
+
+// CloudGetPrivateInterfaceID - Get the interface index used for VIPs.
+// Ncloud VIPs are secondary IPs of the primary interface, so there is no
+// separate interface to steer the default route to
+func (nc *NcloudAPIStruct) CloudGetPrivateInterfaceID() (int, error) {
+	return -1, nil
+}
+
+// CloudGetPrivateGateway - Get the gateway reachable over the private interface
+func (nc *NcloudAPIStruct) CloudGetPrivateGateway() (net.IP, error) {
+	return nil, nil
+}
+
+// NcloudCloudHookNew - Create Ncloud specific API hooks
+func NcloudCloudHookNew() *NcloudAPIStruct {
+	return &NcloudAPIStruct{vIPs: make(map[string]net.IP)}
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2023 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// ncloudStandIn - local stand-in for the ncloud metadata and vserver APIs
+type ncloudStandIn struct {
+	mtx      sync.Mutex
+	assigned map[string]bool
+	fail     bool
+}
+
+func (s *ncloudStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if r.URL.Path == "/latest/meta-data/networkInterfaceNoList/0" {
+		fmt.Fprintln(w, "1234")
+		return
+	}
+
+	if r.Header.Get("x-ncp-iam-access-key") != "akey" ||
+		r.Header.Get("x-ncp-apigw-signature-v2") == "" ||
+		r.Header.Get("x-ncp-apigw-timestamp") == "" {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"errorCode":"200","message":"Authentication Failed"}}`)
+		return
+	}
+
+	q := r.URL.Query()
+	if q.Get("networkInterfaceNo") != "1234" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	ip := q.Get("secondaryIpList.1")
+	msg := "success"
+	if s.fail {
+		msg = "failure"
+	}
+
+	switch r.URL.Path {
+	case "/vserver/v2/assignSecondaryIps":
+		if !s.fail {
+			s.assigned[ip] = true
+		}
+		fmt.Fprintf(w, `{"assignSecondaryIpsResponse":{"returnMessage":"%s"}}`, msg)
+	case "/vserver/v2/unassignSecondaryIps":
+		if !s.fail {
+			delete(s.assigned, ip)
+		}
+		fmt.Fprintf(w, `{"unassignSecondaryIpsResponse":{"returnMessage":"%s"}}`, msg)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newNcloudTestHook(t *testing.T) (*NcloudAPIStruct, *ncloudStandIn) {
+	standIn := &ncloudStandIn{assigned: make(map[string]bool)}
+	srv := httptest.NewServer(standIn)
+	t.Cleanup(srv.Close)
+
+	hook := NcloudCloudHookNew()
+	hook.client = newFromConfig(&NcloudConfig{AccessKey: "akey", SecretKey: "skey"})
+	hook.client.serverURL = srv.URL
+	hook.client.metadataURL = srv.URL
+
+	return hook, standIn
+}
+
+func TestNcloudCloudHook(t *testing.T) {
+	hook, standIn := newNcloudTestHook(t)
+
+	if err := hook.CloudAPIInit(""); err != nil {
+		t.Fatalf("ncloud api init failed: %v", err)
+	}
+	if hook.niID != "1234" {
+		t.Fatalf("wrong ncloud interface %s", hook.niID)
+	}
+
+	vIP1 := net.ParseIP("10.0.0.10")
+	vIP2 := net.ParseIP("10.0.0.11")
+	if err := hook.CloudUpdatePrivateIP(vIP1, vIP1, true); err != nil {
+		t.Errorf("ncloud vip %s assign failed: %v", vIP1, err)
+	}
+	if err := hook.CloudUpdatePrivateIP(vIP2, vIP2, true); err != nil {
+		t.Errorf("ncloud vip %s assign failed: %v", vIP2, err)
+	}
+	if !standIn.assigned[vIP1.String()] || !standIn.assigned[vIP2.String()] {
+		t.Errorf("ncloud vips not assigned: %v", standIn.assigned)
+	}
+
+	if err := hook.CloudUpdatePrivateIP(vIP1, vIP1, false); err != nil {
+		t.Errorf("ncloud vip %s unassign failed: %v", vIP1, err)
+	}
+	if standIn.assigned[vIP1.String()] {
+		t.Errorf("ncloud vip %s still assigned", vIP1)
+	}
+
+	if err := hook.CloudDestroyVIPNetWork(); err != nil {
+		t.Errorf("ncloud destroy failed: %v", err)
+	}
+	if len(standIn.assigned) != 0 || len(hook.vIPs) != 0 {
+		t.Errorf("ncloud vips left after destroy: %v", standIn.assigned)
+	}
+
+	if id, err := hook.CloudGetPrivateInterfaceID(); err != nil || id != -1 {
+		t.Errorf("ncloud unexpected private interface %d:%v", id, err)
+	}
+}
+
+func TestNcloudCloudHookErrors(t *testing.T) {
+	hook, standIn := newNcloudTestHook(t)
+
+	if err := hook.CloudAPIInit(""); err != nil {
+		t.Fatalf("ncloud api init failed: %v", err)
+	}
+
+	standIn.fail = true
+	vIP := net.ParseIP("10.0.0.12")
+	if err := hook.CloudUpdatePrivateIP(vIP, vIP, true); err == nil {
+		t.Errorf("ncloud vip %s assign should fail", vIP)
+	}
+	if len(hook.vIPs) != 0 {
+		t.Errorf("ncloud failed vip %s is tracked", vIP)
+	}
+
+	hook.client.config.AccessKey = "bad"
+	standIn.fail = false
+	if err := hook.CloudUpdatePrivateIP(vIP, vIP, true); err == nil {
+		t.Errorf("ncloud vip %s assign with bad credential should fail", vIP)
+	}
+}
@@ -0,0 +1,342 @@
+/*
+ * Copyright (c) 2024 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	tk "github.com/loxilb-io/loxilib"
+	nl "github.com/vishvananda/netlink"
+)
+
+// This file implements a generic cloud hook which hands over all cloud
+// operations to an external webhook. Every callout is a JSON POST signed
+// with HMAC-SHA256 over "<timestamp>.<body>" using a shared secret
+
+// webhook operation names
+const (
+	WebhookOpInit            = "init"
+	WebhookOpPrepareVIPNet   = "prepareVIPNetwork"
+	WebhookOpUnPrepareVIPNet = "unprepareVIPNetwork"
+	WebhookOpDestroyVIPNet   = "destroyVIPNetwork"
+	WebhookOpUpdatePrivIP    = "updatePrivateIP"
+)
+
+// webhook signing headers
+const (
+	WebhookHdrTimestamp = "X-Loxilb-Timestamp"
+	WebhookHdrSignature = "X-Loxilb-Signature"
+)
+
+// WebhookRequest - payload of a webhook callout
+type WebhookRequest struct {
+	Op        string `json:"op"`
+	Instance  string `json:"instance,omitempty"`
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+	VIP       string `json:"vip,omitempty"`
+	EIP       string `json:"eip,omitempty"`
+	Add       bool   `json:"add,omitempty"`
+}
+
+// WebhookResponse - optional payload of a webhook reply
+type WebhookResponse struct {
+	PrivateInterface string `json:"privateInterface,omitempty"`
+	PrivateGateway   string `json:"privateGateway,omitempty"`
+	Message          string `json:"message,omitempty"`
+}
+
+// webhookJob - a callout whose retries are deferred to the retry worker
+type webhookJob struct {
+	op     string
+	body   []byte
+	try    int
+	status string
+	apply  func(*WebhookResponse)
+}
+
+// WebhookAPIStruct - struct for anchoring webhook routines
+type WebhookAPIStruct struct {
+	url      string
+	secret   string
+	instance string
+	retries  int
+	client   *http.Client
+	backoff  time.Duration
+	privIf   string
+	privGw   net.IP
+	pending  []*webhookJob
+	retrying bool
+	report   func(status string, err error)
+	mtx      sync.Mutex
+}
+
+// WebhookSign - compute the signature of a webhook callout
+func WebhookSign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// attempt - send a request to the webhook once. retry is set for
+// transport errors, 429 and 5xx replies
+func (wh *WebhookAPIStruct) attempt(op string, body []byte) (wResp *WebhookResponse, retry bool, err error) {
+	resp, err := wh.post(body)
+	if err != nil {
+		return nil, true, err
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		wResp = new(WebhookResponse)
+		if len(bytes.TrimSpace(respBody)) != 0 {
+			if err := json.Unmarshal(respBody, wResp); err != nil {
+				return nil, false, fmt.Errorf("webhook %s: malformed reply: %v", op, err)
+			}
+		}
+		return wResp, false, nil
+	}
+
+	err = fmt.Errorf("webhook %s: status %d: %s", op, resp.StatusCode, bytes.TrimSpace(respBody))
+	return nil, resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
+
+// send - send a request to the webhook starting at the given attempt,
+// retrying with exponential backoff till retries are exhausted
+func (wh *WebhookAPIStruct) send(op string, body []byte, try int) (*WebhookResponse, error) {
+	var lastErr error
+
+	for ; try <= wh.retries; try++ {
+		if try > 0 {
+			time.Sleep(wh.backoff * time.Duration(1<<(try-1)))
+		}
+
+		resp, retry, err := wh.attempt(op, body)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+		tk.LogIt(tk.LogDebug, "webhook: %s attempt %d failed: %v\n", op, try+1, lastErr)
+	}
+
+	tk.LogIt(tk.LogError, "webhook: %s failed: %v\n", op, lastErr)
+	return nil, lastErr
+}
+
+// marshal - encode a webhook request
+func (wh *WebhookAPIStruct) marshal(wr *WebhookRequest) ([]byte, error) {
+	wr.Instance = wh.instance
+	return json.Marshal(wr)
+}
+
+// callout - send a request to the webhook, retrying on transport errors,
+// 429 and 5xx replies. It must not be used with the global lock held
+func (wh *WebhookAPIStruct) callout(wr *WebhookRequest) (*WebhookResponse, error) {
+	body, err := wh.marshal(wr)
+	if err != nil {
+		return nil, err
+	}
+	return wh.send(wr.Op, body, 0)
+}
+
+// calloutDeferred - queue a request to the webhook. This is used by the
+// hooks which are invoked with the global lock held, so the request and any
+// retries are handed over to the retry worker and the final result is
+// reported later against status. Requests are sent in the order they are
+// queued. apply is called with the reply of a successful callout
+func (wh *WebhookAPIStruct) calloutDeferred(wr *WebhookRequest, status string, apply func(*WebhookResponse)) error {
+	body, err := wh.marshal(wr)
+	if err != nil {
+		return err
+	}
+	job := &webhookJob{op: wr.Op, body: body, status: status, apply: apply}
+
+	wh.mtx.Lock()
+	wh.pending = append(wh.pending, job)
+	if !wh.retrying {
+		wh.retrying = true
+		go wh.retryWorker()
+	}
+	wh.mtx.Unlock()
+
+	return nil
+}
+
+// retryWorker - send deferred callouts in order and report their results
+func (wh *WebhookAPIStruct) retryWorker() {
+	for {
+		wh.mtx.Lock()
+		if len(wh.pending) == 0 {
+			wh.retrying = false
+			wh.mtx.Unlock()
+			return
+		}
+		job := wh.pending[0]
+		wh.mtx.Unlock()
+
+		resp, err := wh.send(job.op, job.body, job.try)
+		if err == nil && job.apply != nil {
+			job.apply(resp)
+		}
+		if wh.report != nil {
+			wh.report(job.status, err)
+		}
+
+		wh.mtx.Lock()
+		wh.pending = wh.pending[1:]
+		wh.mtx.Unlock()
+	}
+}
+
+// webhookStatusReport - record the result of a deferred callout in the HA state
+func webhookStatusReport(status string, err error) {
+	mh.mtx.Lock()
+	mh.has.CICloudStatusUpdate(status, err)
+	mh.mtx.Unlock()
+}
+
+func (wh *WebhookAPIStruct) post(body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, wh.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHdrTimestamp, timestamp)
+	if wh.secret != "" {
+		req.Header.Set(WebhookHdrSignature, WebhookSign(wh.secret, timestamp, body))
+	}
+
+	return wh.client.Do(req)
+}
+
+// setPrivate - learn the interface and gateway to be used for VIP traffic
+// from a webhook reply
+func (wh *WebhookAPIStruct) setPrivate(resp *WebhookResponse) {
+	wh.mtx.Lock()
+	wh.privIf = resp.PrivateInterface
+	wh.privGw = net.ParseIP(resp.PrivateGateway)
+	wh.mtx.Unlock()
+}
+
+// CloudAPIInit - Initialize the webhook cloud hook. The webhook may reply
+// with the interface and gateway to be used for VIP traffic
+func (wh *WebhookAPIStruct) CloudAPIInit(cloudCIDRBlock string) error {
+	resp, err := wh.callout(&WebhookRequest{Op: WebhookOpInit, CIDRBlock: cloudCIDRBlock})
+	if err != nil {
+		return err
+	}
+	wh.setPrivate(resp)
+
+	tk.LogIt(tk.LogInfo, "webhook: API init - %s\n", wh.url)
+	return nil
+}
+
+// CloudPrepareVIPNetWork - Prepare the VIP network on mastership transition
+func (wh *WebhookAPIStruct) CloudPrepareVIPNetWork() error {
+	return wh.calloutDeferred(&WebhookRequest{Op: WebhookOpPrepareVIPNet}, "prepare-vip-network",
+		func(resp *WebhookResponse) {
+			if resp.PrivateInterface != "" {
+				wh.setPrivate(resp)
+			}
+		})
+}
+
+// CloudUnPrepareVIPNetWork - Unprepare the VIP network on backup transition
+func (wh *WebhookAPIStruct) CloudUnPrepareVIPNetWork() error {
+	return wh.calloutDeferred(&WebhookRequest{Op: WebhookOpUnPrepareVIPNet}, "unprepare-vip-network", nil)
+}
+
+// CloudDestroyVIPNetWork - Destroy all cloud resources related to VIPs
+func (wh *WebhookAPIStruct) CloudDestroyVIPNetWork() error {
+	_, err := wh.callout(&WebhookRequest{Op: WebhookOpDestroyVIPNet})
+	return err
+}
+
+// CloudUpdatePrivateIP - Move a VIP (and an elastic IP if any) to this instance
+// or release it
+func (wh *WebhookAPIStruct) CloudUpdatePrivateIP(vIP net.IP, eIP net.IP, add bool) error {
+	wr := WebhookRequest{Op: WebhookOpUpdatePrivIP, VIP: vIP.String(), Add: add}
+	if eIP != nil && !eIP.Equal(vIP) {
+		wr.EIP = eIP.String()
+	}
+	return wh.calloutDeferred(&wr, "update-private-ip", nil)
+}
+
+// CloudGetPrivateInterfaceID - Get the interface index used for VIP traffic
+// as told by the webhook
+func (wh *WebhookAPIStruct) CloudGetPrivateInterfaceID() (int, error) {
+	wh.mtx.Lock()
+	privIf := wh.privIf
+	wh.mtx.Unlock()
+
+	if privIf == "" {
+		return -1, nil
+	}
+
+	link, err := nl.LinkByName(privIf)
+	if err != nil {
+		tk.LogIt(tk.LogError, "webhook: failed to get link (%s). err: %v\n", privIf, err)
+		return -1, err
+	}
+
+	return link.Attrs().Index, nil
+}
+
+// CloudGetPrivateGateway - Get the gateway reachable over the private
+// interface as told by the webhook
+func (wh *WebhookAPIStruct) CloudGetPrivateGateway() (net.IP, error) {
+	wh.mtx.Lock()
+	defer wh.mtx.Unlock()
+	return wh.privGw, nil
+}
+
+// WebhookCloudHookNew - Create webhook based cloud hooks
+func WebhookCloudHookNew(url, secret, instance string, retries int, timeout time.Duration) *WebhookAPIStruct {
+	if retries < 0 {
+		retries = 0
+	}
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &WebhookAPIStruct{
+		url:      url,
+		secret:   secret,
+		instance: instance,
+		retries:  retries,
+		client:   &http.Client{Timeout: timeout},
+		backoff:  500 * time.Millisecond,
+		report:   webhookStatusReport,
+	}
+}
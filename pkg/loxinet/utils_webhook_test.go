@@ -0,0 +1,211 @@
+/*
+ * Copyright (c) 2024 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package loxinet
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// webhookStandIn - local webhook which verifies signatures and can fail
+// a number of requests before succeeding
+type webhookStandIn struct {
+	mtx      sync.Mutex
+	secret   string
+	failures int
+	status   int
+	delay    time.Duration
+	reqs     []WebhookRequest
+}
+
+func (s *webhookStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	sig := WebhookSign(s.secret, r.Header.Get(WebhookHdrTimestamp), body)
+	if r.Header.Get(WebhookHdrSignature) != sig {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	s.mtx.Lock()
+	var wr WebhookRequest
+	json.Unmarshal(body, &wr)
+	s.reqs = append(s.reqs, wr)
+	fail := s.failures > 0
+	if fail {
+		s.failures--
+	}
+	delay := s.delay
+	s.mtx.Unlock()
+
+	time.Sleep(delay)
+	if fail {
+		w.WriteHeader(s.status)
+		return
+	}
+	if wr.Op == WebhookOpInit {
+		w.Write([]byte(`{"privateInterface":"eth1","privateGateway":"10.10.10.254"}`))
+	}
+}
+
+// webhookReport - result of a deferred callout
+type webhookReport struct {
+	status string
+	err    error
+}
+
+func newWebhookTestHook(t *testing.T, secret string, retries int, timeout time.Duration) (*WebhookAPIStruct, *webhookStandIn, chan webhookReport) {
+	standIn := &webhookStandIn{secret: "secret", status: http.StatusServiceUnavailable}
+	srv := httptest.NewServer(standIn)
+	t.Cleanup(srv.Close)
+
+	reports := make(chan webhookReport, 16)
+	hook := WebhookCloudHookNew(srv.URL, secret, "inst1", retries, timeout)
+	hook.backoff = time.Millisecond
+	hook.report = func(status string, err error) {
+		reports <- webhookReport{status, err}
+	}
+	return hook, standIn, reports
+}
+
+func waitWebhookReport(t *testing.T, reports chan webhookReport) webhookReport {
+	select {
+	case r := <-reports:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatalf("webhook deferred callout not reported")
+	}
+	return webhookReport{}
+}
+
+func TestWebhookCloudHook(t *testing.T) {
+	hook, standIn, reports := newWebhookTestHook(t, "secret", 2, time.Second)
+
+	if err := hook.CloudAPIInit("123.123.123.0/24"); err != nil {
+		t.Fatalf("webhook init failed: %v", err)
+	}
+	if hook.privIf != "eth1" {
+		t.Errorf("webhook private interface not learnt: %s", hook.privIf)
+	}
+	if gw, _ := hook.CloudGetPrivateGateway(); !gw.Equal(net.ParseIP("10.10.10.254")) {
+		t.Errorf("webhook private gateway not learnt: %v", gw)
+	}
+
+	vIP := net.ParseIP("10.10.10.1")
+	eIP := net.ParseIP("1.1.1.1")
+	if err := hook.CloudUpdatePrivateIP(vIP, eIP, true); err != nil {
+		t.Errorf("webhook update private ip failed: %v", err)
+	}
+	if r := waitWebhookReport(t, reports); r.status != "update-private-ip" || r.err != nil {
+		t.Errorf("webhook update private ip not reported: %+v", r)
+	}
+
+	standIn.mtx.Lock()
+	wr := standIn.reqs[len(standIn.reqs)-1]
+	standIn.mtx.Unlock()
+	if wr.Op != WebhookOpUpdatePrivIP || wr.VIP != "10.10.10.1" || wr.EIP != "1.1.1.1" || !wr.Add || wr.Instance != "inst1" {
+		t.Errorf("webhook unexpected request: %+v", wr)
+	}
+
+	// Transient failures are retried in the background
+	standIn.failures = 2
+	if err := hook.CloudPrepareVIPNetWork(); err != nil {
+		t.Errorf("webhook prepare failed: %v", err)
+	}
+	if r := waitWebhookReport(t, reports); r.status != "prepare-vip-network" || r.err != nil {
+		t.Errorf("webhook prepare not retried: %+v", r)
+	}
+
+	// Retries are bounded
+	standIn.failures = 3
+	if err := hook.CloudUnPrepareVIPNetWork(); err != nil {
+		t.Errorf("webhook unprepare failed: %v", err)
+	}
+	if r := waitWebhookReport(t, reports); r.status != "unprepare-vip-network" || r.err == nil {
+		t.Errorf("webhook unprepare should fail after retries: %+v", r)
+	}
+
+	// Neither the first attempt nor retries block the caller and later
+	// callouts are kept in order
+	hook.backoff = 200 * time.Millisecond
+	standIn.failures = 1
+	standIn.delay = 200 * time.Millisecond
+	start := time.Now()
+	hook.CloudUpdatePrivateIP(vIP, eIP, true)
+	hook.CloudUpdatePrivateIP(vIP, eIP, false)
+	if time.Since(start) >= standIn.delay {
+		t.Errorf("webhook callout blocked for %v", time.Since(start))
+	}
+	for i := 0; i < 2; i++ {
+		if r := waitWebhookReport(t, reports); r.status != "update-private-ip" || r.err != nil {
+			t.Errorf("webhook update private ip not retried: %+v", r)
+		}
+	}
+	standIn.mtx.Lock()
+	n := len(standIn.reqs)
+	if n < 2 || !standIn.reqs[n-2].Add || standIn.reqs[n-1].Add {
+		t.Errorf("webhook callouts out of order: %+v", standIn.reqs[n-2:])
+	}
+	standIn.delay = 0
+	standIn.mtx.Unlock()
+	hook.backoff = time.Millisecond
+
+	// Callouts made without the global lock are retried in place
+	standIn.failures = 2
+	if err := hook.CloudDestroyVIPNetWork(); err != nil {
+		t.Errorf("webhook destroy not retried: %v", err)
+	}
+
+	// Client errors are not retried
+	standIn.failures = 1
+	standIn.status = http.StatusBadRequest
+	nReqs := len(standIn.reqs)
+	if err := hook.CloudDestroyVIPNetWork(); err == nil {
+		t.Errorf("webhook destroy should fail")
+	}
+	if len(standIn.reqs) != nReqs+1 {
+		t.Errorf("webhook client error retried %d times", len(standIn.reqs)-nReqs-1)
+	}
+
+	// Deferred callouts report client errors without retrying
+	standIn.failures = 1
+	nReqs = len(standIn.reqs)
+	hook.CloudUnPrepareVIPNetWork()
+	if r := waitWebhookReport(t, reports); r.status != "unprepare-vip-network" || r.err == nil {
+		t.Errorf("webhook unprepare should fail: %+v", r)
+	}
+	if len(standIn.reqs) != nReqs+1 {
+		t.Errorf("webhook client error retried %d times", len(standIn.reqs)-nReqs-1)
+	}
+}
+
+func TestWebhookCloudHookErrors(t *testing.T) {
+	hook, standIn, _ := newWebhookTestHook(t, "bad-secret", 0, 50*time.Millisecond)
+	if err := hook.CloudAPIInit(""); err == nil {
+		t.Errorf("webhook init with wrong signature should fail")
+	}
+
+	hook.secret = "secret"
+	standIn.delay = 200 * time.Millisecond
+	if err := hook.CloudDestroyVIPNetWork(); err == nil {
+		t.Errorf("webhook callout should time out")
+	}
+}
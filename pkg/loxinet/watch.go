@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package loxinet
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// This file implements the broker of change events streamed by the watch API.
+// Recent events are kept in a ring so that watchers can resume from the last
+// resource version they have seen
+
+// watch constants
+const (
+	WatchRingLen = 4096
+	WatchSubQLen = 1024
+)
+
+// watchKinds - kinds of objects which can be watched
+var watchKinds = map[string]bool{
+	cmn.WatchKindLB:       true,
+	cmn.WatchKindEndPoint: true,
+	cmn.WatchKindHAState:  true,
+	cmn.WatchKindBGPNeigh: true,
+	cmn.WatchKindPort:     true,
+}
+
+// watchSub - a watcher
+type watchSub struct {
+	kinds map[string]bool
+	ch    chan cmn.WatchEvent
+}
+
+// WatchH - watch event broker
+type WatchH struct {
+	mtx     sync.Mutex
+	version uint64
+	ring    []cmn.WatchEvent
+	subs    map[*watchSub]struct{}
+}
+
+// WatchInit - initialize the watch event broker
+func WatchInit() *WatchH {
+	nW := new(WatchH)
+	nW.ring = make([]cmn.WatchEvent, 0, WatchRingLen)
+	nW.subs = make(map[*watchSub]struct{})
+	return nW
+}
+
+// WatchPublish - publish a change event to all interested watchers. Watchers
+// which can't keep up are dropped and need to resume from their last version
+func (W *WatchH) WatchPublish(kind, evType, key string, obj interface{}) {
+	if W == nil {
+		return
+	}
+
+	W.mtx.Lock()
+	defer W.mtx.Unlock()
+
+	W.version++
+	ev := cmn.WatchEvent{Version: W.version, Kind: kind, Type: evType, Key: key, Time: time.Now(), Object: obj}
+	if len(W.ring) < WatchRingLen {
+		W.ring = append(W.ring, ev)
+	} else {
+		W.ring[(W.version-1)%WatchRingLen] = ev
+	}
+
+	for s := range W.subs {
+		if len(s.kinds) != 0 && !s.kinds[kind] {
+			continue
+		}
+		select {
+		case s.ch <- ev:
+		default:
+			tk.LogIt(tk.LogWarning, "watch: dropping slow watcher at version %d\n", ev.Version)
+			delete(W.subs, s)
+			close(s.ch)
+		}
+	}
+}
+
+// WatchSubscribe - start watching events of the given kinds (all kinds if
+// empty). If since is not zero, the events after that version are replayed
+// first. It fails if those events are no longer available
+func (W *WatchH) WatchSubscribe(kinds []string, since uint64) (<-chan cmn.WatchEvent, func(), error) {
+	s := &watchSub{kinds: make(map[string]bool), ch: make(chan cmn.WatchEvent, WatchRingLen+WatchSubQLen)}
+	for _, k := range kinds {
+		if k == "" {
+			continue
+		}
+		if !watchKinds[k] {
+			return nil, nil, fmt.Errorf("malformed watch kind %s", k)
+		}
+		s.kinds[k] = true
+	}
+
+	W.mtx.Lock()
+	defer W.mtx.Unlock()
+
+	if since != 0 {
+		oldest := uint64(1)
+		if W.version > uint64(len(W.ring)) {
+			oldest = W.version - uint64(len(W.ring)) + 1
+		}
+		if since > W.version || since+1 < oldest {
+			return nil, nil, errors.New("watch resource-version expired error")
+		}
+		for v := since + 1; v <= W.version; v++ {
+			ev := W.ring[(v-1)%WatchRingLen]
+			if len(s.kinds) == 0 || s.kinds[ev.Kind] {
+				s.ch <- ev
+			}
+		}
+	}
+
+	W.subs[s] = struct{}{}
+
+	cancel := func() {
+		W.mtx.Lock()
+		defer W.mtx.Unlock()
+		if _, ok := W.subs[s]; ok {
+			delete(W.subs, s)
+			close(s.ch)
+		}
+	}
+
+	return s.ch, cancel, nil
+}
+
+// watchLBRule - publish a change of a LB rule
+func (R *RuleH) watchLBRule(r *ruleEnt, evType string) {
+	if mh.watch == nil {
+		return
+	}
+	lm, err := R.lbRule2Mod(r)
+	if err != nil {
+		return
+	}
+	if evType == cmn.WatchEvDeleted {
+		mh.watch.WatchPublish(cmn.WatchKindLB, evType, cmn.LbServKey(lm.Serv), lm.Serv)
+		return
+	}
+	mh.watch.WatchPublish(cmn.WatchKindLB, evType, cmn.LbServKey(lm.Serv), lm)
+}
+
+// watchEPHost - publish a state change of an end-point
+func (ep *epHost) watchEPHost() {
+	if mh.watch == nil {
+		return
+	}
+	mh.watch.WatchPublish(cmn.WatchKindEndPoint, cmn.WatchEvModified, ep.epKey, epHost2Mod(ep))
+}
@@ -53,6 +53,8 @@ type Zone struct {
 	Sess    *SessH
 	Pols    *PolH
 	Mirrs   *MirrH
+	Ipam    *IpamH
+	IPSets  *IPSetH
 	Mtx     sync.RWMutex
 }
 
@@ -98,6 +100,8 @@ func (z *ZoneH) ZoneAdd(name string) (int, error) {
 	zone.Nh = NeighInit(zone)
 	zone.Rt = RtInit(zone)
 	zone.L3 = L3Init(zone)
+	zone.Ipam = IpamInit(zone)
+	zone.IPSets = IPSetInit(zone)
 	zone.Rules = RulesInit(zone)
 	zone.Sess = SessInit(zone)
 	zone.Pols = PolInit(zone)
@@ -127,6 +131,7 @@ func (z *ZoneH) ZoneDelete(name string) (int, error) {
 	}
 
 	zone.Rules.RuleDestructAll()
+	zone.IPSets.IPSetDestructAll()
 	zone.Mirrs.MirrDestructAll()
 	zone.Pols.PolDestructAll()
 	zone.Rt.RtDestructAll()
@@ -0,0 +1,429 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package store provides a journaled on-disk key-value store for config
+// objects. It has no dependencies outside the standard library.
+//
+// Every change is appended to a journal as a length and CRC32C framed record
+// and synced to disk before it is acknowledged. Once the journal grows past
+// CompactRecords, the live objects are written to a new snapshot which
+// atomically replaces the old one, and the journal is truncated.
+//
+// On open, the snapshot is loaded and the journal is replayed on top of it.
+// A record cut short at the end of the journal is the result of a crash in
+// the middle of a write and is dropped. A record which fails its checksum
+// means the file is corrupt: replay stops there and the damaged file is kept
+// aside as <name>.corrupt-<UTC timestamp> for inspection. Either way the
+// problem is reported by Damage().
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// JournalFile - name of the journal within the store directory
+	JournalFile = "journal"
+	// SnapshotFile - name of the snapshot within the store directory
+	SnapshotFile = "snapshot"
+	// CompactRecords - number of journal records which trigger a compaction
+	CompactRecords = 1024
+	// MaxRecordLen - upper bound of a record, anything larger is corruption
+	MaxRecordLen = 16 << 20
+
+	recHdrLen = 8
+)
+
+const (
+	opPut  = "put"
+	opDel  = "del"
+	opMeta = "meta"
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Record is a stored object.
+type Record struct {
+	// Seq is the sequence number of the last change of the object
+	Seq uint64 `json:"seq"`
+	// Kind is the kind of the object, e.g. "loadbalancer"
+	Kind string `json:"kind"`
+	// Key identifies the object within its kind
+	Key string `json:"key"`
+	// Val is the JSON encoded object
+	Val json.RawMessage `json:"val,omitempty"`
+}
+
+// rec is the on-disk form of a change
+type rec struct {
+	Op string `json:"op"`
+	Record
+}
+
+// Store is a journaled key-value store. It is safe for concurrent use.
+type Store struct {
+	mtx      sync.Mutex
+	dir      string
+	journal  *os.File
+	jRecs    int
+	seq      uint64
+	objs     map[string]map[string]Record
+	damage   []string
+	closed   bool
+	compactN int
+}
+
+// Open opens the store in dir, creating it if needed, and loads its contents.
+// It fails only if the store can't be accessed; damaged contents are recovered
+// from as far as possible and reported by Damage().
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	s := &Store{dir: dir, objs: make(map[string]map[string]Record), compactN: CompactRecords}
+
+	snapPath := filepath.Join(dir, SnapshotFile)
+	if _, err := s.load(snapPath, false); err != nil {
+		return nil, err
+	}
+
+	jPath := filepath.Join(dir, JournalFile)
+	good, err := s.load(jPath, true)
+	if err != nil {
+		return nil, err
+	}
+
+	s.journal, err = os.OpenFile(jPath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	// Drop anything after the last good record so that new records follow it
+	if err := s.journal.Truncate(good); err != nil {
+		s.journal.Close()
+		return nil, err
+	}
+	if _, err := s.journal.Seek(good, io.SeekStart); err != nil {
+		s.journal.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// load applies the records of a snapshot or journal file and returns the
+// offset after the last good record
+func (s *Store) load(path string, journal bool) (int64, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	var off int64
+	var snapSeq uint64
+	r := bufio.NewReader(f)
+	hdr := make([]byte, recHdrLen)
+	for {
+		if _, err := io.ReadFull(r, hdr); err != nil {
+			if err == io.ErrUnexpectedEOF {
+				s.damage = append(s.damage, fmt.Sprintf("%s: partial record header at offset %d dropped", path, off))
+			} else if err != io.EOF {
+				return off, err
+			}
+			break
+		}
+		n := binary.BigEndian.Uint32(hdr[0:4])
+		sum := binary.BigEndian.Uint32(hdr[4:8])
+		if n > MaxRecordLen {
+			s.corrupt(path, off, "bad record length")
+			break
+		}
+		payload := make([]byte, n)
+		if _, err := io.ReadFull(r, payload); err != nil {
+			if err == io.ErrUnexpectedEOF || err == io.EOF {
+				s.damage = append(s.damage, fmt.Sprintf("%s: partial record at offset %d dropped", path, off))
+				break
+			}
+			return off, err
+		}
+		if crc32.Checksum(payload, crcTable) != sum {
+			s.corrupt(path, off, "checksum mismatch")
+			break
+		}
+		var c rec
+		if err := json.Unmarshal(payload, &c); err != nil {
+			s.corrupt(path, off, "undecodable record")
+			break
+		}
+		off += int64(recHdrLen) + int64(n)
+
+		// Changes already folded into the snapshot are skipped, which covers
+		// a crash between writing a snapshot and truncating the journal
+		if journal && c.Seq <= s.seq {
+			continue
+		}
+		if c.Op == opMeta {
+			snapSeq = c.Seq
+			continue
+		}
+		s.apply(&c)
+		if journal {
+			s.jRecs++
+		}
+	}
+	if snapSeq > s.seq {
+		s.seq = snapSeq
+	}
+	return off, nil
+}
+
+// corrupt keeps a copy of a damaged file and records the damage
+func (s *Store) corrupt(path string, off int64, why string) {
+	keep := fmt.Sprintf("%s.corrupt-%s", path, time.Now().UTC().Format("20060102T150405Z"))
+	if data, err := os.ReadFile(path); err == nil {
+		os.WriteFile(keep, data, 0o600)
+	}
+	s.damage = append(s.damage, fmt.Sprintf("%s: %s at offset %d, replay stopped (copy kept as %s)", path, why, off, keep))
+}
+
+// apply folds a change into the in-memory view
+func (s *Store) apply(c *rec) {
+	if c.Seq > s.seq {
+		s.seq = c.Seq
+	}
+	switch c.Op {
+	case opPut:
+		m := s.objs[c.Kind]
+		if m == nil {
+			m = make(map[string]Record)
+			s.objs[c.Kind] = m
+		}
+		m[c.Key] = c.Record
+	case opDel:
+		delete(s.objs[c.Kind], c.Key)
+	}
+}
+
+// encode frames a change for writing
+func encode(c *rec) ([]byte, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, recHdrLen, recHdrLen+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(payload, crcTable))
+	return append(buf, payload...), nil
+}
+
+// write appends a change to the journal and applies it
+func (s *Store) write(c *rec) error {
+	if s.closed {
+		return errors.New("store closed")
+	}
+	s.seq++
+	c.Seq = s.seq
+	buf, err := encode(c)
+	if err != nil {
+		s.seq--
+		return err
+	}
+	off, _ := s.journal.Seek(0, io.SeekCurrent)
+	if _, err := s.journal.Write(buf); err != nil {
+		// Don't leave a torn record behind for the next one to follow
+		s.journal.Truncate(off)
+		s.journal.Seek(off, io.SeekStart)
+		s.seq--
+		return err
+	}
+	if err := s.journal.Sync(); err != nil {
+		s.seq--
+		return err
+	}
+	s.apply(c)
+	s.jRecs++
+	if s.jRecs >= s.compactN {
+		return s.compact()
+	}
+	return nil
+}
+
+// Put stores an object, replacing any earlier one with the same kind and key.
+// Storing an unchanged object is a no-op.
+func (s *Store) Put(kind, key string, val interface{}) error {
+	data, err := json.Marshal(val)
+	if err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if old, ok := s.objs[kind][key]; ok && bytes.Equal(old.Val, data) {
+		return nil
+	}
+	return s.write(&rec{Op: opPut, Record: Record{Kind: kind, Key: key, Val: data}})
+}
+
+// Delete removes an object. Removing an object which isn't stored is a no-op.
+func (s *Store) Delete(kind, key string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, ok := s.objs[kind][key]; !ok {
+		return nil
+	}
+	return s.write(&rec{Op: opDel, Record: Record{Kind: kind, Key: key}})
+}
+
+// Get returns a stored object.
+func (s *Store) Get(kind, key string) (Record, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	r, ok := s.objs[kind][key]
+	return r, ok
+}
+
+// List returns the objects of a kind in the order they were last changed.
+func (s *Store) List(kind string) []Record {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	res := make([]Record, 0, len(s.objs[kind]))
+	for _, r := range s.objs[kind] {
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Seq < res[j].Seq })
+	return res
+}
+
+// Damage returns a description of any damage found when the store was opened.
+func (s *Store) Damage() []string {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return append([]string(nil), s.damage...)
+}
+
+// Compact writes all live objects to a new snapshot and truncates the journal.
+func (s *Store) Compact() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return errors.New("store closed")
+	}
+	return s.compact()
+}
+
+func (s *Store) compact() error {
+	var buf bytes.Buffer
+
+	meta, err := encode(&rec{Op: opMeta, Record: Record{Seq: s.seq}})
+	if err != nil {
+		return err
+	}
+	buf.Write(meta)
+
+	kinds := make([]string, 0, len(s.objs))
+	for k := range s.objs {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	for _, k := range kinds {
+		recs := make([]Record, 0, len(s.objs[k]))
+		for _, r := range s.objs[k] {
+			recs = append(recs, r)
+		}
+		sort.Slice(recs, func(i, j int) bool { return recs[i].Seq < recs[j].Seq })
+		for _, r := range recs {
+			b, err := encode(&rec{Op: opPut, Record: r})
+			if err != nil {
+				return err
+			}
+			buf.Write(b)
+		}
+	}
+
+	snapPath := filepath.Join(s.dir, SnapshotFile)
+	tmp := snapPath + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	f.Close()
+	if err := os.Rename(tmp, snapPath); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	syncDir(s.dir)
+
+	if err := s.journal.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := s.journal.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	s.jRecs = 0
+	return s.journal.Sync()
+}
+
+// syncDir makes a rename within dir durable
+func syncDir(dir string) {
+	if d, err := os.Open(dir); err == nil {
+		d.Sync()
+		d.Close()
+	}
+}
+
+// Close closes the store.
+func (s *Store) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.journal.Close()
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type obj struct {
+	Name string `json:"name"`
+	N    int    `json:"n"`
+}
+
+func mustOpen(t *testing.T, dir string) *Store {
+	t.Helper()
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	return s
+}
+
+func keys(recs []Record) string {
+	var ks []string
+	for _, r := range recs {
+		ks = append(ks, r.Key)
+	}
+	return strings.Join(ks, ",")
+}
+
+func TestPutDeleteReopen(t *testing.T) {
+	dir := t.TempDir()
+	s := mustOpen(t, dir)
+	for i := 0; i < 3; i++ {
+		if err := s.Put("lb", fmt.Sprintf("k%d", i), obj{Name: "a", N: i}); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+	if err := s.Delete("lb", "k1"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	// Unchanged put must not reorder or grow the journal
+	before, _ := os.Stat(filepath.Join(dir, JournalFile))
+	s.Put("lb", "k0", obj{Name: "a", N: 0})
+	after, _ := os.Stat(filepath.Join(dir, JournalFile))
+	if before.Size() != after.Size() {
+		t.Fatalf("unchanged put was journaled")
+	}
+	s.Put("lb", "k0", obj{Name: "b", N: 0})
+	s.Close()
+
+	s = mustOpen(t, dir)
+	defer s.Close()
+	if got := keys(s.List("lb")); got != "k2,k0" {
+		t.Fatalf("after reopen got %q, want k2,k0", got)
+	}
+	if r, _ := s.Get("lb", "k0"); string(r.Val) != `{"name":"b","n":0}` {
+		t.Fatalf("unexpected value %s", r.Val)
+	}
+	if d := s.Damage(); len(d) != 0 {
+		t.Fatalf("unexpected damage %v", d)
+	}
+}
+
+func TestTornTail(t *testing.T) {
+	dir := t.TempDir()
+	s := mustOpen(t, dir)
+	s.Put("fw", "a", obj{N: 1})
+	s.Put("fw", "b", obj{N: 2})
+	s.Close()
+
+	jPath := filepath.Join(dir, JournalFile)
+	st, _ := os.Stat(jPath)
+	if err := os.Truncate(jPath, st.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+
+	s = mustOpen(t, dir)
+	if got := keys(s.List("fw")); got != "a" {
+		t.Fatalf("got %q, want a", got)
+	}
+	if d := s.Damage(); len(d) != 1 || !strings.Contains(d[0], "partial record") {
+		t.Fatalf("unexpected damage %v", d)
+	}
+	// New records must follow the last good one
+	s.Put("fw", "c", obj{N: 3})
+	s.Close()
+
+	s = mustOpen(t, dir)
+	defer s.Close()
+	if got := keys(s.List("fw")); got != "a,c" {
+		t.Fatalf("got %q, want a,c", got)
+	}
+	if d := s.Damage(); len(d) != 0 {
+		t.Fatalf("unexpected damage %v", d)
+	}
+}
+
+func TestChecksumMismatch(t *testing.T) {
+	dir := t.TempDir()
+	s := mustOpen(t, dir)
+	s.Put("ep", "a", obj{N: 1})
+	s.Put("ep", "b", obj{N: 2})
+	s.Put("ep", "c", obj{N: 3})
+	s.Close()
+
+	// Flip a byte in the payload of the second record
+	jPath := filepath.Join(dir, JournalFile)
+	data, _ := os.ReadFile(jPath)
+	first := recHdrLen + int(binary.BigEndian.Uint32(data[0:4]))
+	data[first+recHdrLen+2] ^= 0xff
+	os.WriteFile(jPath, data, 0o600)
+
+	s = mustOpen(t, dir)
+	defer s.Close()
+	if got := keys(s.List("ep")); got != "a" {
+		t.Fatalf("got %q, want a", got)
+	}
+	if d := s.Damage(); len(d) != 1 || !strings.Contains(d[0], "checksum mismatch") {
+		t.Fatalf("unexpected damage %v", d)
+	}
+	kept, _ := filepath.Glob(jPath + ".corrupt-*")
+	if len(kept) != 1 {
+		t.Fatalf("damaged journal not kept aside: %v", kept)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	dir := t.TempDir()
+	s := mustOpen(t, dir)
+	s.compactN = 10
+	for i := 0; i < 25; i++ {
+		s.Put("lb", fmt.Sprintf("k%d", i%5), obj{N: i})
+	}
+	s.Delete("lb", "k4")
+	s.Close()
+
+	st, _ := os.Stat(filepath.Join(dir, JournalFile))
+	if st.Size() == 0 {
+		t.Fatalf("expected journal records after last compaction")
+	}
+
+	s = mustOpen(t, dir)
+	if got := keys(s.List("lb")); got != "k0,k1,k2,k3" {
+		t.Fatalf("got %q, want k0,k1,k2,k3", got)
+	}
+	if err := s.Compact(); err != nil {
+		t.Fatalf("compact: %v", err)
+	}
+	s.Put("lb", "k9", obj{N: 9})
+	s.Close()
+
+	s = mustOpen(t, dir)
+	defer s.Close()
+	if got := keys(s.List("lb")); got != "k0,k1,k2,k3,k9" {
+		t.Fatalf("got %q, want k0,k1,k2,k3,k9", got)
+	}
+	if r, _ := s.Get("lb", "k3"); string(r.Val) != `{"name":"","n":23}` {
+		t.Fatalf("unexpected value %s", r.Val)
+	}
+}
@@ -45,7 +45,7 @@ func NewOauthUserService() *OauthUserService {
 	var updatedTokens []TokenData
 	for _, tokenData := range tokens {
 		if tokenData.Expiry.After(time.Now()) { // Only cache non-expired tokens
-			role := "admin" // Assume the role is 'admin' as per your original logic
+			role := OauthUserRole(tokenData.Username)
 			combined := tokenData.Username + "|" + role + "|" + tokenData.RefreshToken
 			c.Set(tokenData.AccessToken, combined, tokenData.Expiry.Sub(time.Now())) // Set with expiry time
 			updatedTokens = append(updatedTokens, tokenData)
@@ -92,7 +92,7 @@ func (s *OauthUserService) ValidateOuathTokenWithRefreshToken(token, refreshToke
 func (s *OauthUserService) StoreOauthTokenCredentials(username, token, refreshToken string, expiry time.Time) (string, bool, error) {
 	// Save token
 	// Store token in cache
-	role := "admin"
+	role := OauthUserRole(username)
 
 	combined := username + "|" + role + "|" + refreshToken
 	s.Cache.Set(token, combined, expiry.Sub(time.Now()))
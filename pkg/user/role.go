@@ -0,0 +1,267 @@
+/*
+ * Copyright (c) 2025 LoxiLB Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// Built-in roles
+const (
+	RoleAdmin        = "admin"
+	RoleViewer       = "viewer"
+	RoleAuditor      = "auditor"
+	RoleLbOperator   = "lb-operator"
+	RoleNetworkAdmin = "network-admin"
+)
+
+// RoleRule is a permission of a role. Rules of a role are checked in order and
+// the first rule matching a request decides; a request no rule matches is denied.
+type RoleRule struct {
+	// Methods are the HTTP methods the rule applies to, "*" matches any method
+	Methods []string `json:"methods"`
+	// Path is the API path the rule applies to. A trailing "*" matches any
+	// path with the given prefix
+	Path string `json:"path"`
+	// Deny makes the rule deny instead of allow matching requests
+	Deny bool `json:"deny,omitempty"`
+}
+
+// RBACConfig is the format of the RBAC config file.
+type RBACConfig struct {
+	// Roles adds roles or replaces the rules of built-in roles
+	Roles map[string][]RoleRule `json:"roles,omitempty"`
+	// OauthUsers maps OAuth2 users to their role
+	OauthUsers map[string]string `json:"oauthUsers,omitempty"`
+	// OauthDefaultRole is the role of OAuth2 users not in OauthUsers
+	OauthDefaultRole string `json:"oauthDefaultRole,omitempty"`
+	// DefaultRole is the role of users whose stored role is not known, like
+	// users created before roles were enforced or whose role was removed from
+	// the config. Unknown roles naming the viewer are still mapped to it
+	DefaultRole string `json:"defaultRole,omitempty"`
+}
+
+const apiBase = "/netlox/v1"
+
+var (
+	readOnly = []string{"GET"}
+	anyMeth  = []string{"*"}
+	logout   = RoleRule{Methods: []string{"POST"}, Path: apiBase + "/auth/logout"}
+	// monitoring is what every role can read to keep an eye on loxilb
+	monitoring = []RoleRule{
+		{Methods: readOnly, Path: apiBase + "/status/*"},
+		{Methods: readOnly, Path: apiBase + "/metrics*"},
+		{Methods: readOnly, Path: apiBase + "/version*"},
+		{Methods: readOnly, Path: apiBase + "/config/watch*"},
+		logout,
+	}
+)
+
+// defaultRoles returns the built-in roles
+func defaultRoles() map[string][]RoleRule {
+	return map[string][]RoleRule{
+		RoleAdmin: {
+			{Methods: anyMeth, Path: "*"},
+		},
+		RoleViewer: {
+			{Methods: readOnly, Path: apiBase + "/audit*", Deny: true},
+			{Methods: readOnly, Path: "*"},
+			logout,
+		},
+		RoleAuditor: {
+			{Methods: readOnly, Path: "*"},
+			logout,
+		},
+		RoleLbOperator: append([]RoleRule{
+			{Methods: anyMeth, Path: apiBase + "/config/loadbalancer*"},
+			{Methods: anyMeth, Path: apiBase + "/config/endpoint*"},
+			{Methods: readOnly, Path: apiBase + "/config/ippool*"},
+		}, monitoring...),
+		RoleNetworkAdmin: append([]RoleRule{
+			{Methods: anyMeth, Path: apiBase + "/config/route*"},
+			{Methods: anyMeth, Path: apiBase + "/config/neighbor*"},
+			{Methods: anyMeth, Path: apiBase + "/config/bgp/*"},
+			{Methods: readOnly, Path: apiBase + "/config/*"},
+		}, monitoring...),
+	}
+}
+
+var rbac = struct {
+	mtx          sync.RWMutex
+	roles        map[string][]RoleRule
+	oauthUsers   map[string]string
+	oauthDefRole string
+	defRole      string
+}{
+	roles:        defaultRoles(),
+	oauthUsers:   map[string]string{},
+	oauthDefRole: RoleAdmin,
+	defRole:      RoleAdmin,
+}
+
+// LoadRBACConfig loads roles and OAuth2 user roles from a JSON file on top of the built-in roles.
+func LoadRBACConfig(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var cfg RBACConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("malformed rbac config: %v", err)
+	}
+	return SetRBACConfig(cfg)
+}
+
+// SetRBACConfig applies roles and OAuth2 user roles on top of the built-in roles.
+func SetRBACConfig(cfg RBACConfig) error {
+	roles := defaultRoles()
+	for name, rules := range cfg.Roles {
+		if name == "" {
+			return errors.New("rbac role without name")
+		}
+		for _, r := range rules {
+			if r.Path == "" || len(r.Methods) == 0 {
+				return fmt.Errorf("rbac role %s has a rule without path or methods", name)
+			}
+		}
+		roles[name] = rules
+	}
+
+	oauthDefRole := RoleAdmin
+	if cfg.OauthDefaultRole != "" {
+		oauthDefRole = cfg.OauthDefaultRole
+	}
+	if _, ok := roles[oauthDefRole]; !ok {
+		return fmt.Errorf("rbac unknown oauth default role %s", oauthDefRole)
+	}
+	defRole := RoleAdmin
+	if cfg.DefaultRole != "" {
+		defRole = cfg.DefaultRole
+	}
+	if _, ok := roles[defRole]; !ok {
+		return fmt.Errorf("rbac unknown default role %s", defRole)
+	}
+	oauthUsers := map[string]string{}
+	for u, role := range cfg.OauthUsers {
+		if _, ok := roles[role]; !ok {
+			return fmt.Errorf("rbac unknown role %s of oauth user %s", role, u)
+		}
+		oauthUsers[u] = role
+	}
+
+	rbac.mtx.Lock()
+	rbac.roles = roles
+	rbac.oauthUsers = oauthUsers
+	rbac.oauthDefRole = oauthDefRole
+	rbac.defRole = defRole
+	rbac.mtx.Unlock()
+
+	tk.LogIt(tk.LogInfo, "rbac: %d roles, %d oauth users configured\n", len(roles), len(oauthUsers))
+	return nil
+}
+
+// ValidRole tells whether role is a known role.
+func ValidRole(role string) bool {
+	rbac.mtx.RLock()
+	defer rbac.mtx.RUnlock()
+
+	_, ok := rbac.roles[role]
+	return ok
+}
+
+// effectiveRole returns the role whose rules apply to a stored role. Before
+// roles were enforced any role naming the viewer was read-only and all others
+// had full access, so unknown roles keep that unless a default role is set.
+// rbac.mtx must be held
+func effectiveRole(role string) string {
+	if _, ok := rbac.roles[role]; ok {
+		return role
+	}
+	if strings.Contains(role, RoleViewer) {
+		return RoleViewer
+	}
+	return rbac.defRole
+}
+
+// EffectiveRole returns the role whose rules apply to a stored role.
+func EffectiveRole(role string) string {
+	rbac.mtx.RLock()
+	defer rbac.mtx.RUnlock()
+
+	return effectiveRole(role)
+}
+
+// WarnUnknownRoles logs a warning for every user whose stored role is not known
+// along with the role applied instead. It returns the number of such users.
+func WarnUnknownRoles(users []cmn.User) int {
+	n := 0
+	for _, u := range users {
+		if ValidRole(u.Role) {
+			continue
+		}
+		tk.LogIt(tk.LogWarning, "rbac: user %s has unknown role \"%s\", using role %s\n",
+			u.Username, u.Role, EffectiveRole(u.Role))
+		n++
+	}
+	return n
+}
+
+// OauthUserRole returns the role of an OAuth2 user.
+func OauthUserRole(username string) string {
+	rbac.mtx.RLock()
+	defer rbac.mtx.RUnlock()
+
+	if role, ok := rbac.oauthUsers[username]; ok {
+		return role
+	}
+	return rbac.oauthDefRole
+}
+
+// matchPath matches an API path against a rule path
+func matchPath(pattern, path string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(path, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == strings.TrimSuffix(path, "/")
+}
+
+// RoleAllowed tells whether role may call method on the API path. Unknown
+// roles get the permissions of the role applied instead.
+func RoleAllowed(role, method, path string) bool {
+	rbac.mtx.RLock()
+	defer rbac.mtx.RUnlock()
+
+	rules := rbac.roles[effectiveRole(role)]
+	for _, r := range rules {
+		if !matchPath(r.Path, path) {
+			continue
+		}
+		for _, m := range r.Methods {
+			if m == "*" || strings.EqualFold(m, method) {
+				return !r.Deny
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2025 LoxiLB Authors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package user
+
+import (
+	"testing"
+
+	cmn "github.com/loxilb-io/loxilb/common"
+)
+
+func TestBuiltinRoles(t *testing.T) {
+	tests := []struct {
+		role, method, path string
+		allowed            bool
+	}{
+		{RoleAdmin, "DELETE", "/netlox/v1/config/route/destinationIPNet/10.0.0.0/8", true},
+		{RoleViewer, "GET", "/netlox/v1/config/loadbalancer/all", true},
+		{RoleViewer, "POST", "/netlox/v1/config/loadbalancer", false},
+		{RoleViewer, "POST", "/netlox/v1/auth/logout", true},
+		{RoleViewer, "GET", "/netlox/v1/audit", false},
+		{RoleAuditor, "GET", "/netlox/v1/logs", true},
+		{RoleAuditor, "GET", "/netlox/v1/audit", true},
+		{RoleAuditor, "DELETE", "/netlox/v1/config/firewall", false},
+		{RoleLbOperator, "POST", "/netlox/v1/config/loadbalancer", true},
+		{RoleLbOperator, "DELETE", "/netlox/v1/config/endpoint/epipaddress/1.1.1.1", true},
+		{RoleLbOperator, "GET", "/netlox/v1/config/ippool/all", true},
+		{RoleLbOperator, "POST", "/netlox/v1/config/ippool", false},
+		{RoleLbOperator, "POST", "/netlox/v1/config/route", false},
+		{RoleLbOperator, "GET", "/netlox/v1/logs", false},
+		{RoleNetworkAdmin, "POST", "/netlox/v1/config/route", true},
+		{RoleNetworkAdmin, "POST", "/netlox/v1/config/bgp/neigh", true},
+		{RoleNetworkAdmin, "GET", "/netlox/v1/config/loadbalancer/all", true},
+		{RoleNetworkAdmin, "POST", "/netlox/v1/config/loadbalancer", false},
+	}
+	for _, tc := range tests {
+		if got := RoleAllowed(tc.role, tc.method, tc.path); got != tc.allowed {
+			t.Errorf("%s %s %s: got %v, want %v", tc.role, tc.method, tc.path, got, tc.allowed)
+		}
+	}
+}
+
+func TestRBACConfig(t *testing.T) {
+	defer SetRBACConfig(RBACConfig{})
+
+	err := SetRBACConfig(RBACConfig{
+		Roles: map[string][]RoleRule{
+			"fw-operator": {
+				{Methods: []string{"GET"}, Path: "/netlox/v1/config/firewall/secret*", Deny: true},
+				{Methods: []string{"*"}, Path: "/netlox/v1/config/firewall*"},
+			},
+		},
+		OauthUsers:       map[string]string{"ops@example.com": "fw-operator"},
+		OauthDefaultRole: RoleViewer,
+	})
+	if err != nil {
+		t.Fatalf("set rbac config: %v", err)
+	}
+	if !ValidRole("fw-operator") || !ValidRole(RoleLbOperator) {
+		t.Fatalf("custom and built-in roles must both be valid")
+	}
+	if !RoleAllowed("fw-operator", "POST", "/netlox/v1/config/firewall") ||
+		RoleAllowed("fw-operator", "GET", "/netlox/v1/config/firewall/secret") {
+		t.Fatalf("custom role rules not applied in order")
+	}
+	if OauthUserRole("ops@example.com") != "fw-operator" || OauthUserRole("dev@example.com") != RoleViewer {
+		t.Fatalf("unexpected oauth user roles")
+	}
+
+	if err := SetRBACConfig(RBACConfig{OauthUsers: map[string]string{"a@example.com": "nope"}}); err == nil {
+		t.Fatalf("unknown oauth user role must be rejected")
+	}
+	if OauthUserRole("dev@example.com") != RoleViewer {
+		t.Fatalf("rejected config must not be applied")
+	}
+}
+
+func TestUnknownRoles(t *testing.T) {
+	defer SetRBACConfig(RBACConfig{})
+
+	// Users stored before roles were enforced, or with a role since removed
+	users := []cmn.User{
+		{Username: "legacy", Role: ""},
+		{Username: "legacy-viewer", Role: "viewer-ro"},
+		{Username: "ops", Role: "operator"},
+		{Username: "lb", Role: RoleLbOperator},
+	}
+	tests := []struct {
+		defRole string
+		roles   []string
+	}{
+		{"", []string{RoleAdmin, RoleViewer, RoleAdmin, RoleLbOperator}},
+		{RoleViewer, []string{RoleViewer, RoleViewer, RoleViewer, RoleLbOperator}},
+		{RoleLbOperator, []string{RoleLbOperator, RoleViewer, RoleLbOperator, RoleLbOperator}},
+	}
+	for _, tc := range tests {
+		if err := SetRBACConfig(RBACConfig{DefaultRole: tc.defRole}); err != nil {
+			t.Fatalf("set rbac config: %v", err)
+		}
+		if n := WarnUnknownRoles(users); n != 3 {
+			t.Errorf("default %q: %d users warned, want 3", tc.defRole, n)
+		}
+		for i, u := range users {
+			if got := EffectiveRole(u.Role); got != tc.roles[i] {
+				t.Errorf("default %q: user %s has role %s, want %s", tc.defRole, u.Username, got, tc.roles[i])
+			}
+			want := RoleAllowed(tc.roles[i], "POST", "/netlox/v1/config/loadbalancer")
+			if got := RoleAllowed(u.Role, "POST", "/netlox/v1/config/loadbalancer"); got != want {
+				t.Errorf("default %q: user %s allowed %v, want %v", tc.defRole, u.Username, got, want)
+			}
+			if !RoleAllowed(u.Role, "GET", "/netlox/v1/version") {
+				t.Errorf("default %q: user %s locked out", tc.defRole, u.Username)
+			}
+		}
+	}
+
+	if err := SetRBACConfig(RBACConfig{DefaultRole: "nope"}); err == nil {
+		t.Errorf("unknown default role must be rejected")
+	}
+}
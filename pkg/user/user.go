@@ -57,6 +57,12 @@ func NewUserService() *UserService {
 // It returns the user's role if the credentials are valid, or an error if the credentials are invalid.
 func (s *UserService) AddUser(user cmn.User) (int, error) {
 	var userID int
+	if user.Role == "" {
+		user.Role = RoleAdmin
+	} else if !ValidRole(user.Role) {
+		tk.LogIt(tk.LogError, "Unknown role %v for user %v\n", user.Role, user.Username)
+		return 0, errors.New("unknown role")
+	}
 	err := RetryOperation(func() error {
 		if err := s.validatePassword(user.Username, user.Password); err != nil {
 			tk.LogIt(tk.LogError, "Password validation failed: %v\n", err.Error())
@@ -120,6 +126,7 @@ func (s *UserService) GetUsers() ([]cmn.User, error) {
 				tk.LogIt(tk.LogError, "Failed to scan user: %v\n", err.Error())
 				return err
 			}
+			user.Role = role
 			user.CreatedAt, err = time.Parse("2006-01-02 15:04:05", createdAt)
 
 			if err != nil {
@@ -153,6 +160,10 @@ func (s *UserService) DeleteUser(id int) error {
 
 // UpdateUser updates a user in the database.
 func (s *UserService) UpdateUser(user cmn.User) error {
+	if user.Role != "" && !ValidRole(user.Role) {
+		tk.LogIt(tk.LogError, "Unknown role %v for user %v\n", user.Role, user.Username)
+		return errors.New("unknown role")
+	}
 	return RetryOperation(func() error {
 		// Check if the user exists
 		var existingUser cmn.User
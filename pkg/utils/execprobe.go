@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	tk "github.com/loxilb-io/loxilib"
+)
+
+const (
+	// DflExecProbeTimeout - Default timeout of an exec probe
+	DflExecProbeTimeout = 5 * time.Second
+	// maxExecProbeOut - Max bytes of the stdout of an exec probe which are kept
+	maxExecProbeOut = 4096
+)
+
+// ExecProbeSpec - Specification of an exec probe
+type ExecProbeSpec struct {
+	// Cmd - check command followed by its arguments
+	Cmd []string
+	// Timeout - time after which the check command is killed,
+	// DflExecProbeTimeout if zero
+	Timeout time.Duration
+}
+
+// capWriter - a writer which keeps only the first bytes written to it
+type capWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *capWriter) Write(p []byte) (int, error) {
+	if room := maxExecProbeOut - w.buf.Len(); room > 0 {
+		if len(p) > room {
+			w.buf.Write(p[:room])
+		} else {
+			w.buf.Write(p)
+		}
+	}
+	return len(p), nil
+}
+
+// ExecProber - Run a check command for an end-point. The end-point ip and port
+// are passed as the last two arguments of the command and as LOXILB_EP_IP and
+// LOXILB_EP_PORT in its environment. It returns whether the command exited
+// with 0 in time, and the first line of what it wrote to stdout
+func ExecProber(spec ExecProbeSpec, ip string, port uint16) (bool, string) {
+	if len(spec.Cmd) == 0 {
+		return false, ""
+	}
+	timeout := spec.Timeout
+	if timeout == 0 {
+		timeout = DflExecProbeTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	portStr := strconv.Itoa(int(port))
+	args := make([]string, 0, len(spec.Cmd)+1)
+	args = append(args, spec.Cmd[1:]...)
+	args = append(args, ip, portStr)
+
+	var out capWriter
+	cmd := exec.CommandContext(ctx, spec.Cmd[0], args...)
+	cmd.Env = append(os.Environ(), "LOXILB_EP_IP="+ip, "LOXILB_EP_PORT="+portStr)
+	cmd.Stdout = &out
+	// Don't wait for children of a killed command which keep stdout open
+	cmd.WaitDelay = time.Second
+
+	err := cmd.Run()
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "exec probe %s for %s:%d failed: %s\n", spec.Cmd[0], ip, port, err)
+	}
+	line, _, _ := strings.Cut(out.buf.String(), "\n")
+	return err == nil, strings.TrimSpace(line)
+}
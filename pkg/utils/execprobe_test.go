@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestExecProber(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "check.sh")
+	body := `#!/bin/sh
+[ "$2" = "$LOXILB_EP_IP" ] && [ "$3" = "$LOXILB_EP_PORT" ] || exit 2
+case "$1" in
+ok) echo "Yellow"; echo "ignored" ;;
+fail) exit 1 ;;
+slow) sleep 5 ;;
+esac
+`
+	if err := os.WriteFile(script, []byte(body), 0755); err != nil {
+		t.Fatalf("write script: %v", err)
+	}
+
+	tests := []struct {
+		arg string
+		ok  bool
+		out string
+	}{
+		{"ok", true, "Yellow"},
+		{"fail", false, ""},
+		{"slow", false, ""},
+	}
+	for _, tc := range tests {
+		spec := ExecProbeSpec{Cmd: []string{script, tc.arg}, Timeout: 300 * time.Millisecond}
+		start := time.Now()
+		ok, out := ExecProber(spec, "10.0.0.1", 5432)
+		if ok != tc.ok || out != tc.out {
+			t.Errorf("%s: got %v %q, want %v %q", tc.arg, ok, out, tc.ok, tc.out)
+		}
+		if time.Since(start) > 2*time.Second {
+			t.Errorf("%s: probe not timed out", tc.arg)
+		}
+	}
+
+	if ok, _ := ExecProber(ExecProbeSpec{Cmd: []string{filepath.Join(dir, "missing")}}, "10.0.0.1", 80); ok {
+		t.Errorf("missing command passed")
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	tk "github.com/loxilb-io/loxilib"
+)
+
+// GRPCProbeSpec - Specification of a grpc health check probe
+type GRPCProbeSpec struct {
+	// Service - service to check, the overall server health if empty
+	Service string
+	// TLS - use TLS to connect
+	TLS bool
+	// ServerName - TLS server name (SNI), the address if empty
+	ServerName string
+	// Timeout - timeout of the probe, DflHTTPProbeTimeout if zero
+	Timeout time.Duration
+}
+
+// GRPCProber - Do a grpc.health.v1.Health/Check probe of the given address.
+// cert and certPool are used for TLS. It returns the serving status reported
+// by the server, or UNKNOWN along with the error if the check failed
+func GRPCProber(addr string, spec GRPCProbeSpec, cert tls.Certificate, certPool *x509.CertPool) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	creds := insecure.NewCredentials()
+	if spec.TLS {
+		tlsCfg := &tls.Config{RootCAs: certPool, ServerName: spec.ServerName}
+		if cert.Certificate != nil {
+			tlsCfg.Certificates = []tls.Certificate{cert}
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+	timeout := spec.Timeout
+	if timeout == 0 {
+		timeout = DflHTTPProbeTimeout
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: spec.Service})
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "grpc probe %s(%s) failed: %s\n", addr, spec.Service, err)
+		return healthpb.HealthCheckResponse_UNKNOWN, err
+	}
+	return res.GetStatus(), nil
+}
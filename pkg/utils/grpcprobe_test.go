@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCProber(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	hs := health.NewServer()
+	hs.SetServingStatus("app.Orders", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("app.Billing", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(srv, hs)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	addr := lis.Addr().String()
+	tests := []struct {
+		service string
+		want    healthpb.HealthCheckResponse_ServingStatus
+		err     bool
+	}{
+		{"", healthpb.HealthCheckResponse_SERVING, false},
+		{"app.Orders", healthpb.HealthCheckResponse_SERVING, false},
+		{"app.Billing", healthpb.HealthCheckResponse_NOT_SERVING, false},
+		{"app.Unknown", healthpb.HealthCheckResponse_UNKNOWN, true},
+	}
+	for _, tc := range tests {
+		got, err := GRPCProber(addr, GRPCProbeSpec{Service: tc.service, Timeout: time.Second}, tls.Certificate{}, nil)
+		if got != tc.want || (err != nil) != tc.err {
+			t.Errorf("service %q: got %v (%v), want %v", tc.service, got, err, tc.want)
+		}
+	}
+
+	// A plain text server doesn't pass a TLS probe
+	if got, err := GRPCProber(addr, GRPCProbeSpec{TLS: true, Timeout: time.Second}, tls.Certificate{}, nil); err == nil {
+		t.Errorf("tls probe of plain text server got %v", got)
+	}
+}
@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tk "github.com/loxilb-io/loxilib"
+)
+
+const (
+	// DflHTTPProbeTimeout - Default timeout of a http(s) probe
+	DflHTTPProbeTimeout = 2 * time.Second
+	// maxHTTPProbeBody - Max bytes of a probe response which get checked
+	maxHTTPProbeBody = 1 << 20
+	// maxHTTPProbeRedirects - Max redirects followed by a probe
+	maxHTTPProbeRedirects = 10
+)
+
+// HTTPProbeSpec - Specification of a http(s) probe
+type HTTPProbeSpec struct {
+	// Method - http method, GET if empty
+	Method string
+	// Host - Host header and TLS server name (SNI)
+	Host string
+	// Headers - extra request headers as "Name: value"
+	Headers []string
+	// Status - accepted status codes e.g "200", "200-399" or "200,204,300-399".
+	// If set, redirects are not followed and checked like any other status.
+	// If empty, redirects are followed and 200 is accepted
+	Status string
+	// Resp - expected response body, checked only if set
+	Resp string
+	// BodyRegex - regex the response body has to match, checked only if set
+	BodyRegex string
+	// JSONPath - path of a value in a json response body e.g "status" or
+	// "$.checks[0].state", checked only if set
+	JSONPath string
+	// JSONValue - expected value at JSONPath. The path only has to exist if empty
+	JSONValue string
+	// Timeout - timeout of the probe, DflHTTPProbeTimeout if zero
+	Timeout time.Duration
+}
+
+// HTTPProbe - A validated http(s) probe
+type HTTPProbe struct {
+	spec     HTTPProbeSpec
+	header   http.Header
+	redirect bool
+	statuses [][2]int
+	bodyRe   *regexp.Regexp
+	jsonPath []interface{}
+}
+
+var httpProbeMethods = map[string]bool{
+	http.MethodGet: true, http.MethodHead: true, http.MethodPost: true,
+	http.MethodPut: true, http.MethodOptions: true,
+}
+
+// parseStatusRanges - parse a list of accepted status codes or ranges
+func parseStatusRanges(s string) ([][2]int, error) {
+	var res [][2]int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		lo, hi, isRange := strings.Cut(part, "-")
+		min, err := strconv.Atoi(strings.TrimSpace(lo))
+		if err != nil {
+			return nil, fmt.Errorf("malformed status %s", part)
+		}
+		max := min
+		if isRange {
+			if max, err = strconv.Atoi(strings.TrimSpace(hi)); err != nil {
+				return nil, fmt.Errorf("malformed status %s", part)
+			}
+		}
+		if min < 100 || max > 599 || min > max {
+			return nil, fmt.Errorf("status %s out of range", part)
+		}
+		res = append(res, [2]int{min, max})
+	}
+	return res, nil
+}
+
+// parseJSONPath - parse a dotted json path with array indexes into its
+// object keys (string) and array indexes (int)
+func parseJSONPath(p string) ([]interface{}, error) {
+	p = strings.TrimPrefix(strings.TrimPrefix(p, "$"), ".")
+	var res []interface{}
+	for _, elem := range strings.Split(p, ".") {
+		key := elem
+		idx := ""
+		if i := strings.IndexByte(elem, '['); i >= 0 {
+			key, idx = elem[:i], elem[i:]
+		}
+		if key != "" {
+			res = append(res, key)
+		}
+		for idx != "" {
+			end := strings.IndexByte(idx, ']')
+			if idx[0] != '[' || end < 0 {
+				return nil, fmt.Errorf("malformed json path %s", p)
+			}
+			n, err := strconv.Atoi(idx[1:end])
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("malformed json path %s", p)
+			}
+			res = append(res, n)
+			idx = idx[end+1:]
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("malformed json path %s", p)
+	}
+	return res, nil
+}
+
+// NewHTTPProbe - Validate a http(s) probe spec
+func NewHTTPProbe(spec HTTPProbeSpec) (*HTTPProbe, error) {
+	var err error
+	p := &HTTPProbe{spec: spec, header: http.Header{}}
+
+	if p.spec.Method == "" {
+		p.spec.Method = http.MethodGet
+	}
+	p.spec.Method = strings.ToUpper(p.spec.Method)
+	if !httpProbeMethods[p.spec.Method] {
+		return nil, fmt.Errorf("unsupported probe method %s", spec.Method)
+	}
+	for _, h := range spec.Headers {
+		name, val, ok := strings.Cut(h, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("malformed probe header %s", h)
+		}
+		p.header.Add(name, strings.TrimSpace(val))
+	}
+	if p.spec.Status == "" {
+		p.spec.Status = "200"
+		p.redirect = true
+	}
+	if p.statuses, err = parseStatusRanges(p.spec.Status); err != nil {
+		return nil, err
+	}
+	if spec.BodyRegex != "" {
+		if p.bodyRe, err = regexp.Compile(spec.BodyRegex); err != nil {
+			return nil, fmt.Errorf("malformed probe body regex : %s", err)
+		}
+	}
+	if spec.JSONPath != "" {
+		if p.jsonPath, err = parseJSONPath(spec.JSONPath); err != nil {
+			return nil, err
+		}
+	} else if spec.JSONValue != "" {
+		return nil, errors.New("probe json value without json path")
+	}
+	if p.spec.Timeout == 0 {
+		p.spec.Timeout = DflHTTPProbeTimeout
+	}
+	return p, nil
+}
+
+// statusOk - check if a status code is accepted
+func (p *HTTPProbe) statusOk(code int) bool {
+	for _, r := range p.statuses {
+		if code >= r[0] && code <= r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonOk - check the json path of a response body
+func (p *HTTPProbe) jsonOk(body []byte) bool {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return false
+	}
+	for _, elem := range p.jsonPath {
+		switch e := elem.(type) {
+		case string:
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if v, ok = m[e]; !ok {
+				return false
+			}
+		case int:
+			a, ok := v.([]interface{})
+			if !ok || e >= len(a) {
+				return false
+			}
+			v = a[e]
+		}
+	}
+	if p.spec.JSONValue == "" {
+		return v != nil
+	}
+	if s, ok := v.(string); ok {
+		return s == p.spec.JSONValue
+	}
+	val, err := json.Marshal(v)
+	return err == nil && string(val) == p.spec.JSONValue
+}
+
+// checkRedirect - follow redirects only if no status codes are configured.
+// Otherwise they are checked against the accepted status codes. The Host
+// header is kept for redirects to the same server
+func (p *HTTPProbe) checkRedirect(req *http.Request, via []*http.Request) error {
+	if !p.redirect {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= maxHTTPProbeRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxHTTPProbeRedirects)
+	}
+	if p.spec.Host != "" && req.URL.Host == via[0].URL.Host {
+		req.Host = p.spec.Host
+	}
+	return nil
+}
+
+// Probe - Do the probe against the given url. cert and certPool are used for https
+func (p *HTTPProbe) Probe(url string, cert tls.Certificate, certPool *x509.CertPool) bool {
+	tlsCfg := &tls.Config{RootCAs: certPool, ServerName: p.spec.Host}
+	if cert.Certificate != nil {
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	client := http.Client{Timeout: p.spec.Timeout,
+		Transport: &http.Transport{
+			DisableKeepAlives: true,
+			TLSClientConfig:   tlsCfg},
+		CheckRedirect: p.checkRedirect,
+	}
+
+	req, err := http.NewRequest(p.spec.Method, url, nil)
+	if err != nil {
+		tk.LogIt(tk.LogError, "unable to create http request: %s\n", err)
+		return false
+	}
+	req.Header = p.header.Clone()
+	if p.spec.Host != "" {
+		req.Host = p.spec.Host
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		tk.LogIt(tk.LogDebug, "http probe %s failed: %s\n", url, err)
+		return false
+	}
+	defer res.Body.Close()
+	if !p.statusOk(res.StatusCode) {
+		return false
+	}
+	if p.spec.Resp == "" && p.bodyRe == nil && p.jsonPath == nil {
+		return true
+	}
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxHTTPProbeBody))
+	if err != nil {
+		return false
+	}
+	if p.spec.Resp != "" && string(body) != p.spec.Resp {
+		return false
+	}
+	if p.bodyRe != nil && !p.bodyRe.Match(body) {
+		return false
+	}
+	if p.jsonPath != nil && !p.jsonOk(body) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHTTPProbeSpecErrors(t *testing.T) {
+	bad := []HTTPProbeSpec{
+		{Method: "BREW"},
+		{Headers: []string{"no-colon"}},
+		{Status: "2xx"},
+		{Status: "399-200"},
+		{Status: "200-700"},
+		{BodyRegex: "("},
+		{JSONPath: "checks[x]"},
+		{JSONValue: "up"},
+	}
+	for _, spec := range bad {
+		if _, err := NewHTTPProbe(spec); err == nil {
+			t.Errorf("spec %+v accepted", spec)
+		}
+	}
+}
+
+func TestHTTPProbe(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Host != "app.example.com" || r.Header.Get("X-Probe") != "loxilb" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		case "/ok":
+		case "/slow":
+			time.Sleep(300 * time.Millisecond)
+		case "/health":
+			if r.Method != http.MethodPost {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.Write([]byte(`{"status":"up","checks":[{"state":"ok","load":3}]}`))
+		}
+	}))
+	defer srv.Close()
+
+	base := HTTPProbeSpec{Method: "post", Host: "app.example.com", Headers: []string{"X-Probe: loxilb"}}
+	tests := []struct {
+		name string
+		path string
+		mod  func(*HTTPProbeSpec)
+		ok   bool
+	}{
+		{"plain", "/health", nil, true},
+		{"no host", "/health", func(s *HTTPProbeSpec) { s.Host = "" }, false},
+		{"wrong method", "/health", func(s *HTTPProbeSpec) { s.Method = "" }, false},
+		{"redirect followed", "/moved", nil, true},
+		{"redirect loop", "/loop", nil, false},
+		{"redirect not accepted", "/moved", func(s *HTTPProbeSpec) { s.Status = "200" }, false},
+		{"redirect accepted", "/moved", func(s *HTTPProbeSpec) { s.Status = "200-399" }, true},
+		{"body regex", "/health", func(s *HTTPProbeSpec) { s.BodyRegex = `"status":\s*"up"` }, true},
+		{"body regex mismatch", "/health", func(s *HTTPProbeSpec) { s.BodyRegex = `down` }, false},
+		{"json value", "/health", func(s *HTTPProbeSpec) { s.JSONPath = "$.checks[0].state"; s.JSONValue = "ok" }, true},
+		{"json number", "/health", func(s *HTTPProbeSpec) { s.JSONPath = "checks[0].load"; s.JSONValue = "3" }, true},
+		{"json mismatch", "/health", func(s *HTTPProbeSpec) { s.JSONPath = "status"; s.JSONValue = "down" }, false},
+		{"json missing", "/health", func(s *HTTPProbeSpec) { s.JSONPath = "checks[1]" }, false},
+		{"timeout", "/slow", func(s *HTTPProbeSpec) { s.Timeout = 100 * time.Millisecond }, false},
+	}
+	for _, tc := range tests {
+		spec := base
+		if tc.mod != nil {
+			tc.mod(&spec)
+		}
+		p, err := NewHTTPProbe(spec)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := p.Probe(srv.URL+tc.path, tls.Certificate{}, nil); got != tc.ok {
+			t.Errorf("%s: probe got %v, want %v", tc.name, got, tc.ok)
+		}
+	}
+}
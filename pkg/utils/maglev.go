@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"hash/fnv"
+	"sort"
+)
+
+// MaglevTable - Build a maglev lookup table of the given size for the given
+// backend names. size should be a prime number larger than the number of
+// backends. Each entry of the returned table is an index into names, or -1
+// if there are no backends. The table only depends on the set of names and
+// not on their order, so that nodes sharing a backend set build the same
+// table and adding or removing a backend moves as few entries as possible
+func MaglevTable(names []string, size int) []int {
+	table := make([]int, size)
+	for i := range table {
+		table[i] = -1
+	}
+	n := len(names)
+	if n == 0 || size == 0 {
+		return table
+	}
+
+	// Populate in name order so the table doesn't depend on input order
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return names[order[i]] < names[order[j]]
+	})
+
+	offset := make([]uint64, n)
+	skip := make([]uint64, n)
+	next := make([]uint64, n)
+	m := uint64(size)
+	for i, idx := range order {
+		h := fnv.New64a()
+		h.Write([]byte(names[idx]))
+		sum := h.Sum64()
+		offset[i] = (sum >> 32) % m
+		skip[i] = 1
+		if m > 1 {
+			skip[i] = (sum&0xffffffff)%(m-1) + 1
+		}
+	}
+
+	filled := 0
+	for {
+		for i, idx := range order {
+			c := (offset[i] + next[i]*skip[i]) % m
+			for table[c] >= 0 {
+				next[i]++
+				c = (offset[i] + next[i]*skip[i]) % m
+			}
+			table[c] = idx
+			next[i]++
+			filled++
+			if filled == size {
+				return table
+			}
+		}
+	}
+}
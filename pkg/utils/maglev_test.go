@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2022 NetLOX Inc
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at:
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMaglevTable(t *testing.T) {
+	const size = 13
+	names := []string{"10.0.0.1:80", "10.0.0.2:80", "10.0.0.3:80", "10.0.0.4:80"}
+
+	tbl := MaglevTable(names, size)
+	cnt := make([]int, len(names))
+	for _, idx := range tbl {
+		if idx < 0 || idx >= len(names) {
+			t.Fatalf("bad table entry %d", idx)
+		}
+		cnt[idx]++
+	}
+	for i, c := range cnt {
+		if c < size/len(names) || c > size/len(names)+1 {
+			t.Errorf("%s: %d entries", names[i], c)
+		}
+	}
+
+	// The input order doesn't matter
+	rev := []string{names[3], names[2], names[1], names[0]}
+	for s, idx := range MaglevTable(rev, size) {
+		if rev[idx] != names[tbl[s]] {
+			t.Errorf("slot %d: got %s, want %s", s, rev[idx], names[tbl[s]])
+		}
+	}
+
+	// Removing a backend keeps most of the others' entries in place
+	moved := 0
+	for s, idx := range MaglevTable(names[:3], size) {
+		if tbl[s] != 3 && tbl[s] != idx {
+			moved++
+		}
+	}
+	if moved > 2 {
+		t.Errorf("%d entries of remaining backends moved", moved)
+	}
+
+	for _, idx := range MaglevTable(nil, size) {
+		if idx != -1 {
+			t.Fatalf("empty table entry %d", idx)
+		}
+	}
+}
+
+func TestMaglevDistribution(t *testing.T) {
+	// Table size and max backends as used by loxinet's maglev selection
+	const size = 13
+	const maxBackends = 4
+
+	for n := 1; n <= maxBackends; n++ {
+		var names []string
+		for i := 0; i < n; i++ {
+			names = append(names, fmt.Sprintf("10.0.%d.%d:8080", i, i+1))
+		}
+
+		// Every backend gets its fair share of entries, give or take one
+		tbl := MaglevTable(names, size)
+		cnt := make([]int, n)
+		for _, idx := range tbl {
+			cnt[idx]++
+		}
+		for i, c := range cnt {
+			if c < size/n || c > (size+n-1)/n {
+				t.Errorf("%d backends: %s has %d of %d entries", n, names[i], c, size)
+			}
+		}
+
+		// Removing any one backend moves at most two entries of the others
+		for r := 0; r < n; r++ {
+			var rest []string
+			var orig []int
+			for i := range names {
+				if i != r {
+					rest = append(rest, names[i])
+					orig = append(orig, i)
+				}
+			}
+			moved := 0
+			for s, idx := range MaglevTable(rest, size) {
+				if tbl[s] != r && tbl[s] != orig[idx] {
+					moved++
+				}
+			}
+			if moved > 2 {
+				t.Errorf("%d backends: removing %s moved %d entries of others", n, names[r], moved)
+			}
+		}
+	}
+}
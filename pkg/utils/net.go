@@ -24,9 +24,7 @@ import (
 	"crypto/x509"
 	"encoding/binary"
 	"errors"
-	"io"
 	"net"
-	"net/http"
 	"os"
 	"syscall"
 	"time"
@@ -185,37 +183,11 @@ func checksum(data []byte) uint16 {
 // HTTPSProber - Do a https probe for given url
 // returns true/false depending on whether probing was successful
 func HTTPSProber(urls string, cert tls.Certificate, certPool *x509.CertPool, resp string) bool {
-	var err error
-	var req *http.Request
-	var res *http.Response
-
-	timeout := time.Duration(2 * time.Second)
-	client := http.Client{Timeout: timeout,
-		Transport: &http.Transport{
-			IdleConnTimeout: 5 * time.Second,
-			TLSClientConfig: &tls.Config{Certificates: []tls.Certificate{cert},
-				RootCAs: certPool}},
-	}
-	if req, err = http.NewRequest(http.MethodGet, urls, nil); err != nil {
-		tk.LogIt(tk.LogError, "unable to create http request: %s\n", err)
-		return false
-	}
-
-	res, err = client.Do(req)
-	if err != nil || res.StatusCode != 200 {
-		tk.LogIt(tk.LogError, "unable to create http request: %s\n", err)
+	p, err := NewHTTPProbe(HTTPProbeSpec{Resp: resp})
+	if err != nil {
 		return false
 	}
-	defer res.Body.Close()
-	if resp != "" {
-		data, err := io.ReadAll(res.Body)
-		if err != nil {
-			return false
-		}
-		return string(data) == resp
-	}
-
-	return true
+	return p.Probe(urls, cert, certPool)
 }
 
 // IsIPHostAddr - Check if provided address is a local address